@@ -0,0 +1,93 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/internal/httpx"
+	"devoratio.dev/web-resume/model"
+)
+
+type AuditUsecase interface {
+	List(ctx context.Context, claim model.Claim, filter model.AuditFilter) (*model.AuditPage, error)
+}
+
+type HTTP struct {
+	auditUsecase AuditUsecase
+}
+
+func NewHTTP(auditUsecase AuditUsecase) *HTTP {
+	return &HTTP{
+		auditUsecase: auditUsecase,
+	}
+}
+
+func (h *HTTP) RegisterRoutes(mux *http.ServeMux, authenticate httpx.Middleware) {
+	mux.Handle("GET /v1/owner/audit", authenticate(http.HandlerFunc(h.list)))
+}
+
+func (h *HTTP) list(w http.ResponseWriter, r *http.Request) {
+	claim, ok := httpx.ClaimFromContext(r.Context())
+	if !ok {
+		httpx.WriteError(w, errorx.ErrUnauthorized)
+		return
+	}
+
+	filter, err := parseFilter(r.URL.Query())
+	if err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	page, err := h.auditUsecase.List(r.Context(), *claim, filter)
+	if err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, http.StatusOK, page)
+}
+
+func parseFilter(query url.Values) (model.AuditFilter, error) {
+	filter := model.AuditFilter{
+		Method:   model.AuditMethod(query.Get("method")),
+		Event:    model.AuditEvent(query.Get("event")),
+		Outcome:  query.Get("outcome"),
+		ClientIP: query.Get("ip"),
+	}
+	details := map[string]interface{}{}
+
+	for key, target := range map[string]*int{"page": &filter.Page, "per_page": &filter.PerPage} {
+		if value := query.Get(key); value != "" {
+			number, err := strconv.Atoi(value)
+			if err != nil {
+				details[key] = "must be a number"
+				continue
+			}
+			*target = number
+		}
+	}
+
+	for key, target := range map[string]**time.Time{"since": &filter.Since, "until": &filter.Until} {
+		if value := query.Get(key); value != "" {
+			timestamp, err := time.Parse(time.RFC3339, value)
+			if err != nil {
+				details[key] = "must be an RFC 3339 timestamp"
+				continue
+			}
+			*target = &timestamp
+		}
+	}
+
+	if len(details) > 0 {
+		err := errorx.New(errorx.TypeInvalidParameter, "audit filter is invalid", nil)
+		err.Details = details
+		return filter, err
+	}
+
+	return filter, nil
+}
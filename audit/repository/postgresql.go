@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"context"
+
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/internal/tenancy"
+	"devoratio.dev/web-resume/model"
+	"gorm.io/gorm"
+)
+
+type PostgreSQLDatabase struct {
+	db *gorm.DB
+}
+
+func NewPostgreSQL(db *gorm.DB) *PostgreSQLDatabase {
+	return &PostgreSQLDatabase{
+		db: db,
+	}
+}
+
+func (p *PostgreSQLDatabase) CreateEntry(ctx context.Context, entry *model.AuditLog) error {
+	entry.TenantID = tenancy.ID(ctx)
+
+	if err := p.db.WithContext(ctx).Create(entry).Error; err != nil {
+		return errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), err)
+	}
+
+	return nil
+}
+
+func (p *PostgreSQLDatabase) ListEntries(ctx context.Context, ownerID uint, withUnowned bool, filter model.AuditFilter) ([]model.AuditLog, int64, error) {
+	query := p.db.WithContext(ctx).Model(&model.AuditLog{})
+	if withUnowned {
+		query = query.Where("owner_id = ? OR (owner_id IS NULL AND tenant_id = ?)", ownerID, tenancy.ID(ctx))
+	} else {
+		query = query.Where("owner_id = ?", ownerID)
+	}
+
+	if filter.Method != "" {
+		query = query.Where("method = ?", filter.Method)
+	}
+	if filter.Event != "" {
+		query = query.Where("event = ?", filter.Event)
+	}
+	if filter.Outcome != "" {
+		query = query.Where("outcome = ?", filter.Outcome)
+	}
+	if filter.ClientIP != "" {
+		query = query.Where("client_ip = ?", filter.ClientIP)
+	}
+	if filter.Since != nil {
+		query = query.Where("created_at >= ?", *filter.Since)
+	}
+	if filter.Until != nil {
+		query = query.Where("created_at < ?", *filter.Until)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), err)
+	}
+
+	entries := []model.AuditLog{}
+	err := query.Order("created_at DESC, id DESC").
+		Offset((filter.Page - 1) * filter.PerPage).
+		Limit(filter.PerPage).
+		Find(&entries).Error
+	if err != nil {
+		return nil, 0, errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), err)
+	}
+
+	return entries, total, nil
+}
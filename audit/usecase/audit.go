@@ -0,0 +1,100 @@
+package usecase
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/internal/requestinfo"
+	"devoratio.dev/web-resume/internal/tenancy"
+	"devoratio.dev/web-resume/model"
+)
+
+const (
+	defaultPerPage = 20
+	maxPerPage     = 100
+)
+
+//go:generate mockgen -destination=repositorymock/postgresqlmock.go -package=repositorymock . AuditRepository
+type AuditRepository interface {
+	CreateEntry(ctx context.Context, entry *model.AuditLog) error
+	// ListEntries returns the entries of the owner, newest first, with the
+	// total number of entries matching filter. withUnowned adds the entries
+	// made on the tenant in ctx that could not be tied to any owner.
+	ListEntries(ctx context.Context, ownerID uint, withUnowned bool, filter model.AuditFilter) ([]model.AuditLog, int64, error)
+}
+
+type Audit struct {
+	auditRepo AuditRepository
+}
+
+func NewUsecase(auditRepo AuditRepository) *Audit {
+	return &Audit{
+		auditRepo: auditRepo,
+	}
+}
+
+// Record appends entry to the audit trail, completing it with the outcome of
+// result and the client information of the current request. Failing to record
+// never fails the attempt being recorded.
+func (a *Audit) Record(ctx context.Context, entry model.AuditLog, result error) {
+	info := requestinfo.FromContext(ctx)
+	entry.Outcome = outcome(result)
+	entry.ClientIP = info.ClientIP
+	entry.UserAgent = info.UserAgent
+	entry.RequestID = info.RequestID
+	entry.CreatedAt = time.Now()
+
+	if err := a.auditRepo.CreateEntry(context.WithoutCancel(ctx), &entry); err != nil {
+		log.Printf("failed to record audit entry %s/%s for %q: %s", entry.Method, entry.Event, entry.Identifier, err)
+	}
+}
+
+func (a *Audit) List(ctx context.Context, claim model.Claim, filter model.AuditFilter) (*model.AuditPage, error) {
+	if filter.Page < 1 {
+		filter.Page = 1
+	}
+	if filter.PerPage < 1 {
+		filter.PerPage = defaultPerPage
+	}
+	if filter.PerPage > maxPerPage {
+		filter.PerPage = maxPerPage
+	}
+	if filter.Since != nil && filter.Until != nil && filter.Until.Before(*filter.Since) {
+		err := errorx.New(errorx.TypeInvalidParameter, "audit filter is invalid", nil)
+		err.Details = map[string]interface{}{"until": "must not be before since"}
+		return nil, err
+	}
+
+	// Attempts with an unknown identifier belong to whoever owns the host they
+	// were made on, the base domain is shared by every owner and only its
+	// administrator gets to see them
+	withUnowned := tenancy.ID(ctx) != 0 || claim.HasRole(model.RoleAdmin)
+
+	entries, total, err := a.auditRepo.ListEntries(ctx, claim.UserID, withUnowned, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.AuditPage{
+		Entries: entries,
+		Page:    filter.Page,
+		PerPage: filter.PerPage,
+		Total:   total,
+	}, nil
+}
+
+// outcome turns the result of an attempt into the outcome stored in the trail
+func outcome(err error) string {
+	if err == nil {
+		return model.AuditOutcomeSuccess
+	}
+
+	e := errorx.Wrap(err)
+	if e.Type == "" {
+		return errorx.TypeInternal.String()
+	}
+
+	return e.Type.String()
+}
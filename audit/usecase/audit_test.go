@@ -0,0 +1,157 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"devoratio.dev/web-resume/audit/usecase"
+	"devoratio.dev/web-resume/audit/usecase/repositorymock"
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/internal/requestinfo"
+	"devoratio.dev/web-resume/internal/tenancy"
+	"devoratio.dev/web-resume/model"
+)
+
+var _ = Describe("Authentication audit trail", func() {
+	var (
+		mockController *gomock.Controller
+
+		auditRepoMock *repositorymock.MockAuditRepository
+
+		commonCtx    context.Context
+		auditUsecase *usecase.Audit
+		claim        model.Claim
+	)
+
+	BeforeEach(func() {
+		gofakeit.Seed(time.Now().UnixNano())
+		mockController = gomock.NewController(GinkgoT())
+
+		auditRepoMock = repositorymock.NewMockAuditRepository(mockController)
+		auditUsecase = usecase.NewUsecase(auditRepoMock)
+
+		gofakeit.Struct(&claim)
+		claim.Roles = []string{model.RoleOwner}
+
+		commonCtx = requestinfo.WithInfo(context.Background(), requestinfo.Info{
+			RequestID: "3f0e8c1a-6a43-4c1e-9d2c-2b8f1e0a7c55",
+			ClientIP:  "203.0.113.7",
+			UserAgent: "curl/8.4.0",
+		})
+	})
+
+	AfterEach(func() {
+		mockController.Finish()
+	})
+
+	When("a login attempt is recorded", func() {
+		It("stores the outcome along with the client of the request", func(ctx SpecContext) {
+			auditRepoMock.EXPECT().CreateEntry(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, entry *model.AuditLog) error {
+					Expect(entry.Event).Should(Equal(model.AuditEventBadPassword))
+					Expect(entry.Outcome).Should(Equal(errorx.TypeInvalidParameter.String()))
+					Expect(entry.RequestID).Should(Equal("3f0e8c1a-6a43-4c1e-9d2c-2b8f1e0a7c55"))
+					Expect(entry.ClientIP).Should(Equal("203.0.113.7"))
+					Expect(entry.UserAgent).Should(Equal("curl/8.4.0"))
+					Expect(entry.CreatedAt).Should(BeTemporally("~", time.Now(), time.Second))
+					return nil
+				})
+
+			auditUsecase.Record(commonCtx, model.AuditLog{
+				Method:     model.AuditMethodPassword,
+				Event:      model.AuditEventBadPassword,
+				Identifier: "devoratio",
+			}, errorx.New(errorx.TypeInvalidParameter, "username or email or password is invalid", errorx.ErrNotMatch))
+		}, SpecTimeout(time.Second*2))
+
+		It("stores a successful attempt as such", func(ctx SpecContext) {
+			auditRepoMock.EXPECT().CreateEntry(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, entry *model.AuditLog) error {
+					Expect(entry.Outcome).Should(Equal(model.AuditOutcomeSuccess))
+					return nil
+				})
+
+			auditUsecase.Record(commonCtx, model.AuditLog{Method: model.AuditMethodPassword, Event: model.AuditEventSuccess}, nil)
+		}, SpecTimeout(time.Second*2))
+	})
+
+	When("the owner lists the audit trail", func() {
+		Context("without pagination", func() {
+			It("returns the first page", func(ctx SpecContext) {
+				auditRepoMock.EXPECT().ListEntries(commonCtx, claim.UserID, false, model.AuditFilter{Page: 1, PerPage: 20}).
+					Return([]model.AuditLog{{ID: 1}}, int64(1), nil)
+
+				page, err := auditUsecase.List(commonCtx, claim, model.AuditFilter{})
+				Expect(err).Should(BeNil())
+				Expect(page.Entries).Should(HaveLen(1))
+				Expect(page.Page).Should(Equal(1))
+				Expect(page.PerPage).Should(Equal(20))
+				Expect(page.Total).Should(Equal(int64(1)))
+			}, SpecTimeout(time.Second*2))
+		})
+
+		Context("on the base domain as its administrator", func() {
+			It("includes the attempts that could not be tied to an owner", func(ctx SpecContext) {
+				claim.Roles = []string{model.RoleOwner, model.RoleAdmin}
+
+				auditRepoMock.EXPECT().ListEntries(commonCtx, claim.UserID, true, model.AuditFilter{Page: 1, PerPage: 20}).
+					Return(nil, int64(0), nil)
+
+				_, err := auditUsecase.List(commonCtx, claim, model.AuditFilter{})
+				Expect(err).Should(BeNil())
+			}, SpecTimeout(time.Second*2))
+		})
+
+		Context("on their own tenant", func() {
+			It("includes the attempts on the tenant that could not be tied to an owner", func(ctx SpecContext) {
+				tenantCtx := tenancy.WithTenant(commonCtx, &model.Tenant{ID: 4, OwnerID: claim.UserID})
+
+				auditRepoMock.EXPECT().ListEntries(tenantCtx, claim.UserID, true, model.AuditFilter{Page: 1, PerPage: 20}).
+					Return(nil, int64(0), nil)
+
+				_, err := auditUsecase.List(tenantCtx, claim, model.AuditFilter{})
+				Expect(err).Should(BeNil())
+			}, SpecTimeout(time.Second*2))
+		})
+
+		Context("with a page size over the limit", func() {
+			It("caps the page size", func(ctx SpecContext) {
+				auditRepoMock.EXPECT().ListEntries(commonCtx, claim.UserID, false, model.AuditFilter{Page: 3, PerPage: 100}).
+					Return(nil, int64(0), nil)
+
+				page, err := auditUsecase.List(commonCtx, claim, model.AuditFilter{Page: 3, PerPage: 1000})
+				Expect(err).Should(BeNil())
+				Expect(page.PerPage).Should(Equal(100))
+			}, SpecTimeout(time.Second*2))
+		})
+
+		Context("with a time range that ends before it starts", func() {
+			It("tells the owner that the filter is invalid", func(ctx SpecContext) {
+				since := time.Now()
+				until := since.Add(-time.Hour)
+
+				page, err := auditUsecase.List(commonCtx, claim, model.AuditFilter{Since: &since, Until: &until})
+				Expect(err.(*errorx.Error).Type).Should(Equal(errorx.TypeInvalidParameter))
+				Expect(err.(*errorx.Error).Details).Should(HaveKey("until"))
+				Expect(page).Should(BeNil())
+			}, SpecTimeout(time.Second*2))
+		})
+
+		Context("the database fails", func() {
+			It("returns the error", func(ctx SpecContext) {
+				errorInternal := errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), errors.New("connection refused"))
+				auditRepoMock.EXPECT().ListEntries(commonCtx, claim.UserID, false, gomock.Any()).Return(nil, int64(0), errorInternal)
+
+				page, err := auditUsecase.List(commonCtx, claim, model.AuditFilter{})
+				Expect(err).Should(Equal(errorInternal))
+				Expect(page).Should(BeNil())
+			}, SpecTimeout(time.Second*2))
+		})
+	})
+})
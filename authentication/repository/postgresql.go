@@ -1,23 +1,100 @@
 package repository
 
 import (
+	"context"
+	"errors"
+	"time"
+
+	"devoratio.dev/web-resume/config"
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/internal/identifier"
+	"devoratio.dev/web-resume/internal/tenancy"
 	"devoratio.dev/web-resume/model"
 	"gorm.io/gorm"
 )
 
 type PostgreSQLDatabase struct {
-	db *gorm.DB
+	db                *gorm.DB
+	verifiedEmailOnly bool
 }
 
-func NewPostgreSQL(db *gorm.DB) *PostgreSQLDatabase {
+func NewPostgreSQL(db *gorm.DB, authConfig config.Authentication) *PostgreSQLDatabase {
 	return &PostgreSQLDatabase{
-		db: db,
+		db:                db,
+		verifiedEmailOnly: authConfig.VerifiedEmailOnly,
+	}
+}
+
+// GetOwnerByUsernameOrEmail finds the owner whatever the case or Unicode form
+// the identifier is spelled in, see identifier.Normalize
+func (p *PostgreSQLDatabase) GetOwnerByUsernameOrEmail(ctx context.Context, ownerIdentifier string) (*model.OwnerAccount, error) {
+	owner := &model.OwnerAccount{}
+	result := p.db.WithContext(ctx).
+		Scopes(tenancy.Scope(ctx, "id"), identifier.Scope(ownerIdentifier, p.verifiedEmailOnly)).
+		First(owner)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, errorx.ErrNotFound
+		}
+		return nil, errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+
+	return owner, nil
+}
+
+func (p *PostgreSQLDatabase) GetOwnerByID(ctx context.Context, ownerID uint) (*model.OwnerAccount, error) {
+	owner := &model.OwnerAccount{}
+	result := p.db.WithContext(ctx).Scopes(tenancy.Scope(ctx, "id")).First(owner, ownerID)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, errorx.ErrNotFound
+		}
+		return nil, errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+
+	return owner, nil
+}
+
+func (p *PostgreSQLDatabase) GetSessionByFamilyID(ctx context.Context, familyID string) (*model.Session, error) {
+	session := &model.Session{}
+	result := p.db.WithContext(ctx).Scopes(tenancy.Scope(ctx, "owner_id")).Where("family_id = ?", familyID).First(session)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, errorx.ErrNotFound
+		}
+		return nil, errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+
+	return session, nil
+}
+
+func (p *PostgreSQLDatabase) TouchSession(ctx context.Context, sessionID uint, lastSeenAt time.Time) error {
+	result := p.db.WithContext(ctx).Model(&model.Session{}).Where("id = ?", sessionID).Update("last_seen_at", lastSeenAt)
+	if result.Error != nil {
+		return errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
 	}
+
+	return nil
 }
 
-func (p *PostgreSQLDatabase) GetOwnerByUsernameOrEmail(identifier string) (*model.OwnerAccount, error) {
-	var owner *model.OwnerAccount
-	result := p.db.Where("username = ? OR email = ?", identifier, identifier).First(owner)
+func (p *PostgreSQLDatabase) GetPersonalToken(ctx context.Context, tokenHash string) (*model.PersonalToken, error) {
+	token := &model.PersonalToken{}
+	result := p.db.WithContext(ctx).Scopes(tenancy.Scope(ctx, "owner_id")).Where("token_hash = ?", tokenHash).First(token)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, errorx.ErrNotFound
+		}
+		return nil, errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+
+	return token, nil
+}
+
+func (p *PostgreSQLDatabase) TouchPersonalToken(ctx context.Context, tokenID uint, lastUsedAt time.Time) error {
+	result := p.db.WithContext(ctx).Model(&model.PersonalToken{}).Where("id = ?", tokenID).Update("last_used_at", lastUsedAt)
+	if result.Error != nil {
+		return errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
 
-	return owner, result.Error
+	return nil
 }
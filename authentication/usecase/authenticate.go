@@ -2,17 +2,29 @@ package usecase
 
 import (
 	"context"
+	"log"
+	"time"
 
 	"devoratio.dev/web-resume/internal/errorx"
 	"devoratio.dev/web-resume/internal/hasher"
+	"devoratio.dev/web-resume/internal/tenancy"
 	"devoratio.dev/web-resume/model"
 )
 
 const invalidInputMessage = "username or email or password is invalid"
 
+// lastSeenPrecision limits how often serving a session writes its last-seen
+// time
+const lastSeenPrecision = 5 * time.Minute
+
 //go:generate mockgen -destination=repositorymock/postgresqlmock.go -package=repositorymock . AuthenticationRepository
 type AuthenticationRepository interface {
 	GetOwnerByUsernameOrEmail(ctx context.Context, identifier string) (*model.OwnerAccount, error)
+	GetOwnerByID(ctx context.Context, ownerID uint) (*model.OwnerAccount, error)
+	GetSessionByFamilyID(ctx context.Context, familyID string) (*model.Session, error)
+	TouchSession(ctx context.Context, sessionID uint, lastSeenAt time.Time) error
+	GetPersonalToken(ctx context.Context, tokenHash string) (*model.PersonalToken, error)
+	TouchPersonalToken(ctx context.Context, tokenID uint, lastUsedAt time.Time) error
 }
 
 type Authentication struct {
@@ -44,3 +56,107 @@ func (a *Authentication) Authenticate(ctx context.Context, identifier, password
 
 	return &ownerAccount.Owner, nil
 }
+
+// ValidateClaim makes sure a verified token was issued on the tenant serving
+// the request, still belongs to an existing owner, was issued after the owner
+// last changed their password and that its session has not been revoked.
+func (a *Authentication) ValidateClaim(ctx context.Context, claim *model.Claim) error {
+	if claim.TenantID != tenancy.ID(ctx) {
+		return errorx.ErrUnauthorized
+	}
+
+	ownerAccount, err := a.authRepo.GetOwnerByID(ctx, claim.UserID)
+	if err != nil {
+		if errorx.Is(err, errorx.ErrNotFound) {
+			return errorx.ErrUnauthorized
+		}
+		return err
+	}
+
+	// Token timestamps only have a precision of one second. A token issued in
+	// the same second as the change is let through to the session check, every
+	// session open at the time of the change has been revoked along with it.
+	if ownerAccount.PasswordChangedAt != nil && claim.IssuedAt.Before(ownerAccount.PasswordChangedAt.Truncate(time.Second)) {
+		return errorx.ErrUnauthorized
+	}
+
+	return a.validateSession(ctx, claim)
+}
+
+func (a *Authentication) validateSession(ctx context.Context, claim *model.Claim) error {
+	if claim.SessionID == "" {
+		return errorx.ErrUnauthorized
+	}
+
+	session, err := a.authRepo.GetSessionByFamilyID(ctx, claim.SessionID)
+	if err != nil {
+		if errorx.Is(err, errorx.ErrNotFound) {
+			return errorx.ErrUnauthorized
+		}
+		return err
+	}
+
+	if session.OwnerID != claim.UserID || session.RevokedAt != nil {
+		return errorx.ErrUnauthorized
+	}
+
+	now := time.Now()
+	if now.Sub(session.LastSeenAt) > lastSeenPrecision {
+		// Keeping the last-seen time is best effort, it never fails the request
+		if err := a.authRepo.TouchSession(ctx, session.ID, now); err != nil {
+			log.Printf("failed to update last seen time of session %d: %s", session.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// ResolvePersonalToken returns the claim of the owner who created a personal
+// access token, limited to the scopes the token was granted
+func (a *Authentication) ResolvePersonalToken(ctx context.Context, personalToken string) (*model.Claim, error) {
+	token, err := a.authRepo.GetPersonalToken(ctx, hasher.HashToken(personalToken))
+	if err != nil {
+		if errorx.Is(err, errorx.ErrNotFound) {
+			return nil, errorx.ErrUnauthorized
+		}
+		return nil, err
+	}
+
+	if tenant, ok := tenancy.FromContext(ctx); ok && token.OwnerID != tenant.OwnerID {
+		return nil, errorx.ErrUnauthorized
+	}
+
+	now := time.Now()
+	if token.RevokedAt != nil || (token.ExpiresAt != nil && !now.Before(*token.ExpiresAt)) {
+		return nil, errorx.ErrUnauthorized
+	}
+
+	ownerAccount, err := a.authRepo.GetOwnerByID(ctx, token.OwnerID)
+	if err != nil {
+		if errorx.Is(err, errorx.ErrNotFound) {
+			return nil, errorx.ErrUnauthorized
+		}
+		return nil, err
+	}
+
+	// Tokens created before the password changed may have been created by
+	// whoever knew the old one
+	if ownerAccount.PasswordResetRequired || (ownerAccount.PasswordChangedAt != nil && !token.CreatedAt.After(*ownerAccount.PasswordChangedAt)) {
+		return nil, errorx.ErrUnauthorized
+	}
+
+	if token.LastUsedAt == nil || now.Sub(*token.LastUsedAt) > lastSeenPrecision {
+		if err := a.authRepo.TouchPersonalToken(ctx, token.ID, now); err != nil {
+			log.Printf("failed to update last used time of personal token %d: %s", token.ID, err)
+		}
+	}
+
+	return &model.Claim{
+		UserID:          ownerAccount.ID,
+		Username:        ownerAccount.Username,
+		TenantID:        tenancy.ID(ctx),
+		Roles:           []string{model.RoleOwner},
+		Scopes:          token.Scopes,
+		PersonalTokenID: token.ID,
+	}, nil
+}
@@ -7,6 +7,8 @@ import (
 	"devoratio.dev/web-resume/authentication/usecase"
 	"devoratio.dev/web-resume/authentication/usecase/repositorymock"
 	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/internal/hasher"
+	"devoratio.dev/web-resume/internal/tenancy"
 	"devoratio.dev/web-resume/model"
 	"github.com/brianvoe/gofakeit/v6"
 	"github.com/golang/mock/gomock"
@@ -38,6 +40,7 @@ var _ = Describe("Authenticate user credential", Label("authentication"), func()
 
 		gofakeit.Struct(&ownerAccountStub)
 		ownerAccountStub.Password = "$2a$12$hWASkUwEkcS1CbsyRRwoBew5r7qwmXwH4YJyP.S149hghOg77UEQW"
+		ownerAccountStub.PasswordResetRequired = false
 
 		commonCtx = context.Background()
 
@@ -118,3 +121,286 @@ var _ = Describe("Authenticate user credential", Label("authentication"), func()
 		})
 	})
 })
+
+var _ = Describe("Validate access token claim", Label("authentication"), func() {
+	var (
+		mockController *gomock.Controller
+
+		authenticationRepoMock *repositorymock.MockAuthenticationRepository
+
+		authenticateUsecase *usecase.Authentication
+
+		commonCtx        context.Context
+		ownerAccountStub model.OwnerAccount
+		claim            *model.Claim
+		sessionStub      *model.Session
+	)
+
+	BeforeEach(func() {
+		gofakeit.Seed(time.Now().UnixNano())
+		mockController = gomock.NewController(GinkgoT())
+
+		authenticationRepoMock = repositorymock.NewMockAuthenticationRepository(mockController)
+
+		authenticateUsecase = usecase.NewUsecase(authenticationRepoMock)
+
+		gofakeit.Struct(&ownerAccountStub)
+		ownerAccountStub.PasswordChangedAt = nil
+
+		commonCtx = context.Background()
+		claim = &model.Claim{
+			UserID:    ownerAccountStub.ID,
+			Username:  ownerAccountStub.Username,
+			SessionID: "2b7c0f6e-5b1a-4d8e-9c3f-7a6e1d2c4b5a",
+			IssuedAt:  time.Now().Truncate(time.Second),
+		}
+		sessionStub = &model.Session{
+			ID:         7,
+			OwnerID:    ownerAccountStub.ID,
+			FamilyID:   claim.SessionID,
+			LastSeenAt: time.Now(),
+		}
+	})
+
+	AfterEach(func() {
+		mockController.Finish()
+	})
+
+	When("the owner no longer exists", func() {
+		It("tells the user that the token is unauthorized", func() {
+			authenticationRepoMock.EXPECT().GetOwnerByID(commonCtx, claim.UserID).Return(nil, errorx.ErrNotFound)
+
+			err := authenticateUsecase.ValidateClaim(commonCtx, claim)
+			Expect(err).Should(Equal(errorx.ErrUnauthorized))
+		})
+	})
+
+	When("the token was issued on another tenant", func() {
+		It("tells the user that the token is unauthorized", func() {
+			tenantCtx := tenancy.WithTenant(commonCtx, &model.Tenant{ID: 7, OwnerID: ownerAccountStub.ID})
+
+			err := authenticateUsecase.ValidateClaim(tenantCtx, claim)
+			Expect(err).Should(Equal(errorx.ErrUnauthorized))
+		})
+	})
+
+	When("the owner never changed their password", func() {
+		It("accepts the token", func() {
+			authenticationRepoMock.EXPECT().GetOwnerByID(commonCtx, claim.UserID).Return(&ownerAccountStub, nil)
+			authenticationRepoMock.EXPECT().GetSessionByFamilyID(commonCtx, claim.SessionID).Return(sessionStub, nil)
+
+			err := authenticateUsecase.ValidateClaim(commonCtx, claim)
+			Expect(err).Should(BeNil())
+		})
+	})
+
+	When("the token was issued before the password was changed", func() {
+		It("tells the user that the token is unauthorized", func() {
+			changedAt := claim.IssuedAt.Add(time.Minute)
+			ownerAccountStub.PasswordChangedAt = &changedAt
+			authenticationRepoMock.EXPECT().GetOwnerByID(commonCtx, claim.UserID).Return(&ownerAccountStub, nil)
+
+			err := authenticateUsecase.ValidateClaim(commonCtx, claim)
+			Expect(err).Should(Equal(errorx.ErrUnauthorized))
+		})
+	})
+
+	When("the token was issued in the same second as the password was changed", func() {
+		It("accepts the token of a session started after the change", func() {
+			changedAt := claim.IssuedAt.Add(500 * time.Millisecond)
+			ownerAccountStub.PasswordChangedAt = &changedAt
+			authenticationRepoMock.EXPECT().GetOwnerByID(commonCtx, claim.UserID).Return(&ownerAccountStub, nil)
+			authenticationRepoMock.EXPECT().GetSessionByFamilyID(commonCtx, claim.SessionID).Return(sessionStub, nil)
+
+			err := authenticateUsecase.ValidateClaim(commonCtx, claim)
+			Expect(err).Should(BeNil())
+		})
+
+		It("rejects the token of a session the change revoked", func() {
+			changedAt := claim.IssuedAt.Add(500 * time.Millisecond)
+			ownerAccountStub.PasswordChangedAt = &changedAt
+			sessionStub.RevokedAt = &changedAt
+			authenticationRepoMock.EXPECT().GetOwnerByID(commonCtx, claim.UserID).Return(&ownerAccountStub, nil)
+			authenticationRepoMock.EXPECT().GetSessionByFamilyID(commonCtx, claim.SessionID).Return(sessionStub, nil)
+
+			err := authenticateUsecase.ValidateClaim(commonCtx, claim)
+			Expect(err).Should(Equal(errorx.ErrUnauthorized))
+		})
+	})
+
+	When("the token was issued after the password was changed", func() {
+		It("accepts the token", func() {
+			changedAt := claim.IssuedAt.Add(-time.Minute)
+			ownerAccountStub.PasswordChangedAt = &changedAt
+			authenticationRepoMock.EXPECT().GetOwnerByID(commonCtx, claim.UserID).Return(&ownerAccountStub, nil)
+			authenticationRepoMock.EXPECT().GetSessionByFamilyID(commonCtx, claim.SessionID).Return(sessionStub, nil)
+
+			err := authenticateUsecase.ValidateClaim(commonCtx, claim)
+			Expect(err).Should(BeNil())
+		})
+	})
+
+	When("the token does not belong to a session", func() {
+		It("tells the user that the token is unauthorized", func() {
+			claim.SessionID = ""
+			authenticationRepoMock.EXPECT().GetOwnerByID(commonCtx, claim.UserID).Return(&ownerAccountStub, nil)
+
+			err := authenticateUsecase.ValidateClaim(commonCtx, claim)
+			Expect(err).Should(Equal(errorx.ErrUnauthorized))
+		})
+	})
+
+	When("the session of the token has been revoked", func() {
+		It("tells the user that the token is unauthorized", func() {
+			revokedAt := time.Now()
+			sessionStub.RevokedAt = &revokedAt
+			authenticationRepoMock.EXPECT().GetOwnerByID(commonCtx, claim.UserID).Return(&ownerAccountStub, nil)
+			authenticationRepoMock.EXPECT().GetSessionByFamilyID(commonCtx, claim.SessionID).Return(sessionStub, nil)
+
+			err := authenticateUsecase.ValidateClaim(commonCtx, claim)
+			Expect(err).Should(Equal(errorx.ErrUnauthorized))
+		})
+	})
+
+	When("the session has not been seen for a while", func() {
+		It("accepts the token and records that the session was seen", func() {
+			sessionStub.LastSeenAt = time.Now().Add(-time.Hour)
+			authenticationRepoMock.EXPECT().GetOwnerByID(commonCtx, claim.UserID).Return(&ownerAccountStub, nil)
+			authenticationRepoMock.EXPECT().GetSessionByFamilyID(commonCtx, claim.SessionID).Return(sessionStub, nil)
+			authenticationRepoMock.EXPECT().TouchSession(commonCtx, sessionStub.ID, gomock.Any()).Return(nil)
+
+			err := authenticateUsecase.ValidateClaim(commonCtx, claim)
+			Expect(err).Should(BeNil())
+		})
+	})
+})
+
+var _ = Describe("Resolve personal access token", Label("authentication"), func() {
+	var (
+		mockController *gomock.Controller
+
+		authenticationRepoMock *repositorymock.MockAuthenticationRepository
+
+		authenticateUsecase *usecase.Authentication
+
+		commonCtx         context.Context
+		ownerAccountStub  model.OwnerAccount
+		personalTokenStub *model.PersonalToken
+		personalToken     = model.PersonalTokenPrefix + "personaltoken"
+		tokenHash         = hasher.HashToken(model.PersonalTokenPrefix + "personaltoken")
+	)
+
+	BeforeEach(func() {
+		gofakeit.Seed(time.Now().UnixNano())
+		mockController = gomock.NewController(GinkgoT())
+
+		authenticationRepoMock = repositorymock.NewMockAuthenticationRepository(mockController)
+
+		authenticateUsecase = usecase.NewUsecase(authenticationRepoMock)
+
+		gofakeit.Struct(&ownerAccountStub)
+		passwordChangedAt := time.Now().Add(-time.Hour)
+		ownerAccountStub.PasswordChangedAt = &passwordChangedAt
+		ownerAccountStub.PasswordResetRequired = false
+		lastUsedAt := time.Now()
+		personalTokenStub = &model.PersonalToken{
+			ID:         7,
+			OwnerID:    ownerAccountStub.ID,
+			Scopes:     []string{model.ScopeResumeRead},
+			CreatedAt:  time.Now().Add(-time.Minute),
+			LastUsedAt: &lastUsedAt,
+		}
+
+		commonCtx = context.Background()
+	})
+
+	AfterEach(func() {
+		mockController.Finish()
+	})
+
+	When("the token is unknown", func() {
+		It("rejects the token", func() {
+			authenticationRepoMock.EXPECT().GetPersonalToken(commonCtx, tokenHash).Return(nil, errorx.ErrNotFound)
+
+			claim, err := authenticateUsecase.ResolvePersonalToken(commonCtx, personalToken)
+			Expect(err).Should(Equal(errorx.ErrUnauthorized))
+			Expect(claim).Should(BeNil())
+		})
+	})
+
+	When("the token has been revoked", func() {
+		It("rejects the token", func() {
+			revokedAt := time.Now().Add(-time.Minute)
+			personalTokenStub.RevokedAt = &revokedAt
+			authenticationRepoMock.EXPECT().GetPersonalToken(commonCtx, tokenHash).Return(personalTokenStub, nil)
+
+			claim, err := authenticateUsecase.ResolvePersonalToken(commonCtx, personalToken)
+			Expect(err).Should(Equal(errorx.ErrUnauthorized))
+			Expect(claim).Should(BeNil())
+		})
+	})
+
+	When("the token has expired", func() {
+		It("rejects the token", func() {
+			expiresAt := time.Now().Add(-time.Minute)
+			personalTokenStub.ExpiresAt = &expiresAt
+			authenticationRepoMock.EXPECT().GetPersonalToken(commonCtx, tokenHash).Return(personalTokenStub, nil)
+
+			claim, err := authenticateUsecase.ResolvePersonalToken(commonCtx, personalToken)
+			Expect(err).Should(Equal(errorx.ErrUnauthorized))
+			Expect(claim).Should(BeNil())
+		})
+	})
+
+	When("the owner has to reset their password", func() {
+		It("rejects the token", func() {
+			ownerAccountStub.PasswordResetRequired = true
+			authenticationRepoMock.EXPECT().GetPersonalToken(commonCtx, tokenHash).Return(personalTokenStub, nil)
+			authenticationRepoMock.EXPECT().GetOwnerByID(commonCtx, ownerAccountStub.ID).Return(&ownerAccountStub, nil)
+
+			claim, err := authenticateUsecase.ResolvePersonalToken(commonCtx, personalToken)
+			Expect(err).Should(Equal(errorx.ErrUnauthorized))
+			Expect(claim).Should(BeNil())
+		})
+	})
+
+	When("the token was created before the password was changed", func() {
+		It("rejects the token", func() {
+			passwordChangedAt := personalTokenStub.CreatedAt.Add(time.Second)
+			ownerAccountStub.PasswordChangedAt = &passwordChangedAt
+			authenticationRepoMock.EXPECT().GetPersonalToken(commonCtx, tokenHash).Return(personalTokenStub, nil)
+			authenticationRepoMock.EXPECT().GetOwnerByID(commonCtx, ownerAccountStub.ID).Return(&ownerAccountStub, nil)
+
+			claim, err := authenticateUsecase.ResolvePersonalToken(commonCtx, personalToken)
+			Expect(err).Should(Equal(errorx.ErrUnauthorized))
+			Expect(claim).Should(BeNil())
+		})
+	})
+
+	When("the token is valid", func() {
+		It("returns the claim of the owner limited to the token scopes", func() {
+			authenticationRepoMock.EXPECT().GetPersonalToken(commonCtx, tokenHash).Return(personalTokenStub, nil)
+			authenticationRepoMock.EXPECT().GetOwnerByID(commonCtx, ownerAccountStub.ID).Return(&ownerAccountStub, nil)
+
+			claim, err := authenticateUsecase.ResolvePersonalToken(commonCtx, personalToken)
+			Expect(err).Should(BeNil())
+			Expect(claim.UserID).Should(Equal(ownerAccountStub.ID))
+			Expect(claim.PersonalTokenID).Should(Equal(personalTokenStub.ID))
+			Expect(claim.HasScope(model.ScopeResumeRead)).Should(BeTrue())
+			Expect(claim.HasScope(model.ScopeResumeWrite)).Should(BeFalse())
+		})
+	})
+
+	When("the token has not been used for a while", func() {
+		It("accepts the token and records that it was used", func() {
+			personalTokenStub.LastUsedAt = nil
+			authenticationRepoMock.EXPECT().GetPersonalToken(commonCtx, tokenHash).Return(personalTokenStub, nil)
+			authenticationRepoMock.EXPECT().GetOwnerByID(commonCtx, ownerAccountStub.ID).Return(&ownerAccountStub, nil)
+			authenticationRepoMock.EXPECT().TouchPersonalToken(commonCtx, personalTokenStub.ID, gomock.Any()).Return(nil)
+
+			claim, err := authenticateUsecase.ResolvePersonalToken(commonCtx, personalToken)
+			Expect(err).Should(BeNil())
+			Expect(claim).ShouldNot(BeNil())
+		})
+	})
+})
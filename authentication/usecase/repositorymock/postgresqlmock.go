@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: devoratio.dev/web-resume/authentication (interfaces: AuthenticationRepository)
+// Source: devoratio.dev/web-resume/authentication/usecase (interfaces: AuthenticationRepository)
 
 // Package repositorymock is a generated GoMock package.
 package repositorymock
@@ -7,6 +7,7 @@ package repositorymock
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	model "devoratio.dev/web-resume/model"
 	gomock "github.com/golang/mock/gomock"
@@ -35,6 +36,21 @@ func (m *MockAuthenticationRepository) EXPECT() *MockAuthenticationRepositoryMoc
 	return m.recorder
 }
 
+// GetOwnerByID mocks base method.
+func (m *MockAuthenticationRepository) GetOwnerByID(arg0 context.Context, arg1 uint) (*model.OwnerAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOwnerByID", arg0, arg1)
+	ret0, _ := ret[0].(*model.OwnerAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOwnerByID indicates an expected call of GetOwnerByID.
+func (mr *MockAuthenticationRepositoryMockRecorder) GetOwnerByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOwnerByID", reflect.TypeOf((*MockAuthenticationRepository)(nil).GetOwnerByID), arg0, arg1)
+}
+
 // GetOwnerByUsernameOrEmail mocks base method.
 func (m *MockAuthenticationRepository) GetOwnerByUsernameOrEmail(arg0 context.Context, arg1 string) (*model.OwnerAccount, error) {
 	m.ctrl.T.Helper()
@@ -49,3 +65,61 @@ func (mr *MockAuthenticationRepositoryMockRecorder) GetOwnerByUsernameOrEmail(ar
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOwnerByUsernameOrEmail", reflect.TypeOf((*MockAuthenticationRepository)(nil).GetOwnerByUsernameOrEmail), arg0, arg1)
 }
+
+// GetPersonalToken mocks base method.
+func (m *MockAuthenticationRepository) GetPersonalToken(arg0 context.Context, arg1 string) (*model.PersonalToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPersonalToken", arg0, arg1)
+	ret0, _ := ret[0].(*model.PersonalToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPersonalToken indicates an expected call of GetPersonalToken.
+func (mr *MockAuthenticationRepositoryMockRecorder) GetPersonalToken(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPersonalToken", reflect.TypeOf((*MockAuthenticationRepository)(nil).GetPersonalToken), arg0, arg1)
+}
+
+// GetSessionByFamilyID mocks base method.
+func (m *MockAuthenticationRepository) GetSessionByFamilyID(arg0 context.Context, arg1 string) (*model.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSessionByFamilyID", arg0, arg1)
+	ret0, _ := ret[0].(*model.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSessionByFamilyID indicates an expected call of GetSessionByFamilyID.
+func (mr *MockAuthenticationRepositoryMockRecorder) GetSessionByFamilyID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessionByFamilyID", reflect.TypeOf((*MockAuthenticationRepository)(nil).GetSessionByFamilyID), arg0, arg1)
+}
+
+// TouchPersonalToken mocks base method.
+func (m *MockAuthenticationRepository) TouchPersonalToken(arg0 context.Context, arg1 uint, arg2 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TouchPersonalToken", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TouchPersonalToken indicates an expected call of TouchPersonalToken.
+func (mr *MockAuthenticationRepositoryMockRecorder) TouchPersonalToken(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TouchPersonalToken", reflect.TypeOf((*MockAuthenticationRepository)(nil).TouchPersonalToken), arg0, arg1, arg2)
+}
+
+// TouchSession mocks base method.
+func (m *MockAuthenticationRepository) TouchSession(arg0 context.Context, arg1 uint, arg2 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TouchSession", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TouchSession indicates an expected call of TouchSession.
+func (mr *MockAuthenticationRepositoryMockRecorder) TouchSession(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TouchSession", reflect.TypeOf((*MockAuthenticationRepository)(nil).TouchSession), arg0, arg1, arg2)
+}
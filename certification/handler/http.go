@@ -0,0 +1,148 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/internal/httpx"
+	"devoratio.dev/web-resume/model"
+)
+
+type CertificationUsecase interface {
+	Create(ctx context.Context, claim model.Claim, request model.CertificationRequest) (*model.Certification, error)
+	Get(ctx context.Context, claim model.Claim, certificationID uint) (*model.Certification, error)
+	List(ctx context.Context, claim model.Claim) ([]model.Certification, error)
+	Update(ctx context.Context, claim model.Claim, certificationID uint, request model.CertificationRequest) (*model.Certification, error)
+	Delete(ctx context.Context, claim model.Claim, certificationID uint) error
+}
+
+type HTTP struct {
+	certificationUsecase CertificationUsecase
+}
+
+func NewHTTP(certificationUsecase CertificationUsecase) *HTTP {
+	return &HTTP{
+		certificationUsecase: certificationUsecase,
+	}
+}
+
+// RegisterRoutes registers the certification routes, read is required to get the
+// certifications and write to change them
+func (h *HTTP) RegisterRoutes(mux *http.ServeMux, read, write httpx.Middleware) {
+	mux.Handle("GET /v1/certifications", read(http.HandlerFunc(h.list)))
+	mux.Handle("POST /v1/certifications", write(http.HandlerFunc(h.create)))
+	mux.Handle("GET /v1/certifications/{id}", read(http.HandlerFunc(h.get)))
+	mux.Handle("PUT /v1/certifications/{id}", write(http.HandlerFunc(h.update)))
+	mux.Handle("DELETE /v1/certifications/{id}", write(http.HandlerFunc(h.delete)))
+}
+
+func (h *HTTP) list(w http.ResponseWriter, r *http.Request) {
+	claim, ok := httpx.ClaimFromContext(r.Context())
+	if !ok {
+		httpx.WriteError(w, errorx.ErrUnauthorized)
+		return
+	}
+
+	certifications, err := h.certificationUsecase.List(r.Context(), *claim)
+	if err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, http.StatusOK, certifications)
+}
+
+func (h *HTTP) create(w http.ResponseWriter, r *http.Request) {
+	claim, ok := httpx.ClaimFromContext(r.Context())
+	if !ok {
+		httpx.WriteError(w, errorx.ErrUnauthorized)
+		return
+	}
+
+	var request model.CertificationRequest
+	if err := httpx.DecodeJSON(w, r, &request); err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	certification, err := h.certificationUsecase.Create(r.Context(), *claim, request)
+	if err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, http.StatusCreated, certification)
+}
+
+func (h *HTTP) get(w http.ResponseWriter, r *http.Request) {
+	claim, ok := httpx.ClaimFromContext(r.Context())
+	if !ok {
+		httpx.WriteError(w, errorx.ErrUnauthorized)
+		return
+	}
+
+	certificationID, err := strconv.ParseUint(r.PathValue("id"), 10, 0)
+	if err != nil {
+		httpx.WriteError(w, errorx.ErrNotFound)
+		return
+	}
+
+	certification, err := h.certificationUsecase.Get(r.Context(), *claim, uint(certificationID))
+	if err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, http.StatusOK, certification)
+}
+
+func (h *HTTP) update(w http.ResponseWriter, r *http.Request) {
+	claim, ok := httpx.ClaimFromContext(r.Context())
+	if !ok {
+		httpx.WriteError(w, errorx.ErrUnauthorized)
+		return
+	}
+
+	certificationID, err := strconv.ParseUint(r.PathValue("id"), 10, 0)
+	if err != nil {
+		httpx.WriteError(w, errorx.ErrNotFound)
+		return
+	}
+
+	var request model.CertificationRequest
+	if err := httpx.DecodeJSON(w, r, &request); err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	certification, err := h.certificationUsecase.Update(r.Context(), *claim, uint(certificationID), request)
+	if err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, http.StatusOK, certification)
+}
+
+func (h *HTTP) delete(w http.ResponseWriter, r *http.Request) {
+	claim, ok := httpx.ClaimFromContext(r.Context())
+	if !ok {
+		httpx.WriteError(w, errorx.ErrUnauthorized)
+		return
+	}
+
+	certificationID, err := strconv.ParseUint(r.PathValue("id"), 10, 0)
+	if err != nil {
+		httpx.WriteError(w, errorx.ErrNotFound)
+		return
+	}
+
+	if err := h.certificationUsecase.Delete(r.Context(), *claim, uint(certificationID)); err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	httpx.WriteNoContent(w)
+}
@@ -0,0 +1,127 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/model"
+	"gorm.io/gorm"
+)
+
+type PostgreSQLDatabase struct {
+	db *gorm.DB
+}
+
+func NewPostgreSQL(db *gorm.DB) *PostgreSQLDatabase {
+	return &PostgreSQLDatabase{
+		db: db,
+	}
+}
+
+func (p *PostgreSQLDatabase) CreateCertification(ctx context.Context, certification *model.Certification) error {
+	if err := p.db.WithContext(ctx).Create(certification).Error; err != nil {
+		return errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), err)
+	}
+
+	return nil
+}
+
+func (p *PostgreSQLDatabase) GetCertification(ctx context.Context, ownerID, certificationID uint) (*model.Certification, error) {
+	certification := &model.Certification{}
+	result := p.db.WithContext(ctx).Where("id = ? AND owner_id = ?", certificationID, ownerID).First(certification)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, errorx.ErrNotFound
+		}
+		return nil, errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+
+	return certification, nil
+}
+
+func (p *PostgreSQLDatabase) ListCertifications(ctx context.Context, ownerID uint) ([]model.Certification, error) {
+	var certifications []model.Certification
+	result := p.db.WithContext(ctx).Where("owner_id = ?", ownerID).Order("issued_on DESC, id").Find(&certifications)
+	if result.Error != nil {
+		return nil, errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+
+	return certifications, nil
+}
+
+func (p *PostgreSQLDatabase) UpdateCertification(ctx context.Context, certification *model.Certification) error {
+	result := p.db.WithContext(ctx).Model(certification).
+		Where("owner_id = ?", certification.OwnerID).
+		Select("*").Omit("id", "owner_id", "created_at").
+		Updates(certification)
+	if result.Error != nil {
+		return errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return errorx.ErrNotFound
+	}
+
+	return nil
+}
+
+func (p *PostgreSQLDatabase) DeleteCertification(ctx context.Context, ownerID, certificationID uint) error {
+	result := p.db.WithContext(ctx).Where("id = ? AND owner_id = ?", certificationID, ownerID).Delete(&model.Certification{})
+	if result.Error != nil {
+		return errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return errorx.ErrNotFound
+	}
+
+	return nil
+}
+
+func (p *PostgreSQLDatabase) ListExpiringCertifications(ctx context.Context, from, until model.Date) ([]model.Certification, error) {
+	var certifications []model.Certification
+	result := p.db.WithContext(ctx).
+		Where("expires_on BETWEEN ? AND ? AND reminder_sent_at IS NULL", from, until).
+		Order("expires_on").
+		Find(&certifications)
+	if result.Error != nil {
+		return nil, errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+
+	return certifications, nil
+}
+
+func (p *PostgreSQLDatabase) MarkReminderSent(ctx context.Context, certificationID uint, sentAt time.Time) (bool, error) {
+	result := p.db.WithContext(ctx).Model(&model.Certification{}).
+		Where("id = ? AND reminder_sent_at IS NULL", certificationID).
+		Update("reminder_sent_at", sentAt)
+	if result.Error != nil {
+		return false, errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+func (p *PostgreSQLDatabase) ClearReminderSent(ctx context.Context, certificationID uint) error {
+	result := p.db.WithContext(ctx).Model(&model.Certification{}).
+		Where("id = ?", certificationID).
+		Update("reminder_sent_at", nil)
+	if result.Error != nil {
+		return errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+
+	return nil
+}
+
+func (p *PostgreSQLDatabase) GetOwner(ctx context.Context, ownerID uint) (*model.Owner, error) {
+	ownerAccount := &model.OwnerAccount{}
+	result := p.db.WithContext(ctx).Where("id = ?", ownerID).First(ownerAccount)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, errorx.ErrNotFound
+		}
+		return nil, errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+
+	return &ownerAccount.Owner, nil
+}
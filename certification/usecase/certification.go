@@ -0,0 +1,267 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"devoratio.dev/web-resume/config"
+	"devoratio.dev/web-resume/internal/authz"
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/internal/mailer"
+	"devoratio.dev/web-resume/internal/policy"
+	"devoratio.dev/web-resume/model"
+)
+
+const (
+	// ExpiredHide leaves expired credentials out of the public resume, any
+	// other setting shows them marked as expired
+	ExpiredHide  = "hide"
+	ExpiredLabel = "label"
+
+	invalidCertificationMessage = "certification is invalid"
+
+	maxNameLength = 100
+)
+
+const reminderEmailBody = `Hi %s,
+
+Your %s certification issued by %s expires on %s.
+
+Renew it in time to keep it on your resume.
+`
+
+//go:generate mockgen -destination=repositorymock/postgresqlmock.go -package=repositorymock . CertificationRepository
+type CertificationRepository interface {
+	CreateCertification(ctx context.Context, certification *model.Certification) error
+	GetCertification(ctx context.Context, ownerID, certificationID uint) (*model.Certification, error)
+	ListCertifications(ctx context.Context, ownerID uint) ([]model.Certification, error)
+	UpdateCertification(ctx context.Context, certification *model.Certification) error
+	DeleteCertification(ctx context.Context, ownerID, certificationID uint) error
+	// ListExpiringCertifications returns the certifications of every owner
+	// expiring between from and until, both included, whose owner hasn't been
+	// reminded yet
+	ListExpiringCertifications(ctx context.Context, from, until model.Date) ([]model.Certification, error)
+	// MarkReminderSent records the reminder unless another one already did,
+	// reporting whether it was recorded
+	MarkReminderSent(ctx context.Context, certificationID uint, sentAt time.Time) (bool, error)
+	ClearReminderSent(ctx context.Context, certificationID uint) error
+	GetOwner(ctx context.Context, ownerID uint) (*model.Owner, error)
+}
+
+//go:generate mockgen -destination=notifiermock/notifiermock.go -package=notifiermock . Mailer
+type Mailer interface {
+	Send(ctx context.Context, message mailer.Message) error
+}
+
+type Certification struct {
+	certificationRepo CertificationRepository
+	mailer            Mailer
+	now               func() time.Time
+	appConfig         *config.Application
+}
+
+// NewUsecase creates the certification usecase, now tells which credentials
+// have expired and which are about to
+func NewUsecase(certificationRepo CertificationRepository, mailer Mailer, now func() time.Time, appConfig *config.Application) *Certification {
+	return &Certification{
+		certificationRepo: certificationRepo,
+		mailer:            mailer,
+		now:               now,
+		appConfig:         appConfig,
+	}
+}
+
+func (c *Certification) Create(ctx context.Context, claim model.Claim, request model.CertificationRequest) (*model.Certification, error) {
+	if err := authz.RequireScope(claim, model.ScopeResumeWrite); err != nil {
+		return nil, err
+	}
+
+	certification := &model.Certification{OwnerID: claim.UserID}
+	if err := c.apply(certification, request); err != nil {
+		return nil, err
+	}
+
+	if err := c.certificationRepo.CreateCertification(ctx, certification); err != nil {
+		return nil, err
+	}
+
+	return certification, nil
+}
+
+func (c *Certification) Get(ctx context.Context, claim model.Claim, certificationID uint) (*model.Certification, error) {
+	certification, err := c.certificationRepo.GetCertification(ctx, claim.UserID, certificationID)
+	if err != nil {
+		return nil, err
+	}
+
+	certification.Expired = IsExpired(*certification, model.DateOf(c.now()))
+	return certification, nil
+}
+
+// List returns every certification of the owner, expired ones included, most
+// recently issued first
+func (c *Certification) List(ctx context.Context, claim model.Claim) ([]model.Certification, error) {
+	certifications, err := c.certificationRepo.ListCertifications(ctx, claim.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	Sort(certifications)
+	today := model.DateOf(c.now())
+	for i := range certifications {
+		certifications[i].Expired = IsExpired(certifications[i], today)
+	}
+
+	return certifications, nil
+}
+
+func (c *Certification) Update(ctx context.Context, claim model.Claim, certificationID uint, request model.CertificationRequest) (*model.Certification, error) {
+	if err := authz.RequireScope(claim, model.ScopeResumeWrite); err != nil {
+		return nil, err
+	}
+
+	certification, err := c.certificationRepo.GetCertification(ctx, claim.UserID, certificationID)
+	if err != nil {
+		return nil, err
+	}
+
+	expiresOn := certification.ExpiresOn
+	if err := c.apply(certification, request); err != nil {
+		return nil, err
+	}
+	// A renewed credential is to be reminded of again
+	if (expiresOn == nil) != (certification.ExpiresOn == nil) || (expiresOn != nil && *expiresOn != *certification.ExpiresOn) {
+		certification.ReminderSentAt = nil
+	}
+
+	if err := c.certificationRepo.UpdateCertification(ctx, certification); err != nil {
+		return nil, err
+	}
+
+	return certification, nil
+}
+
+func (c *Certification) Delete(ctx context.Context, claim model.Claim, certificationID uint) error {
+	if err := authz.RequireScope(claim, model.ScopeResumeWrite); err != nil {
+		return err
+	}
+
+	return c.certificationRepo.DeleteCertification(ctx, claim.UserID, certificationID)
+}
+
+// SendExpiryReminders emails the owners of the credentials expiring within
+// the configured number of days. Each credential is reminded of once, unless
+// sending the reminder fails, in which case it is tried again on the next
+// run.
+func (c *Certification) SendExpiryReminders(ctx context.Context) error {
+	reminderDays := c.appConfig.Usecase.Certification.ReminderDays
+	if reminderDays <= 0 {
+		return nil
+	}
+
+	now := c.now()
+	today := model.DateOf(now)
+	certifications, err := c.certificationRepo.ListExpiringCertifications(ctx, today, today.AddDays(reminderDays))
+	if err != nil {
+		return err
+	}
+
+	for _, certification := range certifications {
+		// Another instance of the service may be sending the same reminders
+		marked, err := c.certificationRepo.MarkReminderSent(ctx, certification.ID, now)
+		if err != nil {
+			return err
+		}
+		if !marked {
+			continue
+		}
+
+		if err := c.remind(ctx, certification); err != nil {
+			log.Printf("failed to remind owner %d of certification %d expiring: %s", certification.OwnerID, certification.ID, err)
+			if err := c.certificationRepo.ClearReminderSent(ctx, certification.ID); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (c *Certification) remind(ctx context.Context, certification model.Certification) error {
+	owner, err := c.certificationRepo.GetOwner(ctx, certification.OwnerID)
+	if err != nil {
+		return err
+	}
+
+	return c.mailer.Send(ctx, mailer.Message{
+		To:      owner.Email,
+		Subject: fmt.Sprintf("Your %s certification expires soon", certification.Name),
+		Body: fmt.Sprintf(reminderEmailBody, owner.FullName(), certification.Name, certification.Issuer,
+			certification.ExpiresOn.Time().Format("January 2, 2006")),
+	})
+}
+
+// IsExpired reports whether certification expired before today
+func IsExpired(certification model.Certification, today model.Date) bool {
+	return certification.ExpiresOn != nil && certification.ExpiresOn.Before(today)
+}
+
+// Sort orders certifications most recently issued first
+func Sort(certifications []model.Certification) {
+	slices.SortStableFunc(certifications, func(a, b model.Certification) int {
+		return b.IssuedOn.Time().Compare(a.IssuedOn.Time())
+	})
+}
+
+// apply validates request and copies it onto certification
+func (c *Certification) apply(certification *model.Certification, request model.CertificationRequest) error {
+	certification.Name = strings.TrimSpace(request.Name)
+	certification.Issuer = strings.TrimSpace(request.Issuer)
+	certification.CredentialID = strings.TrimSpace(request.CredentialID)
+	certification.VerificationURL = strings.TrimSpace(request.VerificationURL)
+	certification.IssuedOn = request.IssuedOn
+	certification.ExpiresOn = request.ExpiresOn
+	certification.Expired = IsExpired(*certification, model.DateOf(c.now()))
+	certification.UpdatedAt = time.Now()
+
+	details := map[string]interface{}{}
+
+	for field, value := range map[string]string{
+		"name":   certification.Name,
+		"issuer": certification.Issuer,
+	} {
+		if value == "" {
+			details[field] = "must not be empty"
+		} else if utf8.RuneCountInString(value) > maxNameLength {
+			details[field] = fmt.Sprintf("must not be longer than %d characters", maxNameLength)
+		}
+	}
+	if utf8.RuneCountInString(certification.CredentialID) > maxNameLength {
+		details["credential_id"] = fmt.Sprintf("must not be longer than %d characters", maxNameLength)
+	}
+	if certification.VerificationURL != "" && !policy.IsWebURL(certification.VerificationURL) {
+		details["verification_url"] = "must be an http or https URL"
+	}
+
+	if certification.IssuedOn.IsZero() {
+		details["issued_on"] = "must not be empty"
+	} else if certification.IssuedOn.After(model.DateOf(c.now())) {
+		details["issued_on"] = "must not be in the future"
+	}
+	if certification.ExpiresOn != nil && !certification.ExpiresOn.After(certification.IssuedOn) {
+		details["expires_on"] = "must be after issued_on"
+	}
+
+	if len(details) > 0 {
+		err := errorx.New(errorx.TypeInvalidParameter, invalidCertificationMessage, nil)
+		err.Details = details
+		return err
+	}
+
+	return nil
+}
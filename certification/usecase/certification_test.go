@@ -0,0 +1,204 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"devoratio.dev/web-resume/certification/usecase"
+	"devoratio.dev/web-resume/certification/usecase/notifiermock"
+	"devoratio.dev/web-resume/certification/usecase/repositorymock"
+	"devoratio.dev/web-resume/config"
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/internal/mailer"
+	"devoratio.dev/web-resume/model"
+)
+
+var _ = Describe("Certification", Label("certification"), func() {
+	var (
+		mockController *gomock.Controller
+
+		certificationRepoMock *repositorymock.MockCertificationRepository
+		mailerMock            *notifiermock.MockMailer
+
+		certificationUsecase *usecase.Certification
+		appConfig            *config.Application
+		now                  time.Time
+		commonCtx            context.Context
+		claim                model.Claim
+		request              model.CertificationRequest
+	)
+
+	BeforeEach(func() {
+		mockController = gomock.NewController(GinkgoT())
+
+		certificationRepoMock = repositorymock.NewMockCertificationRepository(mockController)
+		mailerMock = notifiermock.NewMockMailer(mockController)
+
+		appConfig = &config.Application{
+			Usecase: config.Usecase{
+				Certification: config.Certification{ReminderDays: 30, Expired: usecase.ExpiredLabel},
+			},
+		}
+		now = time.Date(2024, time.June, 15, 9, 0, 0, 0, time.UTC)
+		certificationUsecase = usecase.NewUsecase(certificationRepoMock, mailerMock, func() time.Time { return now }, appConfig)
+
+		claim = model.Claim{UserID: 1, Username: "devoratio", Scopes: []string{model.ScopeResumeWrite}}
+		expiresOn := model.NewDate(2027, time.March, 1)
+		request = model.CertificationRequest{
+			Name:            "Certified Kubernetes Administrator",
+			Issuer:          "The Linux Foundation",
+			CredentialID:    " LF-123456 ",
+			VerificationURL: "https://training.linuxfoundation.org/certification/verify",
+			IssuedOn:        model.NewDate(2024, time.March, 1),
+			ExpiresOn:       &expiresOn,
+		}
+
+		commonCtx = context.Background()
+	})
+
+	AfterEach(func() {
+		mockController.Finish()
+	})
+
+	Describe("Create a certification", func() {
+		When("the certification is invalid", func() {
+			It("tells the owner which fields are invalid", func(ctx SpecContext) {
+				expiresOn := model.NewDate(2023, time.January, 1)
+				request.Issuer = ""
+				request.VerificationURL = "not a url"
+				request.ExpiresOn = &expiresOn
+
+				result, err := certificationUsecase.Create(commonCtx, claim, request)
+				Expect(err.(*errorx.Error).Type).Should(Equal(errorx.TypeInvalidParameter))
+				Expect(err.(*errorx.Error).Details).Should(HaveKey("issuer"))
+				Expect(err.(*errorx.Error).Details).Should(HaveKey("verification_url"))
+				Expect(err.(*errorx.Error).Details).Should(HaveKeyWithValue("expires_on", "must be after issued_on"))
+				Expect(result).Should(BeNil())
+			}, SpecTimeout(time.Second*2))
+		})
+
+		When("the certification is valid", func() {
+			It("stores the cleaned up certification", func(ctx SpecContext) {
+				certificationRepoMock.EXPECT().CreateCertification(commonCtx, gomock.Any()).Return(nil).Times(1)
+
+				result, err := certificationUsecase.Create(commonCtx, claim, request)
+				Expect(err).Should(BeNil())
+				Expect(result.OwnerID).Should(Equal(claim.UserID))
+				Expect(result.CredentialID).Should(Equal("LF-123456"))
+				Expect(result.Expired).Should(BeFalse())
+			}, SpecTimeout(time.Second*2))
+		})
+	})
+
+	Describe("Update a certification", func() {
+		When("the credential is renewed", func() {
+			It("reminds the owner again before the new expiry", func(ctx SpecContext) {
+				sentAt := now.AddDate(0, -1, 0)
+				expiresOn := model.NewDate(2024, time.July, 1)
+				certificationRepoMock.EXPECT().GetCertification(commonCtx, claim.UserID, uint(3)).Return(&model.Certification{
+					ID: 3, OwnerID: claim.UserID, ExpiresOn: &expiresOn, ReminderSentAt: &sentAt,
+				}, nil).Times(1)
+				certificationRepoMock.EXPECT().UpdateCertification(commonCtx, gomock.Any()).Return(nil).Times(1)
+
+				result, err := certificationUsecase.Update(commonCtx, claim, 3, request)
+				Expect(err).Should(BeNil())
+				Expect(result.ReminderSentAt).Should(BeNil())
+			}, SpecTimeout(time.Second*2))
+		})
+
+		When("the expiry stays the same", func() {
+			It("keeps the reminder", func(ctx SpecContext) {
+				sentAt := now.AddDate(0, -1, 0)
+				expiresOn := *request.ExpiresOn
+				certificationRepoMock.EXPECT().GetCertification(commonCtx, claim.UserID, uint(3)).Return(&model.Certification{
+					ID: 3, OwnerID: claim.UserID, ExpiresOn: &expiresOn, ReminderSentAt: &sentAt,
+				}, nil).Times(1)
+				certificationRepoMock.EXPECT().UpdateCertification(commonCtx, gomock.Any()).Return(nil).Times(1)
+
+				result, err := certificationUsecase.Update(commonCtx, claim, 3, request)
+				Expect(err).Should(BeNil())
+				Expect(result.ReminderSentAt).Should(Equal(&sentAt))
+			}, SpecTimeout(time.Second*2))
+		})
+	})
+
+	Describe("List the certifications", func() {
+		It("marks the expired ones, most recently issued first", func(ctx SpecContext) {
+			expired := model.NewDate(2024, time.June, 14)
+			certificationRepoMock.EXPECT().ListCertifications(commonCtx, claim.UserID).Return([]model.Certification{
+				{ID: 1, IssuedOn: model.NewDate(2021, time.June, 14), ExpiresOn: &expired},
+				{ID: 2, IssuedOn: model.NewDate(2023, time.January, 1)},
+			}, nil).Times(1)
+
+			result, err := certificationUsecase.List(commonCtx, claim)
+			Expect(err).Should(BeNil())
+			Expect(result[0].ID).Should(Equal(uint(2)))
+			Expect(result[0].Expired).Should(BeFalse())
+			Expect(result[1].Expired).Should(BeTrue())
+		}, SpecTimeout(time.Second*2))
+	})
+
+	Describe("Send expiry reminders", func() {
+		var expiring model.Certification
+
+		BeforeEach(func() {
+			expiresOn := model.NewDate(2024, time.July, 1)
+			expiring = model.Certification{ID: 3, OwnerID: 1, Name: "CKA", Issuer: "The Linux Foundation", ExpiresOn: &expiresOn}
+		})
+
+		When("reminders are turned off", func() {
+			It("does not look for expiring credentials", func(ctx SpecContext) {
+				appConfig.Usecase.Certification.ReminderDays = 0
+
+				Expect(certificationUsecase.SendExpiryReminders(commonCtx)).Should(Succeed())
+			}, SpecTimeout(time.Second*2))
+		})
+
+		When("a credential expires within the reminder days", func() {
+			It("emails its owner", func(ctx SpecContext) {
+				certificationRepoMock.EXPECT().ListExpiringCertifications(commonCtx,
+					model.NewDate(2024, time.June, 15), model.NewDate(2024, time.July, 15)).
+					Return([]model.Certification{expiring}, nil).Times(1)
+				certificationRepoMock.EXPECT().MarkReminderSent(commonCtx, uint(3), now).Return(true, nil).Times(1)
+				certificationRepoMock.EXPECT().GetOwner(commonCtx, uint(1)).
+					Return(&model.Owner{ID: 1, FistName: "Andre", LastName: "Febrianto", Email: "owner@devoratio.dev"}, nil).Times(1)
+				mailerMock.EXPECT().Send(commonCtx, gomock.Any()).DoAndReturn(
+					func(_ context.Context, message mailer.Message) error {
+						Expect(message.To).Should(Equal("owner@devoratio.dev"))
+						Expect(message.Body).Should(ContainSubstring("July 1, 2024"))
+						return nil
+					}).Times(1)
+
+				Expect(certificationUsecase.SendExpiryReminders(commonCtx)).Should(Succeed())
+			}, SpecTimeout(time.Second*2))
+		})
+
+		When("another instance already sent the reminder", func() {
+			It("does not email the owner twice", func(ctx SpecContext) {
+				certificationRepoMock.EXPECT().ListExpiringCertifications(commonCtx, gomock.Any(), gomock.Any()).
+					Return([]model.Certification{expiring}, nil).Times(1)
+				certificationRepoMock.EXPECT().MarkReminderSent(commonCtx, uint(3), now).Return(false, nil).Times(1)
+
+				Expect(certificationUsecase.SendExpiryReminders(commonCtx)).Should(Succeed())
+			}, SpecTimeout(time.Second*2))
+		})
+
+		When("the reminder cannot be sent", func() {
+			It("tries again on the next run", func(ctx SpecContext) {
+				certificationRepoMock.EXPECT().ListExpiringCertifications(commonCtx, gomock.Any(), gomock.Any()).
+					Return([]model.Certification{expiring}, nil).Times(1)
+				certificationRepoMock.EXPECT().MarkReminderSent(commonCtx, uint(3), now).Return(true, nil).Times(1)
+				certificationRepoMock.EXPECT().GetOwner(commonCtx, uint(1)).Return(&model.Owner{ID: 1}, nil).Times(1)
+				mailerMock.EXPECT().Send(commonCtx, gomock.Any()).Return(errors.New("mail server unavailable")).Times(1)
+				certificationRepoMock.EXPECT().ClearReminderSent(commonCtx, uint(3)).Return(nil).Times(1)
+
+				Expect(certificationUsecase.SendExpiryReminders(commonCtx)).Should(Succeed())
+			}, SpecTimeout(time.Second*2))
+		})
+	})
+})
@@ -0,0 +1,168 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: devoratio.dev/web-resume/certification/usecase (interfaces: CertificationRepository)
+
+// Package repositorymock is a generated GoMock package.
+package repositorymock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	model "devoratio.dev/web-resume/model"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockCertificationRepository is a mock of CertificationRepository interface.
+type MockCertificationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCertificationRepositoryMockRecorder
+}
+
+// MockCertificationRepositoryMockRecorder is the mock recorder for MockCertificationRepository.
+type MockCertificationRepositoryMockRecorder struct {
+	mock *MockCertificationRepository
+}
+
+// NewMockCertificationRepository creates a new mock instance.
+func NewMockCertificationRepository(ctrl *gomock.Controller) *MockCertificationRepository {
+	mock := &MockCertificationRepository{ctrl: ctrl}
+	mock.recorder = &MockCertificationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCertificationRepository) EXPECT() *MockCertificationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ClearReminderSent mocks base method.
+func (m *MockCertificationRepository) ClearReminderSent(arg0 context.Context, arg1 uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearReminderSent", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClearReminderSent indicates an expected call of ClearReminderSent.
+func (mr *MockCertificationRepositoryMockRecorder) ClearReminderSent(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearReminderSent", reflect.TypeOf((*MockCertificationRepository)(nil).ClearReminderSent), arg0, arg1)
+}
+
+// CreateCertification mocks base method.
+func (m *MockCertificationRepository) CreateCertification(arg0 context.Context, arg1 *model.Certification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCertification", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateCertification indicates an expected call of CreateCertification.
+func (mr *MockCertificationRepositoryMockRecorder) CreateCertification(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCertification", reflect.TypeOf((*MockCertificationRepository)(nil).CreateCertification), arg0, arg1)
+}
+
+// DeleteCertification mocks base method.
+func (m *MockCertificationRepository) DeleteCertification(arg0 context.Context, arg1, arg2 uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCertification", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCertification indicates an expected call of DeleteCertification.
+func (mr *MockCertificationRepositoryMockRecorder) DeleteCertification(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCertification", reflect.TypeOf((*MockCertificationRepository)(nil).DeleteCertification), arg0, arg1, arg2)
+}
+
+// GetCertification mocks base method.
+func (m *MockCertificationRepository) GetCertification(arg0 context.Context, arg1, arg2 uint) (*model.Certification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCertification", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*model.Certification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCertification indicates an expected call of GetCertification.
+func (mr *MockCertificationRepositoryMockRecorder) GetCertification(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCertification", reflect.TypeOf((*MockCertificationRepository)(nil).GetCertification), arg0, arg1, arg2)
+}
+
+// GetOwner mocks base method.
+func (m *MockCertificationRepository) GetOwner(arg0 context.Context, arg1 uint) (*model.Owner, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOwner", arg0, arg1)
+	ret0, _ := ret[0].(*model.Owner)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOwner indicates an expected call of GetOwner.
+func (mr *MockCertificationRepositoryMockRecorder) GetOwner(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOwner", reflect.TypeOf((*MockCertificationRepository)(nil).GetOwner), arg0, arg1)
+}
+
+// ListCertifications mocks base method.
+func (m *MockCertificationRepository) ListCertifications(arg0 context.Context, arg1 uint) ([]model.Certification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCertifications", arg0, arg1)
+	ret0, _ := ret[0].([]model.Certification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCertifications indicates an expected call of ListCertifications.
+func (mr *MockCertificationRepositoryMockRecorder) ListCertifications(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCertifications", reflect.TypeOf((*MockCertificationRepository)(nil).ListCertifications), arg0, arg1)
+}
+
+// ListExpiringCertifications mocks base method.
+func (m *MockCertificationRepository) ListExpiringCertifications(arg0 context.Context, arg1, arg2 model.Date) ([]model.Certification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListExpiringCertifications", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]model.Certification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListExpiringCertifications indicates an expected call of ListExpiringCertifications.
+func (mr *MockCertificationRepositoryMockRecorder) ListExpiringCertifications(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExpiringCertifications", reflect.TypeOf((*MockCertificationRepository)(nil).ListExpiringCertifications), arg0, arg1, arg2)
+}
+
+// MarkReminderSent mocks base method.
+func (m *MockCertificationRepository) MarkReminderSent(arg0 context.Context, arg1 uint, arg2 time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkReminderSent", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkReminderSent indicates an expected call of MarkReminderSent.
+func (mr *MockCertificationRepositoryMockRecorder) MarkReminderSent(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkReminderSent", reflect.TypeOf((*MockCertificationRepository)(nil).MarkReminderSent), arg0, arg1, arg2)
+}
+
+// UpdateCertification mocks base method.
+func (m *MockCertificationRepository) UpdateCertification(arg0 context.Context, arg1 *model.Certification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCertification", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCertification indicates an expected call of UpdateCertification.
+func (mr *MockCertificationRepositoryMockRecorder) UpdateCertification(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCertification", reflect.TypeOf((*MockCertificationRepository)(nil).UpdateCertification), arg0, arg1)
+}
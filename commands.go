@@ -0,0 +1,68 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	authenticationrepository "devoratio.dev/web-resume/authentication/repository"
+	authenticationusecase "devoratio.dev/web-resume/authentication/usecase"
+	"devoratio.dev/web-resume/config"
+	emailverificationrepository "devoratio.dev/web-resume/emailverification/repository"
+	emailverificationusecase "devoratio.dev/web-resume/emailverification/usecase"
+	"devoratio.dev/web-resume/internal/initializer/database"
+	"devoratio.dev/web-resume/internal/mailer"
+	"devoratio.dev/web-resume/model"
+	setuprepository "devoratio.dev/web-resume/setup/repository"
+	setupusecase "devoratio.dev/web-resume/setup/usecase"
+)
+
+const ownerPasswordEnv = "WEBRESUME_OWNER_PASSWORD"
+
+func migrate(appConfig *config.Application) error {
+	return database.Migrate(appConfig.Service.PostgreSQL)
+}
+
+func setup(ctx context.Context, appConfig *config.Application, args []string) error {
+	var registration model.OwnerRegistration
+
+	flags := flag.NewFlagSet("setup", flag.ExitOnError)
+	flags.StringVar(&registration.Username, "username", "", "owner username")
+	flags.StringVar(&registration.FirstName, "first-name", "", "owner first name")
+	flags.StringVar(&registration.LastName, "last-name", "", "owner last name")
+	flags.StringVar(&registration.Email, "email", "", "owner email address")
+	flags.Parse(args)
+
+	// Never accept the password as a flag, it would end up in the shell history
+	registration.Password = os.Getenv(ownerPasswordEnv)
+	if registration.Password == "" {
+		fmt.Fprint(os.Stderr, "password: ")
+		line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+		if err != nil {
+			return err
+		}
+		registration.Password = strings.TrimRight(line, "\r\n")
+	}
+
+	mail, err := mailer.New(appConfig.Service.Mailer)
+	if err != nil {
+		return err
+	}
+
+	db := database.PostgreSQL(appConfig.Service.PostgreSQL)
+	authenticationUsecase := authenticationusecase.NewUsecase(authenticationrepository.NewPostgreSQL(db, appConfig.Authentication))
+	emailVerificationUsecase := emailverificationusecase.NewUsecase(authenticationUsecase, emailverificationrepository.NewPostgreSQL(db), mail, appConfig)
+	setupUsecase := setupusecase.NewUsecase(setuprepository.NewPostgreSQL(db), emailVerificationUsecase, "")
+
+	owner, err := setupUsecase.CreateFirstOwner(ctx, registration)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("owner %s has been created, setup is now locked", owner.Username)
+	return nil
+}
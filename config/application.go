@@ -3,13 +3,24 @@ package config
 import "time"
 
 type Application struct {
+	Server         Server         `mapstructure:"server"`
 	Service        Service        `mapstructure:"service"`
 	Usecase        Usecase        `mapstructure:"usecase"`
 	Authentication Authentication `mapstructure:"authentication"`
+	Tenancy        Tenancy        `mapstructure:"tenancy"`
+	AccessControl  AccessControl  `mapstructure:"accesscontrol"`
+}
+
+type Server struct {
+	Address         string        `mapstructure:"address"`
+	ReadTimeout     time.Duration `mapstructure:"readtimeout"`
+	WriteTimeout    time.Duration `mapstructure:"writetimeout"`
+	ShutdownTimeout time.Duration `mapstructure:"shutdowntimeout"`
 }
 
 type Service struct {
 	PostgreSQL PostgreSQL `mapstructure:"postgresql"`
+	Mailer     Mailer     `mapstructure:"mailer"`
 }
 
 type PostgreSQL struct {
@@ -38,9 +49,162 @@ type DatabaseCredential struct {
 	Password string `mapstructure:"password"`
 }
 
+type Mailer struct {
+	// Driver is one of smtp, file or log
+	Driver    string `mapstructure:"driver"`
+	From      string `mapstructure:"from"`
+	SMTP      SMTP   `mapstructure:"smtp"`
+	Directory string `mapstructure:"directory"`
+}
+
+type SMTP struct {
+	Host     string `mapstructure:"host"`
+	Port     string `mapstructure:"port"`
+	Username string `mapstructure:"username"`
+	Password string `mapstructure:"password"`
+	// Timeout bounds the delivery of a message, from connecting to quitting
+	Timeout time.Duration `mapstructure:"timeout"`
+}
+
 type Usecase struct {
+	Login             Login             `mapstructure:"login"`
+	MagicLink         MagicLink         `mapstructure:"magic-link"`
+	PasswordReset     PasswordReset     `mapstructure:"password-reset"`
+	EmailVerification EmailVerification `mapstructure:"email-verification"`
+	LoginAlert        LoginAlert        `mapstructure:"login-alert"`
+	Certification     Certification     `mapstructure:"certification"`
+}
+
+type Login struct {
+	// RateLimit is shared by every sign-in method and password reset requests
+	RateLimit RateLimit `mapstructure:"ratelimit"`
+}
+
+type RateLimit struct {
+	// Attempts allowed per identifier within Window
+	Attempts int           `mapstructure:"attempts"`
+	Window   time.Duration `mapstructure:"window"`
+}
+
+type MagicLink struct {
+	TokenTTL time.Duration `mapstructure:"tokenttl"`
+	// URL of the page exchanging the sign-in token, the token is appended as
+	// the token query parameter
+	URL string `mapstructure:"url"`
+}
+
+type PasswordReset struct {
+	TokenTTL time.Duration `mapstructure:"tokenttl"`
+	// URL of the page that lets the owner pick a new password, the reset
+	// token is appended as the token query parameter
+	URL string `mapstructure:"url"`
+}
+
+type EmailVerification struct {
+	TokenTTL time.Duration `mapstructure:"tokenttl"`
+	// URL of the page confirming the email address, the verification token is
+	// appended as the token query parameter
+	URL string `mapstructure:"url"`
+}
+
+type LoginAlert struct {
+	Enabled bool `mapstructure:"enabled"`
+	// Channel is either mail or webhook
+	Channel  string        `mapstructure:"channel"`
+	TokenTTL time.Duration `mapstructure:"tokenttl"`
+	// URL of the page reporting a sign-in that wasn't the owner, the report
+	// token is appended as the token query parameter
+	URL string `mapstructure:"url"`
+	// GeoIPDatabase is the path of a DB-IP "IP to City Lite" CSV file used to
+	// approximate the location of a sign-in, locations are left out when empty
+	GeoIPDatabase string  `mapstructure:"geoipdatabase"`
+	Webhook       Webhook `mapstructure:"webhook"`
+}
+
+type Certification struct {
+	// ReminderDays is how many days before a credential expires the owner is
+	// reminded of it, no reminders are sent when zero
+	ReminderDays int `mapstructure:"reminderdays"`
+	// ReminderInterval is how often credentials about to expire are looked for
+	ReminderInterval time.Duration `mapstructure:"reminderinterval"`
+	// Expired is either hide or label, hiding expired credentials from the
+	// public resume or showing them marked as expired
+	Expired string `mapstructure:"expired"`
+}
+
+type Webhook struct {
+	URL string `mapstructure:"url"`
+	// Secret signs the request body, see webhook.SignatureHeader
+	Secret  string        `mapstructure:"secret"`
+	Timeout time.Duration `mapstructure:"timeout"`
 }
 
 type Authentication struct {
 	SigningKey []byte `mapstructure:"signingkey"`
+	// VerifiedEmailOnly only lets the owner sign in with their email address
+	// once it has been verified, the username keeps working regardless
+	VerifiedEmailOnly bool `mapstructure:"verifiedemailonly"`
+	// RefreshTokenTTL is how long a session survives without being refreshed
+	RefreshTokenTTL time.Duration `mapstructure:"refreshtokenttl"`
+	OAuth           OAuth         `mapstructure:"oauth"`
+	// Modes lists how tokens are handed to clients, bearer in the response body
+	// and cookie as HttpOnly cookies paired with a CSRF token
+	Modes  []string `mapstructure:"modes"`
+	Cookie Cookie   `mapstructure:"cookie"`
+}
+
+type Cookie struct {
+	// SameSite is either strict or lax
+	SameSite string `mapstructure:"samesite"`
+}
+
+type OAuth struct {
+	// StateTTL is how long the owner has to go through a provider's
+	// authorization page
+	StateTTL  time.Duration   `mapstructure:"statettl"`
+	Providers []OAuthProvider `mapstructure:"providers"`
+}
+
+type OAuthProvider struct {
+	// Name is used in the URLs, such as github in /v1/login/oauth/github
+	Name string `mapstructure:"name"`
+	// Type is one of github, google or oidc
+	Type         string `mapstructure:"type"`
+	ClientID     string `mapstructure:"clientid"`
+	ClientSecret string `mapstructure:"clientsecret"`
+	// RedirectURL is the page the provider sends the owner back to, it hands
+	// the code and state over to the callback endpoint
+	RedirectURL string `mapstructure:"redirecturl"`
+	// Issuer of an oidc provider, its endpoints are discovered from the
+	// issuer's well-known configuration
+	Issuer string `mapstructure:"issuer"`
+	// Scopes default to the ones needed to read the account when empty
+	Scopes []string `mapstructure:"scopes"`
+}
+
+type Tenancy struct {
+	// Enabled resolves the owner from the host of every request, requests on
+	// a host without a tenant are answered with not found
+	Enabled bool `mapstructure:"enabled"`
+	// BaseDomain serves the deployment itself, tenants are hosted on its
+	// subdomains such as jane.resume.example.com unless they have a custom host
+	BaseDomain string `mapstructure:"basedomain"`
+}
+
+type AccessControl struct {
+	// TrustedProxies are the CIDR ranges of the reverse proxies in front of the
+	// service, the client address is read from X-Forwarded-For behind them
+	TrustedProxies []string `mapstructure:"trustedproxies"`
+	// Login guards the sign-in routes and the unauthenticated routes around
+	// them: password reset, sign-in reports, setup and email confirmation
+	Login IPFilter `mapstructure:"login"`
+	// Owner guards the routes managing the owner's account and resume
+	Owner IPFilter `mapstructure:"owner"`
+}
+
+// IPFilter lists CIDR ranges or single addresses. Deny wins over Allow, and
+// every address is allowed when Allow is empty.
+type IPFilter struct {
+	Allow []string `mapstructure:"allow"`
+	Deny  []string `mapstructure:"deny"`
 }
@@ -3,6 +3,7 @@ package config
 import (
 	"context"
 	"log"
+	"reflect"
 
 	"github.com/mitchellh/mapstructure"
 	"github.com/spf13/viper"
@@ -14,6 +15,11 @@ func Load(ctx context.Context) (*Application, error) {
 		// Prevent service to bootup if configuration is missing
 		dc.ErrorUnset = true
 		dc.ErrorUnused = false
+		dc.DecodeHook = mapstructure.ComposeDecodeHookFunc(
+			stringToBytesHookFunc(),
+			mapstructure.StringToTimeDurationHookFunc(),
+			mapstructure.StringToSliceHookFunc(","),
+		)
 	})
 
 	if err != nil {
@@ -23,3 +29,15 @@ func Load(ctx context.Context) (*Application, error) {
 
 	return appConf, nil
 }
+
+// stringToBytesHookFunc keeps secrets such as the signing key as written,
+// instead of reading them as a list of numbers
+func stringToBytesHookFunc() mapstructure.DecodeHookFuncType {
+	return func(from reflect.Type, to reflect.Type, data interface{}) (interface{}, error) {
+		if from.Kind() != reflect.String || to != reflect.TypeOf([]byte(nil)) {
+			return data, nil
+		}
+
+		return []byte(data.(string)), nil
+	}
+}
@@ -0,0 +1,190 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/internal/httpx"
+	"devoratio.dev/web-resume/model"
+)
+
+type EducationUsecase interface {
+	Create(ctx context.Context, claim model.Claim, request model.EducationRequest) (*model.Education, error)
+	Get(ctx context.Context, claim model.Claim, educationID uint) (*model.Education, error)
+	List(ctx context.Context, claim model.Claim) ([]model.Education, error)
+	Update(ctx context.Context, claim model.Claim, educationID uint, request model.EducationRequest) (*model.Education, error)
+	Delete(ctx context.Context, claim model.Claim, educationID uint) error
+	Reorder(ctx context.Context, claim model.Claim, request model.OrderRequest) ([]model.Education, error)
+	ResetOrder(ctx context.Context, claim model.Claim) ([]model.Education, error)
+}
+
+type HTTP struct {
+	educationUsecase EducationUsecase
+}
+
+func NewHTTP(educationUsecase EducationUsecase) *HTTP {
+	return &HTTP{
+		educationUsecase: educationUsecase,
+	}
+}
+
+// RegisterRoutes registers the education routes, read is required to get the
+// education and write to change them
+func (h *HTTP) RegisterRoutes(mux *http.ServeMux, read, write httpx.Middleware) {
+	mux.Handle("GET /v1/education", read(http.HandlerFunc(h.list)))
+	mux.Handle("POST /v1/education", write(http.HandlerFunc(h.create)))
+	mux.Handle("PUT /v1/education/order", write(http.HandlerFunc(h.reorder)))
+	mux.Handle("DELETE /v1/education/order", write(http.HandlerFunc(h.resetOrder)))
+	mux.Handle("GET /v1/education/{id}", read(http.HandlerFunc(h.get)))
+	mux.Handle("PUT /v1/education/{id}", write(http.HandlerFunc(h.update)))
+	mux.Handle("DELETE /v1/education/{id}", write(http.HandlerFunc(h.delete)))
+}
+
+func (h *HTTP) list(w http.ResponseWriter, r *http.Request) {
+	claim, ok := httpx.ClaimFromContext(r.Context())
+	if !ok {
+		httpx.WriteError(w, errorx.ErrUnauthorized)
+		return
+	}
+
+	education, err := h.educationUsecase.List(r.Context(), *claim)
+	if err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, http.StatusOK, education)
+}
+
+func (h *HTTP) create(w http.ResponseWriter, r *http.Request) {
+	claim, ok := httpx.ClaimFromContext(r.Context())
+	if !ok {
+		httpx.WriteError(w, errorx.ErrUnauthorized)
+		return
+	}
+
+	var request model.EducationRequest
+	if err := httpx.DecodeJSON(w, r, &request); err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	education, err := h.educationUsecase.Create(r.Context(), *claim, request)
+	if err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, http.StatusCreated, education)
+}
+
+func (h *HTTP) get(w http.ResponseWriter, r *http.Request) {
+	claim, ok := httpx.ClaimFromContext(r.Context())
+	if !ok {
+		httpx.WriteError(w, errorx.ErrUnauthorized)
+		return
+	}
+
+	educationID, err := strconv.ParseUint(r.PathValue("id"), 10, 0)
+	if err != nil {
+		httpx.WriteError(w, errorx.ErrNotFound)
+		return
+	}
+
+	education, err := h.educationUsecase.Get(r.Context(), *claim, uint(educationID))
+	if err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, http.StatusOK, education)
+}
+
+func (h *HTTP) update(w http.ResponseWriter, r *http.Request) {
+	claim, ok := httpx.ClaimFromContext(r.Context())
+	if !ok {
+		httpx.WriteError(w, errorx.ErrUnauthorized)
+		return
+	}
+
+	educationID, err := strconv.ParseUint(r.PathValue("id"), 10, 0)
+	if err != nil {
+		httpx.WriteError(w, errorx.ErrNotFound)
+		return
+	}
+
+	var request model.EducationRequest
+	if err := httpx.DecodeJSON(w, r, &request); err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	education, err := h.educationUsecase.Update(r.Context(), *claim, uint(educationID), request)
+	if err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, http.StatusOK, education)
+}
+
+func (h *HTTP) delete(w http.ResponseWriter, r *http.Request) {
+	claim, ok := httpx.ClaimFromContext(r.Context())
+	if !ok {
+		httpx.WriteError(w, errorx.ErrUnauthorized)
+		return
+	}
+
+	educationID, err := strconv.ParseUint(r.PathValue("id"), 10, 0)
+	if err != nil {
+		httpx.WriteError(w, errorx.ErrNotFound)
+		return
+	}
+
+	if err := h.educationUsecase.Delete(r.Context(), *claim, uint(educationID)); err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	httpx.WriteNoContent(w)
+}
+
+func (h *HTTP) reorder(w http.ResponseWriter, r *http.Request) {
+	claim, ok := httpx.ClaimFromContext(r.Context())
+	if !ok {
+		httpx.WriteError(w, errorx.ErrUnauthorized)
+		return
+	}
+
+	var request model.OrderRequest
+	if err := httpx.DecodeJSON(w, r, &request); err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	education, err := h.educationUsecase.Reorder(r.Context(), *claim, request)
+	if err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, http.StatusOK, education)
+}
+
+func (h *HTTP) resetOrder(w http.ResponseWriter, r *http.Request) {
+	claim, ok := httpx.ClaimFromContext(r.Context())
+	if !ok {
+		httpx.WriteError(w, errorx.ErrUnauthorized)
+		return
+	}
+
+	education, err := h.educationUsecase.ResetOrder(r.Context(), *claim)
+	if err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, http.StatusOK, education)
+}
@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/model"
+	"gorm.io/gorm"
+)
+
+type PostgreSQLDatabase struct {
+	db *gorm.DB
+}
+
+func NewPostgreSQL(db *gorm.DB) *PostgreSQLDatabase {
+	return &PostgreSQLDatabase{
+		db: db,
+	}
+}
+
+func (p *PostgreSQLDatabase) CreateEducation(ctx context.Context, education *model.Education) error {
+	if err := p.db.WithContext(ctx).Create(education).Error; err != nil {
+		return errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), err)
+	}
+
+	return nil
+}
+
+func (p *PostgreSQLDatabase) GetEducation(ctx context.Context, ownerID, educationID uint) (*model.Education, error) {
+	education := &model.Education{}
+	result := p.db.WithContext(ctx).Where("id = ? AND owner_id = ?", educationID, ownerID).First(education)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, errorx.ErrNotFound
+		}
+		return nil, errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+
+	return education, nil
+}
+
+func (p *PostgreSQLDatabase) ListEducation(ctx context.Context, ownerID uint) ([]model.Education, error) {
+	var education []model.Education
+	result := p.db.WithContext(ctx).Where("owner_id = ?", ownerID).Order("id").Find(&education)
+	if result.Error != nil {
+		return nil, errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+
+	return education, nil
+}
+
+func (p *PostgreSQLDatabase) UpdateEducation(ctx context.Context, education *model.Education) error {
+	result := p.db.WithContext(ctx).Model(education).
+		Where("owner_id = ?", education.OwnerID).
+		Select("*").Omit("id", "owner_id", "position", "created_at").
+		Updates(education)
+	if result.Error != nil {
+		return errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return errorx.ErrNotFound
+	}
+
+	return nil
+}
+
+func (p *PostgreSQLDatabase) DeleteEducation(ctx context.Context, ownerID, educationID uint) error {
+	result := p.db.WithContext(ctx).Where("id = ? AND owner_id = ?", educationID, ownerID).Delete(&model.Education{})
+	if result.Error != nil {
+		return errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return errorx.ErrNotFound
+	}
+
+	return nil
+}
+
+func (p *PostgreSQLDatabase) SetEducationPositions(ctx context.Context, ownerID uint, ids []uint) error {
+	err := p.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&model.Education{}).Where("owner_id = ?", ownerID).Update("position", nil).Error
+		if err != nil {
+			return err
+		}
+
+		for position, id := range ids {
+			err := tx.Model(&model.Education{}).Where("id = ? AND owner_id = ?", id, ownerID).Update("position", position).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), err)
+	}
+
+	return nil
+}
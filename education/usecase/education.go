@@ -0,0 +1,230 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"devoratio.dev/web-resume/internal/authz"
+	"devoratio.dev/web-resume/internal/entry"
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/model"
+)
+
+const (
+	invalidEducationMessage = "education is invalid"
+	invalidOrderMessage     = "order must list every education exactly once"
+
+	maxNameLength       = 100
+	maxCoursework       = 30
+	maxCourseworkLength = 100
+	// maxGradeScale accepts percentages, the largest scale in common use
+	maxGradeScale = 100
+)
+
+//go:generate mockgen -destination=repositorymock/postgresqlmock.go -package=repositorymock . EducationRepository
+type EducationRepository interface {
+	CreateEducation(ctx context.Context, education *model.Education) error
+	GetEducation(ctx context.Context, ownerID, educationID uint) (*model.Education, error)
+	ListEducation(ctx context.Context, ownerID uint) ([]model.Education, error)
+	UpdateEducation(ctx context.Context, education *model.Education) error
+	DeleteEducation(ctx context.Context, ownerID, educationID uint) error
+	// SetEducationPositions stores the positions of the education of the
+	// owner, clearing them all when ids is empty
+	SetEducationPositions(ctx context.Context, ownerID uint, ids []uint) error
+}
+
+type Education struct {
+	educationRepo EducationRepository
+}
+
+func NewUsecase(educationRepo EducationRepository) *Education {
+	return &Education{
+		educationRepo: educationRepo,
+	}
+}
+
+func (e *Education) Create(ctx context.Context, claim model.Claim, request model.EducationRequest) (*model.Education, error) {
+	if err := authz.RequireScope(claim, model.ScopeResumeWrite); err != nil {
+		return nil, err
+	}
+
+	education := &model.Education{OwnerID: claim.UserID}
+	if err := apply(education, request); err != nil {
+		return nil, err
+	}
+
+	if err := e.educationRepo.CreateEducation(ctx, education); err != nil {
+		return nil, err
+	}
+
+	return education, nil
+}
+
+func (e *Education) Get(ctx context.Context, claim model.Claim, educationID uint) (*model.Education, error) {
+	return e.educationRepo.GetEducation(ctx, claim.UserID, educationID)
+}
+
+// List returns the education of the owner in the order it is shown
+func (e *Education) List(ctx context.Context, claim model.Claim) ([]model.Education, error) {
+	education, err := e.educationRepo.ListEducation(ctx, claim.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	Sort(education)
+	return education, nil
+}
+
+func (e *Education) Update(ctx context.Context, claim model.Claim, educationID uint, request model.EducationRequest) (*model.Education, error) {
+	if err := authz.RequireScope(claim, model.ScopeResumeWrite); err != nil {
+		return nil, err
+	}
+
+	education, err := e.educationRepo.GetEducation(ctx, claim.UserID, educationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := apply(education, request); err != nil {
+		return nil, err
+	}
+
+	if err := e.educationRepo.UpdateEducation(ctx, education); err != nil {
+		return nil, err
+	}
+
+	return education, nil
+}
+
+func (e *Education) Delete(ctx context.Context, claim model.Claim, educationID uint) error {
+	if err := authz.RequireScope(claim, model.ScopeResumeWrite); err != nil {
+		return err
+	}
+
+	return e.educationRepo.DeleteEducation(ctx, claim.UserID, educationID)
+}
+
+// Reorder puts the education of the owner in the order of ids, which has to
+// list every entry
+func (e *Education) Reorder(ctx context.Context, claim model.Claim, request model.OrderRequest) ([]model.Education, error) {
+	if err := authz.RequireScope(claim, model.ScopeResumeWrite); err != nil {
+		return nil, err
+	}
+
+	education, err := e.educationRepo.ListEducation(ctx, claim.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	existing := make([]uint, 0, len(education))
+	for _, education := range education {
+		existing = append(existing, education.ID)
+	}
+	if !entry.IsPermutation(request.IDs, existing) {
+		return nil, errorx.New(errorx.TypeInvalidParameter, invalidOrderMessage, nil)
+	}
+
+	if err := e.educationRepo.SetEducationPositions(ctx, claim.UserID, request.IDs); err != nil {
+		return nil, err
+	}
+
+	return e.List(ctx, claim)
+}
+
+// ResetOrder goes back to listing the education most recent first
+func (e *Education) ResetOrder(ctx context.Context, claim model.Claim) ([]model.Education, error) {
+	if err := authz.RequireScope(claim, model.ScopeResumeWrite); err != nil {
+		return nil, err
+	}
+
+	if err := e.educationRepo.SetEducationPositions(ctx, claim.UserID, nil); err != nil {
+		return nil, err
+	}
+
+	return e.List(ctx, claim)
+}
+
+// Sort orders education by the position the owner gave it, then ongoing
+// studies first and the others by when they ended, most recent first
+func Sort(education []model.Education) {
+	entry.Sort(education, func(education model.Education) *int {
+		return education.Position
+	}, func(a, b model.Education) int {
+		switch {
+		case a.End == nil && b.End != nil:
+			return -1
+		case a.End != nil && b.End == nil:
+			return 1
+		case a.End != nil && *a.End != *b.End:
+			return b.End.Time().Compare(a.End.Time())
+		}
+
+		return b.Start.Time().Compare(a.Start.Time())
+	})
+}
+
+// apply validates request and copies it onto education
+func apply(education *model.Education, request model.EducationRequest) error {
+	education.Institution = strings.TrimSpace(request.Institution)
+	education.Degree = strings.TrimSpace(request.Degree)
+	education.FieldOfStudy = strings.TrimSpace(request.FieldOfStudy)
+	education.Start = request.Start
+	education.End = request.End
+	education.Grade = request.Grade
+	education.GradeScale = request.GradeScale
+	education.Honors = strings.TrimSpace(request.Honors)
+	education.Coursework = entry.Dedupe(entry.TrimAll(request.Coursework))
+	education.UpdatedAt = time.Now()
+
+	details := map[string]interface{}{}
+
+	if education.Institution == "" {
+		details["institution"] = "must not be empty"
+	}
+	for field, value := range map[string]string{
+		"institution":    education.Institution,
+		"degree":         education.Degree,
+		"field_of_study": education.FieldOfStudy,
+		"honors":         education.Honors,
+	} {
+		if utf8.RuneCountInString(value) > maxNameLength {
+			details[field] = fmt.Sprintf("must not be longer than %d characters", maxNameLength)
+		}
+	}
+
+	if education.Start.IsZero() {
+		details["start"] = "must not be empty"
+	}
+	if education.End != nil && !education.Start.IsZero() && education.End.Before(education.Start) {
+		details["end"] = "must not be before start"
+	}
+
+	switch {
+	case (education.Grade == nil) != (education.GradeScale == nil):
+		details["grade"] = "must be given along with grade_scale"
+	case education.GradeScale != nil && (*education.GradeScale <= 0 || *education.GradeScale > maxGradeScale):
+		details["grade_scale"] = fmt.Sprintf("must be greater than 0 and at most %d", maxGradeScale)
+	case education.Grade != nil && (*education.Grade < 0 || *education.Grade > *education.GradeScale):
+		details["grade"] = "must be between 0 and grade_scale"
+	}
+
+	if len(education.Coursework) > maxCoursework {
+		details["coursework"] = fmt.Sprintf("must not have more than %d items", maxCoursework)
+	}
+	for _, course := range education.Coursework {
+		if utf8.RuneCountInString(course) > maxCourseworkLength {
+			details["coursework"] = fmt.Sprintf("must not have items longer than %d characters", maxCourseworkLength)
+		}
+	}
+
+	if len(details) > 0 {
+		err := errorx.New(errorx.TypeInvalidParameter, invalidEducationMessage, nil)
+		err.Details = details
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,175 @@
+package usecase_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"devoratio.dev/web-resume/education/usecase"
+	"devoratio.dev/web-resume/education/usecase/repositorymock"
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/model"
+)
+
+var _ = Describe("Education", Label("education"), func() {
+	var (
+		mockController *gomock.Controller
+
+		educationRepoMock *repositorymock.MockEducationRepository
+
+		educationUsecase *usecase.Education
+		commonCtx        context.Context
+		claim            model.Claim
+		request          model.EducationRequest
+	)
+
+	BeforeEach(func() {
+		mockController = gomock.NewController(GinkgoT())
+
+		educationRepoMock = repositorymock.NewMockEducationRepository(mockController)
+
+		educationUsecase = usecase.NewUsecase(educationRepoMock)
+
+		claim = model.Claim{UserID: 1, Username: "devoratio", Scopes: []string{model.ScopeResumeWrite}}
+		end := model.NewMonth(2019, time.August)
+		grade, scale := 3.8, 4.0
+		request = model.EducationRequest{
+			Institution:  "Universitas Indonesia",
+			Degree:       "Bachelor of Computer Science",
+			FieldOfStudy: "Computer Science",
+			Start:        model.NewMonth(2015, time.August),
+			End:          &end,
+			Grade:        &grade,
+			GradeScale:   &scale,
+			Honors:       "Cum Laude",
+			Coursework:   []string{" Distributed Systems ", "distributed systems", ""},
+		}
+
+		commonCtx = context.Background()
+	})
+
+	AfterEach(func() {
+		mockController.Finish()
+	})
+
+	Describe("Create an education", func() {
+		When("the education is invalid", func() {
+			It("tells the owner which fields are invalid", func(ctx SpecContext) {
+				end := model.NewMonth(2014, time.January)
+				request.Institution = ""
+				request.End = &end
+
+				result, err := educationUsecase.Create(commonCtx, claim, request)
+				Expect(err.(*errorx.Error).Type).Should(Equal(errorx.TypeInvalidParameter))
+				Expect(err.(*errorx.Error).Details).Should(HaveKey("institution"))
+				Expect(err.(*errorx.Error).Details).Should(HaveKeyWithValue("end", "must not be before start"))
+				Expect(result).Should(BeNil())
+			}, SpecTimeout(time.Second*2))
+		})
+
+		When("the grade is above the scale", func() {
+			It("refuses the grade", func(ctx SpecContext) {
+				grade := 4.2
+				request.Grade = &grade
+
+				_, err := educationUsecase.Create(commonCtx, claim, request)
+				Expect(err.(*errorx.Error).Details).Should(HaveKeyWithValue("grade", "must be between 0 and grade_scale"))
+			}, SpecTimeout(time.Second*2))
+		})
+
+		When("the grade has no scale", func() {
+			It("asks for the scale", func(ctx SpecContext) {
+				request.GradeScale = nil
+
+				_, err := educationUsecase.Create(commonCtx, claim, request)
+				Expect(err.(*errorx.Error).Details).Should(HaveKey("grade"))
+			}, SpecTimeout(time.Second*2))
+		})
+
+		When("the education is valid", func() {
+			It("stores the cleaned up education", func(ctx SpecContext) {
+				educationRepoMock.EXPECT().CreateEducation(commonCtx, gomock.Any()).DoAndReturn(
+					func(_ context.Context, education *model.Education) error {
+						education.ID = 3
+						return nil
+					}).Times(1)
+
+				result, err := educationUsecase.Create(commonCtx, claim, request)
+				Expect(err).Should(BeNil())
+				Expect(result.ID).Should(Equal(uint(3)))
+				Expect(result.OwnerID).Should(Equal(claim.UserID))
+				Expect(result.Coursework).Should(Equal([]string{"Distributed Systems"}))
+			}, SpecTimeout(time.Second*2))
+		})
+	})
+
+	Describe("Update an education", func() {
+		When("the education belongs to someone else", func() {
+			It("tells the owner it does not exist", func(ctx SpecContext) {
+				educationRepoMock.EXPECT().GetEducation(commonCtx, claim.UserID, uint(9)).Return(nil, errorx.ErrNotFound).Times(1)
+
+				result, err := educationUsecase.Update(commonCtx, claim, 9, request)
+				Expect(err).Should(Equal(errorx.ErrNotFound))
+				Expect(result).Should(BeNil())
+			}, SpecTimeout(time.Second*2))
+		})
+
+		When("the grade is removed", func() {
+			It("clears the grade", func(ctx SpecContext) {
+				request.Grade, request.GradeScale = nil, nil
+
+				grade, scale := 3.5, 4.0
+				educationRepoMock.EXPECT().GetEducation(commonCtx, claim.UserID, uint(3)).
+					Return(&model.Education{ID: 3, OwnerID: claim.UserID, Grade: &grade, GradeScale: &scale}, nil).Times(1)
+				educationRepoMock.EXPECT().UpdateEducation(commonCtx, gomock.Any()).Return(nil).Times(1)
+
+				result, err := educationUsecase.Update(commonCtx, claim, 3, request)
+				Expect(err).Should(BeNil())
+				Expect(result.Grade).Should(BeNil())
+				Expect(result.GradeScale).Should(BeNil())
+			}, SpecTimeout(time.Second*2))
+		})
+	})
+
+	Describe("List the education", func() {
+		It("lists ongoing studies first, then the others most recent first", func(ctx SpecContext) {
+			older, newer := model.NewMonth(2015, time.June), model.NewMonth(2019, time.August)
+			educationRepoMock.EXPECT().ListEducation(commonCtx, claim.UserID).Return([]model.Education{
+				{ID: 1, Start: model.NewMonth(2012, time.July), End: &older},
+				{ID: 2, Start: model.NewMonth(2023, time.September)},
+				{ID: 3, Start: model.NewMonth(2015, time.August), End: &newer},
+			}, nil).Times(1)
+
+			result, err := educationUsecase.List(commonCtx, claim)
+			Expect(err).Should(BeNil())
+			Expect([]uint{result[0].ID, result[1].ID, result[2].ID}).Should(Equal([]uint{2, 3, 1}))
+		}, SpecTimeout(time.Second*2))
+	})
+
+	Describe("Reorder the education", func() {
+		BeforeEach(func() {
+			educationRepoMock.EXPECT().ListEducation(commonCtx, claim.UserID).
+				Return([]model.Education{{ID: 1}, {ID: 2}}, nil).AnyTimes()
+		})
+
+		When("the order leaves out an education", func() {
+			It("refuses the order", func(ctx SpecContext) {
+				result, err := educationUsecase.Reorder(commonCtx, claim, model.OrderRequest{IDs: []uint{2}})
+				Expect(err.(*errorx.Error).Type).Should(Equal(errorx.TypeInvalidParameter))
+				Expect(result).Should(BeNil())
+			}, SpecTimeout(time.Second*2))
+		})
+
+		When("the order lists every education", func() {
+			It("stores the positions", func(ctx SpecContext) {
+				educationRepoMock.EXPECT().SetEducationPositions(commonCtx, claim.UserID, []uint{2, 1}).Return(nil).Times(1)
+
+				_, err := educationUsecase.Reorder(commonCtx, claim, model.OrderRequest{IDs: []uint{2, 1}})
+				Expect(err).Should(BeNil())
+			}, SpecTimeout(time.Second*2))
+		})
+	})
+})
@@ -0,0 +1,122 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: devoratio.dev/web-resume/education/usecase (interfaces: EducationRepository)
+
+// Package repositorymock is a generated GoMock package.
+package repositorymock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "devoratio.dev/web-resume/model"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockEducationRepository is a mock of EducationRepository interface.
+type MockEducationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockEducationRepositoryMockRecorder
+}
+
+// MockEducationRepositoryMockRecorder is the mock recorder for MockEducationRepository.
+type MockEducationRepositoryMockRecorder struct {
+	mock *MockEducationRepository
+}
+
+// NewMockEducationRepository creates a new mock instance.
+func NewMockEducationRepository(ctrl *gomock.Controller) *MockEducationRepository {
+	mock := &MockEducationRepository{ctrl: ctrl}
+	mock.recorder = &MockEducationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEducationRepository) EXPECT() *MockEducationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateEducation mocks base method.
+func (m *MockEducationRepository) CreateEducation(arg0 context.Context, arg1 *model.Education) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEducation", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateEducation indicates an expected call of CreateEducation.
+func (mr *MockEducationRepositoryMockRecorder) CreateEducation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEducation", reflect.TypeOf((*MockEducationRepository)(nil).CreateEducation), arg0, arg1)
+}
+
+// DeleteEducation mocks base method.
+func (m *MockEducationRepository) DeleteEducation(arg0 context.Context, arg1, arg2 uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEducation", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteEducation indicates an expected call of DeleteEducation.
+func (mr *MockEducationRepositoryMockRecorder) DeleteEducation(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEducation", reflect.TypeOf((*MockEducationRepository)(nil).DeleteEducation), arg0, arg1, arg2)
+}
+
+// GetEducation mocks base method.
+func (m *MockEducationRepository) GetEducation(arg0 context.Context, arg1, arg2 uint) (*model.Education, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEducation", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*model.Education)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEducation indicates an expected call of GetEducation.
+func (mr *MockEducationRepositoryMockRecorder) GetEducation(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEducation", reflect.TypeOf((*MockEducationRepository)(nil).GetEducation), arg0, arg1, arg2)
+}
+
+// ListEducation mocks base method.
+func (m *MockEducationRepository) ListEducation(arg0 context.Context, arg1 uint) ([]model.Education, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEducation", arg0, arg1)
+	ret0, _ := ret[0].([]model.Education)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEducation indicates an expected call of ListEducation.
+func (mr *MockEducationRepositoryMockRecorder) ListEducation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEducation", reflect.TypeOf((*MockEducationRepository)(nil).ListEducation), arg0, arg1)
+}
+
+// SetEducationPositions mocks base method.
+func (m *MockEducationRepository) SetEducationPositions(arg0 context.Context, arg1 uint, arg2 []uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetEducationPositions", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetEducationPositions indicates an expected call of SetEducationPositions.
+func (mr *MockEducationRepositoryMockRecorder) SetEducationPositions(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetEducationPositions", reflect.TypeOf((*MockEducationRepository)(nil).SetEducationPositions), arg0, arg1, arg2)
+}
+
+// UpdateEducation mocks base method.
+func (m *MockEducationRepository) UpdateEducation(arg0 context.Context, arg1 *model.Education) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEducation", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateEducation indicates an expected call of UpdateEducation.
+func (mr *MockEducationRepositoryMockRecorder) UpdateEducation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEducation", reflect.TypeOf((*MockEducationRepository)(nil).UpdateEducation), arg0, arg1)
+}
@@ -0,0 +1,95 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/internal/httpx"
+	"devoratio.dev/web-resume/model"
+)
+
+type EmailVerificationUsecase interface {
+	SendVerification(ctx context.Context, ownerID uint) error
+	RequestChange(ctx context.Context, claim model.Claim, currentPassword, newEmail string) error
+	Verify(ctx context.Context, verificationToken string) error
+}
+
+type HTTP struct {
+	verificationUsecase EmailVerificationUsecase
+}
+
+func NewHTTP(verificationUsecase EmailVerificationUsecase) *HTTP {
+	return &HTTP{
+		verificationUsecase: verificationUsecase,
+	}
+}
+
+// RegisterRoutes registers the email verification routes, the link confirming
+// an address is wrapped by restrict and the others by authenticate
+func (h *HTTP) RegisterRoutes(mux *http.ServeMux, restrict, authenticate httpx.Middleware) {
+	mux.Handle("POST /v1/email/verify", restrict(http.HandlerFunc(h.verify)))
+	mux.Handle("POST /v1/owner/email/verification", authenticate(http.HandlerFunc(h.sendVerification)))
+	mux.Handle("PUT /v1/owner/email", authenticate(http.HandlerFunc(h.requestChange)))
+}
+
+type verifyRequest struct {
+	Token string `json:"token"`
+}
+
+func (h *HTTP) verify(w http.ResponseWriter, r *http.Request) {
+	var request verifyRequest
+	if err := httpx.DecodeJSON(w, r, &request); err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	if err := h.verificationUsecase.Verify(r.Context(), request.Token); err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	httpx.WriteNoContent(w)
+}
+
+func (h *HTTP) sendVerification(w http.ResponseWriter, r *http.Request) {
+	claim, ok := httpx.ClaimFromContext(r.Context())
+	if !ok {
+		httpx.WriteError(w, errorx.ErrUnauthorized)
+		return
+	}
+
+	if err := h.verificationUsecase.SendVerification(r.Context(), claim.UserID); err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+type requestChangeRequest struct {
+	CurrentPassword string `json:"current_password"`
+	NewEmail        string `json:"new_email"`
+}
+
+func (h *HTTP) requestChange(w http.ResponseWriter, r *http.Request) {
+	claim, ok := httpx.ClaimFromContext(r.Context())
+	if !ok {
+		httpx.WriteError(w, errorx.ErrUnauthorized)
+		return
+	}
+
+	var request requestChangeRequest
+	if err := httpx.DecodeJSON(w, r, &request); err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	err := h.verificationUsecase.RequestChange(r.Context(), *claim, request.CurrentPassword, request.NewEmail)
+	if err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
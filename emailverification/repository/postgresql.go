@@ -0,0 +1,107 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/internal/identifier"
+	"devoratio.dev/web-resume/internal/tenancy"
+	"devoratio.dev/web-resume/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// errEmailTaken aborts the verification when another owner took the address
+// after the verification link was sent
+var errEmailTaken = errors.New("email has been taken by another owner")
+
+type PostgreSQLDatabase struct {
+	db *gorm.DB
+}
+
+func NewPostgreSQL(db *gorm.DB) *PostgreSQLDatabase {
+	return &PostgreSQLDatabase{
+		db: db,
+	}
+}
+
+func (p *PostgreSQLDatabase) GetOwnerByID(ctx context.Context, ownerID uint) (*model.OwnerAccount, error) {
+	owner := &model.OwnerAccount{}
+	result := p.db.WithContext(ctx).Scopes(tenancy.Scope(ctx, "id")).First(owner, ownerID)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, errorx.ErrNotFound
+		}
+		return nil, errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+
+	return owner, nil
+}
+
+func (p *PostgreSQLDatabase) IsEmailTaken(ctx context.Context, email string, exceptOwnerID uint) (bool, error) {
+	var count int64
+	result := p.db.WithContext(ctx).Model(&model.OwnerAccount{}).Where("lower(email) = ? AND id <> ?", identifier.Normalize(email), exceptOwnerID).Count(&count)
+	if result.Error != nil {
+		return false, errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+
+	return count > 0, nil
+}
+
+func (p *PostgreSQLDatabase) CreateToken(ctx context.Context, token *model.OneTimeToken) error {
+	if err := p.db.WithContext(ctx).Create(token).Error; err != nil {
+		return errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), err)
+	}
+
+	return nil
+}
+
+func (p *PostgreSQLDatabase) VerifyEmail(ctx context.Context, tokenHash string, now time.Time) error {
+	err := p.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		token := &model.OneTimeToken{}
+		result := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Scopes(tenancy.Scope(ctx, "owner_id")).
+			Where("token_hash = ? AND purpose = ? AND used_at IS NULL AND expires_at > ?", tokenHash, model.TokenPurposeEmailVerification, now).
+			First(token)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		var taken int64
+		err := tx.Model(&model.OwnerAccount{}).Where("lower(email) = ? AND id <> ?", identifier.Normalize(token.Email), token.OwnerID).Count(&taken).Error
+		if err != nil {
+			return err
+		}
+		if taken > 0 {
+			return errEmailTaken
+		}
+
+		// Links sent for other addresses are stale once an address is confirmed
+		err = tx.Model(&model.OneTimeToken{}).
+			Where("owner_id = ? AND purpose = ? AND used_at IS NULL", token.OwnerID, model.TokenPurposeEmailVerification).
+			Update("used_at", now).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Model(&model.OwnerAccount{}).Where("id = ?", token.OwnerID).Updates(map[string]interface{}{
+			"email":             token.Email,
+			"email_verified":    true,
+			"email_verified_at": now,
+		}).Error
+	})
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errorx.ErrNotFound
+		}
+		if errors.Is(err, errEmailTaken) {
+			return errorx.New(errorx.TypeInvalidParameter, errEmailTaken.Error(), err)
+		}
+		return errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), err)
+	}
+
+	return nil
+}
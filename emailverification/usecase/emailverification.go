@@ -0,0 +1,184 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+	"time"
+
+	"devoratio.dev/web-resume/config"
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/internal/generator"
+	"devoratio.dev/web-resume/internal/hasher"
+	"devoratio.dev/web-resume/internal/identifier"
+	"devoratio.dev/web-resume/internal/mailer"
+	"devoratio.dev/web-resume/model"
+)
+
+const (
+	invalidVerificationTokenMessage = "verification token is invalid or has expired"
+	invalidCurrentPasswordMessage   = "current password is invalid"
+	invalidEmailMessage             = "email is invalid"
+	alreadyVerifiedMessage          = "email has already been verified"
+)
+
+const verificationEmailBody = `Hi %s,
+
+Please confirm that %s is your email address by opening the link below within
+%s:
+
+%s
+
+If you did not expect this email you can ignore it.
+`
+
+const changeNoticeEmailBody = `Hi %s,
+
+Someone asked to change the email address of your web resume account from %s
+to %s. The change only takes effect once the new address has been confirmed,
+until then this address stays in use.
+
+If you did not ask for this, reset your password right away.
+`
+
+//go:generate mockgen -destination=usecasemock/authenticationmock.go -package=usecasemock . AuthenticationUsecase
+type AuthenticationUsecase interface {
+	Authenticate(ctx context.Context, identifier, password string) (*model.Owner, error)
+}
+
+//go:generate mockgen -destination=repositorymock/postgresqlmock.go -package=repositorymock . EmailVerificationRepository
+type EmailVerificationRepository interface {
+	GetOwnerByID(ctx context.Context, ownerID uint) (*model.OwnerAccount, error)
+	IsEmailTaken(ctx context.Context, email string, exceptOwnerID uint) (bool, error)
+	CreateToken(ctx context.Context, token *model.OneTimeToken) error
+	// VerifyEmail consumes the verification token and makes the address it was
+	// sent to the verified email of its owner. It returns errorx.ErrNotFound
+	// when the token is unknown, already used or expired.
+	VerifyEmail(ctx context.Context, tokenHash string, now time.Time) error
+}
+
+//go:generate mockgen -destination=mailermock/mailermock.go -package=mailermock . Mailer
+type Mailer interface {
+	Send(ctx context.Context, message mailer.Message) error
+}
+
+type EmailVerification struct {
+	authUsecase      AuthenticationUsecase
+	verificationRepo EmailVerificationRepository
+	mailer           Mailer
+	appConfig        *config.Application
+}
+
+func NewUsecase(authUsecase AuthenticationUsecase, verificationRepo EmailVerificationRepository, mailer Mailer, appConfig *config.Application) *EmailVerification {
+	return &EmailVerification{
+		authUsecase:      authUsecase,
+		verificationRepo: verificationRepo,
+		mailer:           mailer,
+		appConfig:        appConfig,
+	}
+}
+
+// SendVerification emails a verification link to the current address of the
+// owner.
+func (e *EmailVerification) SendVerification(ctx context.Context, ownerID uint) error {
+	ownerAccount, err := e.verificationRepo.GetOwnerByID(ctx, ownerID)
+	if err != nil {
+		return err
+	}
+	if ownerAccount.EmailVerified {
+		return errorx.New(errorx.TypeInvalidParameter, alreadyVerifiedMessage, nil)
+	}
+
+	return e.sendVerification(ctx, &ownerAccount.Owner, ownerAccount.Email)
+}
+
+// RequestChange starts moving the owner to newEmail. The current address stays
+// in use until the link sent to newEmail is opened, and is told about the
+// pending change.
+func (e *EmailVerification) RequestChange(ctx context.Context, claim model.Claim, currentPassword, newEmail string) error {
+	owner, err := e.authUsecase.Authenticate(ctx, claim.Username, currentPassword)
+	if err != nil {
+		if errorx.Is(err, errorx.ErrInvalidParameter) {
+			return errorx.New(errorx.TypeInvalidParameter, invalidCurrentPasswordMessage, err)
+		}
+		return err
+	}
+	if owner.ID != claim.UserID {
+		return errorx.ErrForbidden
+	}
+
+	newEmail = identifier.Normalize(newEmail)
+	if address, err := mail.ParseAddress(newEmail); err != nil || address.Address != newEmail {
+		return invalidEmail("must be a valid email address")
+	}
+	if newEmail == identifier.Normalize(owner.Email) {
+		return invalidEmail("must be different from the current email")
+	}
+
+	taken, err := e.verificationRepo.IsEmailTaken(ctx, newEmail, owner.ID)
+	if err != nil {
+		return err
+	}
+	if taken {
+		return invalidEmail("is already in use")
+	}
+
+	if err := e.sendVerification(ctx, owner, newEmail); err != nil {
+		return err
+	}
+
+	return e.mailer.Send(ctx, mailer.Message{
+		To:      owner.Email,
+		Subject: "Your email address is about to change",
+		Body:    fmt.Sprintf(changeNoticeEmailBody, owner.FullName(), owner.Email, newEmail),
+	})
+}
+
+// Verify confirms the address a verification token was sent to
+func (e *EmailVerification) Verify(ctx context.Context, verificationToken string) error {
+	err := e.verificationRepo.VerifyEmail(ctx, hasher.HashToken(verificationToken), time.Now())
+	if err != nil {
+		if errorx.Is(err, errorx.ErrNotFound) {
+			return errorx.New(errorx.TypeInvalidParameter, invalidVerificationTokenMessage, err)
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (e *EmailVerification) sendVerification(ctx context.Context, owner *model.Owner, email string) error {
+	verificationToken, err := generator.GenerateRandomToken()
+	if err != nil {
+		return err
+	}
+
+	ttl := e.appConfig.Usecase.EmailVerification.TokenTTL
+	err = e.verificationRepo.CreateToken(ctx, &model.OneTimeToken{
+		OwnerID:   owner.ID,
+		Purpose:   model.TokenPurposeEmailVerification,
+		TokenHash: hasher.HashToken(verificationToken),
+		Email:     email,
+		ExpiresAt: time.Now().Add(ttl),
+	})
+	if err != nil {
+		return err
+	}
+
+	verificationURL, err := generator.GenerateTokenURL(e.appConfig.Usecase.EmailVerification.URL, verificationToken)
+	if err != nil {
+		return err
+	}
+
+	return e.mailer.Send(ctx, mailer.Message{
+		To:      email,
+		Subject: "Confirm your email address",
+		Body:    fmt.Sprintf(verificationEmailBody, owner.FullName(), email, ttl, verificationURL),
+	})
+}
+
+func invalidEmail(violation string) error {
+	err := errorx.New(errorx.TypeInvalidParameter, invalidEmailMessage, nil)
+	err.Details = map[string]interface{}{"email": violation}
+	return err
+}
@@ -0,0 +1,168 @@
+package usecase_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"devoratio.dev/web-resume/config"
+	"devoratio.dev/web-resume/emailverification/usecase"
+	"devoratio.dev/web-resume/emailverification/usecase/mailermock"
+	"devoratio.dev/web-resume/emailverification/usecase/repositorymock"
+	"devoratio.dev/web-resume/emailverification/usecase/usecasemock"
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/internal/hasher"
+	"devoratio.dev/web-resume/internal/mailer"
+	"devoratio.dev/web-resume/model"
+)
+
+var _ = Describe("Verify and change the owner email", Label("emailverification"), func() {
+	var (
+		mockController *gomock.Controller
+
+		authenticationUsecaseMock *usecasemock.MockAuthenticationUsecase
+		verificationRepoMock      *repositorymock.MockEmailVerificationRepository
+		mailerMock                *mailermock.MockMailer
+
+		verificationUsecase *usecase.EmailVerification
+		commonCtx           context.Context
+		ownerAccountStub    model.OwnerAccount
+		claim               model.Claim
+		currentPassword     = "veryverysecurepassword"
+		newEmail            = "new@devoratio.dev"
+	)
+
+	BeforeEach(func() {
+		gofakeit.Seed(time.Now().UnixNano())
+		mockController = gomock.NewController(GinkgoT())
+
+		authenticationUsecaseMock = usecasemock.NewMockAuthenticationUsecase(mockController)
+		verificationRepoMock = repositorymock.NewMockEmailVerificationRepository(mockController)
+		mailerMock = mailermock.NewMockMailer(mockController)
+
+		appConfig := &config.Application{
+			Usecase: config.Usecase{
+				EmailVerification: config.EmailVerification{
+					TokenTTL: 48 * time.Hour,
+					URL:      "https://resume.devoratio.dev/verify-email",
+				},
+			},
+		}
+
+		verificationUsecase = usecase.NewUsecase(authenticationUsecaseMock, verificationRepoMock, mailerMock, appConfig)
+
+		gofakeit.Struct(&ownerAccountStub)
+		ownerAccountStub.Email = "old@devoratio.dev"
+		ownerAccountStub.EmailVerified = false
+		claim = model.Claim{UserID: ownerAccountStub.ID, Username: ownerAccountStub.Username}
+
+		commonCtx = context.Background()
+	})
+
+	AfterEach(func() {
+		mockController.Finish()
+	})
+
+	When("the owner asks for a new verification link", func() {
+		Context("the email has already been verified", func() {
+			It("tells the owner there is nothing to verify", func(ctx SpecContext) {
+				ownerAccountStub.EmailVerified = true
+				verificationRepoMock.EXPECT().GetOwnerByID(commonCtx, ownerAccountStub.ID).Return(&ownerAccountStub, nil)
+
+				err := verificationUsecase.SendVerification(commonCtx, ownerAccountStub.ID)
+				Expect(err.(*errorx.Error).Type).Should(Equal(errorx.TypeInvalidParameter))
+			}, SpecTimeout(time.Second*2))
+		})
+
+		Context("the email has not been verified", func() {
+			It("sends a verification link to the current email", func(ctx SpecContext) {
+				verificationRepoMock.EXPECT().GetOwnerByID(commonCtx, ownerAccountStub.ID).Return(&ownerAccountStub, nil)
+				verificationRepoMock.EXPECT().CreateToken(commonCtx, gomock.Any()).DoAndReturn(
+					func(_ context.Context, token *model.OneTimeToken) error {
+						Expect(token.Purpose).Should(Equal(model.TokenPurposeEmailVerification))
+						Expect(token.Email).Should(Equal("old@devoratio.dev"))
+						return nil
+					})
+				mailerMock.EXPECT().Send(commonCtx, gomock.Any()).DoAndReturn(
+					func(_ context.Context, message mailer.Message) error {
+						Expect(message.To).Should(Equal("old@devoratio.dev"))
+						Expect(message.Body).Should(ContainSubstring("https://resume.devoratio.dev/verify-email?token="))
+						return nil
+					})
+
+				err := verificationUsecase.SendVerification(commonCtx, ownerAccountStub.ID)
+				Expect(err).Should(BeNil())
+			}, SpecTimeout(time.Second*2))
+		})
+	})
+
+	When("the owner asks to change their email", func() {
+		Context("the current password is wrong", func() {
+			It("tells the owner that the current password is invalid", func(ctx SpecContext) {
+				authenticationUsecaseMock.EXPECT().Authenticate(commonCtx, claim.Username, "twinkling").
+					Return(nil, errorx.New(errorx.TypeInvalidParameter, "username or email or password is invalid", errorx.ErrNotMatch))
+
+				err := verificationUsecase.RequestChange(commonCtx, claim, "twinkling", newEmail)
+				Expect(err.(*errorx.Error).Message).Should(Equal("current password is invalid"))
+			}, SpecTimeout(time.Second*2))
+		})
+
+		Context("the new email is used by another owner", func() {
+			It("tells the owner that the email is already in use", func(ctx SpecContext) {
+				authenticationUsecaseMock.EXPECT().Authenticate(commonCtx, claim.Username, currentPassword).Return(&ownerAccountStub.Owner, nil)
+				verificationRepoMock.EXPECT().IsEmailTaken(commonCtx, newEmail, ownerAccountStub.ID).Return(true, nil)
+
+				err := verificationUsecase.RequestChange(commonCtx, claim, currentPassword, newEmail)
+				Expect(err.(*errorx.Error).Type).Should(Equal(errorx.TypeInvalidParameter))
+				Expect(err.(*errorx.Error).Details).Should(HaveKeyWithValue("email", "is already in use"))
+			}, SpecTimeout(time.Second*2))
+		})
+
+		Context("the new email is valid", func() {
+			It("sends a verification link to the new email and tells the old email", func(ctx SpecContext) {
+				var recipients []string
+
+				authenticationUsecaseMock.EXPECT().Authenticate(commonCtx, claim.Username, currentPassword).Return(&ownerAccountStub.Owner, nil)
+				verificationRepoMock.EXPECT().IsEmailTaken(commonCtx, newEmail, ownerAccountStub.ID).Return(false, nil)
+				verificationRepoMock.EXPECT().CreateToken(commonCtx, gomock.Any()).DoAndReturn(
+					func(_ context.Context, token *model.OneTimeToken) error {
+						Expect(token.Email).Should(Equal(newEmail))
+						return nil
+					})
+				mailerMock.EXPECT().Send(commonCtx, gomock.Any()).Times(2).DoAndReturn(
+					func(_ context.Context, message mailer.Message) error {
+						recipients = append(recipients, message.To)
+						return nil
+					})
+
+				err := verificationUsecase.RequestChange(commonCtx, claim, currentPassword, newEmail)
+				Expect(err).Should(BeNil())
+				Expect(recipients).Should(Equal([]string{newEmail, "old@devoratio.dev"}))
+			}, SpecTimeout(time.Second*2))
+		})
+	})
+
+	When("the owner opens a verification link", func() {
+		Context("the token is unknown, used or expired", func() {
+			It("tells the owner that the token is invalid", func(ctx SpecContext) {
+				verificationRepoMock.EXPECT().VerifyEmail(commonCtx, hasher.HashToken("verificationtoken"), gomock.Any()).Return(errorx.ErrNotFound)
+
+				err := verificationUsecase.Verify(commonCtx, "verificationtoken")
+				Expect(err.(*errorx.Error).Type).Should(Equal(errorx.TypeInvalidParameter))
+			}, SpecTimeout(time.Second*2))
+		})
+
+		Context("the token is valid", func() {
+			It("verifies the email", func(ctx SpecContext) {
+				verificationRepoMock.EXPECT().VerifyEmail(commonCtx, hasher.HashToken("verificationtoken"), gomock.Any()).Return(nil)
+
+				err := verificationUsecase.Verify(commonCtx, "verificationtoken")
+				Expect(err).Should(BeNil())
+			}, SpecTimeout(time.Second*2))
+		})
+	})
+})
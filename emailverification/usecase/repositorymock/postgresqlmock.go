@@ -0,0 +1,95 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: devoratio.dev/web-resume/emailverification/usecase (interfaces: EmailVerificationRepository)
+
+// Package repositorymock is a generated GoMock package.
+package repositorymock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	model "devoratio.dev/web-resume/model"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockEmailVerificationRepository is a mock of EmailVerificationRepository interface.
+type MockEmailVerificationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockEmailVerificationRepositoryMockRecorder
+}
+
+// MockEmailVerificationRepositoryMockRecorder is the mock recorder for MockEmailVerificationRepository.
+type MockEmailVerificationRepositoryMockRecorder struct {
+	mock *MockEmailVerificationRepository
+}
+
+// NewMockEmailVerificationRepository creates a new mock instance.
+func NewMockEmailVerificationRepository(ctrl *gomock.Controller) *MockEmailVerificationRepository {
+	mock := &MockEmailVerificationRepository{ctrl: ctrl}
+	mock.recorder = &MockEmailVerificationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEmailVerificationRepository) EXPECT() *MockEmailVerificationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateToken mocks base method.
+func (m *MockEmailVerificationRepository) CreateToken(arg0 context.Context, arg1 *model.OneTimeToken) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateToken", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateToken indicates an expected call of CreateToken.
+func (mr *MockEmailVerificationRepositoryMockRecorder) CreateToken(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateToken", reflect.TypeOf((*MockEmailVerificationRepository)(nil).CreateToken), arg0, arg1)
+}
+
+// GetOwnerByID mocks base method.
+func (m *MockEmailVerificationRepository) GetOwnerByID(arg0 context.Context, arg1 uint) (*model.OwnerAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOwnerByID", arg0, arg1)
+	ret0, _ := ret[0].(*model.OwnerAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOwnerByID indicates an expected call of GetOwnerByID.
+func (mr *MockEmailVerificationRepositoryMockRecorder) GetOwnerByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOwnerByID", reflect.TypeOf((*MockEmailVerificationRepository)(nil).GetOwnerByID), arg0, arg1)
+}
+
+// IsEmailTaken mocks base method.
+func (m *MockEmailVerificationRepository) IsEmailTaken(arg0 context.Context, arg1 string, arg2 uint) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsEmailTaken", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsEmailTaken indicates an expected call of IsEmailTaken.
+func (mr *MockEmailVerificationRepositoryMockRecorder) IsEmailTaken(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsEmailTaken", reflect.TypeOf((*MockEmailVerificationRepository)(nil).IsEmailTaken), arg0, arg1, arg2)
+}
+
+// VerifyEmail mocks base method.
+func (m *MockEmailVerificationRepository) VerifyEmail(arg0 context.Context, arg1 string, arg2 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyEmail", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VerifyEmail indicates an expected call of VerifyEmail.
+func (mr *MockEmailVerificationRepositoryMockRecorder) VerifyEmail(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyEmail", reflect.TypeOf((*MockEmailVerificationRepository)(nil).VerifyEmail), arg0, arg1, arg2)
+}
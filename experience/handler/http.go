@@ -0,0 +1,190 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/internal/httpx"
+	"devoratio.dev/web-resume/model"
+)
+
+type ExperienceUsecase interface {
+	Create(ctx context.Context, claim model.Claim, request model.ExperienceRequest) (*model.Experience, error)
+	Get(ctx context.Context, claim model.Claim, experienceID uint) (*model.Experience, error)
+	List(ctx context.Context, claim model.Claim) ([]model.Experience, error)
+	Update(ctx context.Context, claim model.Claim, experienceID uint, request model.ExperienceRequest) (*model.Experience, error)
+	Delete(ctx context.Context, claim model.Claim, experienceID uint) error
+	Reorder(ctx context.Context, claim model.Claim, request model.OrderRequest) ([]model.Experience, error)
+	ResetOrder(ctx context.Context, claim model.Claim) ([]model.Experience, error)
+}
+
+type HTTP struct {
+	experienceUsecase ExperienceUsecase
+}
+
+func NewHTTP(experienceUsecase ExperienceUsecase) *HTTP {
+	return &HTTP{
+		experienceUsecase: experienceUsecase,
+	}
+}
+
+// RegisterRoutes registers the experience routes, read is required to get the
+// experiences and write to change them
+func (h *HTTP) RegisterRoutes(mux *http.ServeMux, read, write httpx.Middleware) {
+	mux.Handle("GET /v1/experiences", read(http.HandlerFunc(h.list)))
+	mux.Handle("POST /v1/experiences", write(http.HandlerFunc(h.create)))
+	mux.Handle("PUT /v1/experiences/order", write(http.HandlerFunc(h.reorder)))
+	mux.Handle("DELETE /v1/experiences/order", write(http.HandlerFunc(h.resetOrder)))
+	mux.Handle("GET /v1/experiences/{id}", read(http.HandlerFunc(h.get)))
+	mux.Handle("PUT /v1/experiences/{id}", write(http.HandlerFunc(h.update)))
+	mux.Handle("DELETE /v1/experiences/{id}", write(http.HandlerFunc(h.delete)))
+}
+
+func (h *HTTP) list(w http.ResponseWriter, r *http.Request) {
+	claim, ok := httpx.ClaimFromContext(r.Context())
+	if !ok {
+		httpx.WriteError(w, errorx.ErrUnauthorized)
+		return
+	}
+
+	experiences, err := h.experienceUsecase.List(r.Context(), *claim)
+	if err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, http.StatusOK, experiences)
+}
+
+func (h *HTTP) create(w http.ResponseWriter, r *http.Request) {
+	claim, ok := httpx.ClaimFromContext(r.Context())
+	if !ok {
+		httpx.WriteError(w, errorx.ErrUnauthorized)
+		return
+	}
+
+	var request model.ExperienceRequest
+	if err := httpx.DecodeJSON(w, r, &request); err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	experience, err := h.experienceUsecase.Create(r.Context(), *claim, request)
+	if err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, http.StatusCreated, experience)
+}
+
+func (h *HTTP) get(w http.ResponseWriter, r *http.Request) {
+	claim, ok := httpx.ClaimFromContext(r.Context())
+	if !ok {
+		httpx.WriteError(w, errorx.ErrUnauthorized)
+		return
+	}
+
+	experienceID, err := strconv.ParseUint(r.PathValue("id"), 10, 0)
+	if err != nil {
+		httpx.WriteError(w, errorx.ErrNotFound)
+		return
+	}
+
+	experience, err := h.experienceUsecase.Get(r.Context(), *claim, uint(experienceID))
+	if err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, http.StatusOK, experience)
+}
+
+func (h *HTTP) update(w http.ResponseWriter, r *http.Request) {
+	claim, ok := httpx.ClaimFromContext(r.Context())
+	if !ok {
+		httpx.WriteError(w, errorx.ErrUnauthorized)
+		return
+	}
+
+	experienceID, err := strconv.ParseUint(r.PathValue("id"), 10, 0)
+	if err != nil {
+		httpx.WriteError(w, errorx.ErrNotFound)
+		return
+	}
+
+	var request model.ExperienceRequest
+	if err := httpx.DecodeJSON(w, r, &request); err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	experience, err := h.experienceUsecase.Update(r.Context(), *claim, uint(experienceID), request)
+	if err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, http.StatusOK, experience)
+}
+
+func (h *HTTP) delete(w http.ResponseWriter, r *http.Request) {
+	claim, ok := httpx.ClaimFromContext(r.Context())
+	if !ok {
+		httpx.WriteError(w, errorx.ErrUnauthorized)
+		return
+	}
+
+	experienceID, err := strconv.ParseUint(r.PathValue("id"), 10, 0)
+	if err != nil {
+		httpx.WriteError(w, errorx.ErrNotFound)
+		return
+	}
+
+	if err := h.experienceUsecase.Delete(r.Context(), *claim, uint(experienceID)); err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	httpx.WriteNoContent(w)
+}
+
+func (h *HTTP) reorder(w http.ResponseWriter, r *http.Request) {
+	claim, ok := httpx.ClaimFromContext(r.Context())
+	if !ok {
+		httpx.WriteError(w, errorx.ErrUnauthorized)
+		return
+	}
+
+	var request model.OrderRequest
+	if err := httpx.DecodeJSON(w, r, &request); err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	experiences, err := h.experienceUsecase.Reorder(r.Context(), *claim, request)
+	if err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, http.StatusOK, experiences)
+}
+
+func (h *HTTP) resetOrder(w http.ResponseWriter, r *http.Request) {
+	claim, ok := httpx.ClaimFromContext(r.Context())
+	if !ok {
+		httpx.WriteError(w, errorx.ErrUnauthorized)
+		return
+	}
+
+	experiences, err := h.experienceUsecase.ResetOrder(r.Context(), *claim)
+	if err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, http.StatusOK, experiences)
+}
@@ -0,0 +1,113 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/model"
+	"gorm.io/gorm"
+)
+
+type PostgreSQLDatabase struct {
+	db *gorm.DB
+}
+
+func NewPostgreSQL(db *gorm.DB) *PostgreSQLDatabase {
+	return &PostgreSQLDatabase{
+		db: db,
+	}
+}
+
+func (p *PostgreSQLDatabase) CreateExperience(ctx context.Context, experience *model.Experience) error {
+	if err := p.db.WithContext(ctx).Create(experience).Error; err != nil {
+		return errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), err)
+	}
+
+	return nil
+}
+
+func (p *PostgreSQLDatabase) GetExperience(ctx context.Context, ownerID, experienceID uint) (*model.Experience, error) {
+	experience := &model.Experience{}
+	result := p.db.WithContext(ctx).Where("id = ? AND owner_id = ?", experienceID, ownerID).First(experience)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, errorx.ErrNotFound
+		}
+		return nil, errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+
+	return experience, nil
+}
+
+func (p *PostgreSQLDatabase) ListExperiences(ctx context.Context, ownerID uint) ([]model.Experience, error) {
+	var experiences []model.Experience
+	result := p.db.WithContext(ctx).Where("owner_id = ?", ownerID).Order("id").Find(&experiences)
+	if result.Error != nil {
+		return nil, errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+
+	return experiences, nil
+}
+
+func (p *PostgreSQLDatabase) UpdateExperience(ctx context.Context, experience *model.Experience) error {
+	result := p.db.WithContext(ctx).Model(experience).
+		Where("owner_id = ?", experience.OwnerID).
+		Select("*").Omit("id", "owner_id", "position", "created_at").
+		Updates(experience)
+	if result.Error != nil {
+		return errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return errorx.ErrNotFound
+	}
+
+	return nil
+}
+
+func (p *PostgreSQLDatabase) DeleteExperience(ctx context.Context, ownerID, experienceID uint) error {
+	result := p.db.WithContext(ctx).Where("id = ? AND owner_id = ?", experienceID, ownerID).Delete(&model.Experience{})
+	if result.Error != nil {
+		return errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return errorx.ErrNotFound
+	}
+
+	return nil
+}
+
+func (p *PostgreSQLDatabase) HasCurrentExperience(ctx context.Context, ownerID uint, company string, exceptID uint) (bool, error) {
+	var count int64
+	result := p.db.WithContext(ctx).Model(&model.Experience{}).
+		Where("owner_id = ? AND current AND lower(company) = lower(?) AND id <> ?", ownerID, company, exceptID).
+		Count(&count)
+	if result.Error != nil {
+		return false, errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+
+	return count > 0, nil
+}
+
+func (p *PostgreSQLDatabase) SetExperiencePositions(ctx context.Context, ownerID uint, ids []uint) error {
+	err := p.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&model.Experience{}).Where("owner_id = ?", ownerID).Update("position", nil).Error
+		if err != nil {
+			return err
+		}
+
+		for position, id := range ids {
+			err := tx.Model(&model.Experience{}).Where("id = ? AND owner_id = ?", id, ownerID).Update("position", position).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,262 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"devoratio.dev/web-resume/internal/authz"
+	"devoratio.dev/web-resume/internal/entry"
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/model"
+)
+
+const (
+	invalidExperienceMessage = "experience is invalid"
+	invalidOrderMessage      = "order must list every experience exactly once"
+
+	maxNameLength      = 100
+	maxHighlights      = 20
+	maxHighlightLength = 300
+	maxTags            = 30
+	maxTagLength       = 50
+)
+
+//go:generate mockgen -destination=repositorymock/postgresqlmock.go -package=repositorymock . ExperienceRepository
+type ExperienceRepository interface {
+	CreateExperience(ctx context.Context, experience *model.Experience) error
+	GetExperience(ctx context.Context, ownerID, experienceID uint) (*model.Experience, error)
+	ListExperiences(ctx context.Context, ownerID uint) ([]model.Experience, error)
+	UpdateExperience(ctx context.Context, experience *model.Experience) error
+	DeleteExperience(ctx context.Context, ownerID, experienceID uint) error
+	// HasCurrentExperience reports whether the owner holds a current role at
+	// company, other than the experience exceptID
+	HasCurrentExperience(ctx context.Context, ownerID uint, company string, exceptID uint) (bool, error)
+	// SetExperiencePositions stores the positions of the experiences of the
+	// owner, clearing them all when ids is empty
+	SetExperiencePositions(ctx context.Context, ownerID uint, ids []uint) error
+}
+
+type Experience struct {
+	experienceRepo ExperienceRepository
+}
+
+func NewUsecase(experienceRepo ExperienceRepository) *Experience {
+	return &Experience{
+		experienceRepo: experienceRepo,
+	}
+}
+
+func (e *Experience) Create(ctx context.Context, claim model.Claim, request model.ExperienceRequest) (*model.Experience, error) {
+	if err := authz.RequireScope(claim, model.ScopeResumeWrite); err != nil {
+		return nil, err
+	}
+
+	experience := &model.Experience{OwnerID: claim.UserID}
+	if err := e.apply(ctx, experience, request); err != nil {
+		return nil, err
+	}
+
+	if err := e.experienceRepo.CreateExperience(ctx, experience); err != nil {
+		return nil, err
+	}
+
+	return experience, nil
+}
+
+func (e *Experience) Get(ctx context.Context, claim model.Claim, experienceID uint) (*model.Experience, error) {
+	return e.experienceRepo.GetExperience(ctx, claim.UserID, experienceID)
+}
+
+// List returns the experiences of the owner in the order they are shown
+func (e *Experience) List(ctx context.Context, claim model.Claim) ([]model.Experience, error) {
+	experiences, err := e.experienceRepo.ListExperiences(ctx, claim.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	Sort(experiences)
+	return experiences, nil
+}
+
+func (e *Experience) Update(ctx context.Context, claim model.Claim, experienceID uint, request model.ExperienceRequest) (*model.Experience, error) {
+	if err := authz.RequireScope(claim, model.ScopeResumeWrite); err != nil {
+		return nil, err
+	}
+
+	experience, err := e.experienceRepo.GetExperience(ctx, claim.UserID, experienceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := e.apply(ctx, experience, request); err != nil {
+		return nil, err
+	}
+
+	if err := e.experienceRepo.UpdateExperience(ctx, experience); err != nil {
+		return nil, err
+	}
+
+	return experience, nil
+}
+
+func (e *Experience) Delete(ctx context.Context, claim model.Claim, experienceID uint) error {
+	if err := authz.RequireScope(claim, model.ScopeResumeWrite); err != nil {
+		return err
+	}
+
+	return e.experienceRepo.DeleteExperience(ctx, claim.UserID, experienceID)
+}
+
+// Reorder puts the experiences of the owner in the order of ids, which has to
+// list every one of them
+func (e *Experience) Reorder(ctx context.Context, claim model.Claim, request model.OrderRequest) ([]model.Experience, error) {
+	if err := authz.RequireScope(claim, model.ScopeResumeWrite); err != nil {
+		return nil, err
+	}
+
+	experiences, err := e.experienceRepo.ListExperiences(ctx, claim.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	existing := make([]uint, 0, len(experiences))
+	for _, experience := range experiences {
+		existing = append(existing, experience.ID)
+	}
+	if !entry.IsPermutation(request.IDs, existing) {
+		return nil, errorx.New(errorx.TypeInvalidParameter, invalidOrderMessage, nil)
+	}
+
+	if err := e.experienceRepo.SetExperiencePositions(ctx, claim.UserID, request.IDs); err != nil {
+		return nil, err
+	}
+
+	return e.List(ctx, claim)
+}
+
+// ResetOrder goes back to listing the experiences most recent first
+func (e *Experience) ResetOrder(ctx context.Context, claim model.Claim) ([]model.Experience, error) {
+	if err := authz.RequireScope(claim, model.ScopeResumeWrite); err != nil {
+		return nil, err
+	}
+
+	if err := e.experienceRepo.SetExperiencePositions(ctx, claim.UserID, nil); err != nil {
+		return nil, err
+	}
+
+	return e.List(ctx, claim)
+}
+
+// Sort orders experiences by the position the owner gave them, then current
+// roles first and the others by when they ended, most recent first
+func Sort(experiences []model.Experience) {
+	entry.Sort(experiences, func(experience model.Experience) *int {
+		return experience.Position
+	}, func(a, b model.Experience) int {
+		if a.Current != b.Current {
+			if a.Current {
+				return -1
+			}
+			return 1
+		}
+
+		if a.End != nil && b.End != nil && *a.End != *b.End {
+			return b.End.Time().Compare(a.End.Time())
+		}
+
+		return b.Start.Time().Compare(a.Start.Time())
+	})
+}
+
+// apply validates request and copies it onto experience
+func (e *Experience) apply(ctx context.Context, experience *model.Experience, request model.ExperienceRequest) error {
+	experience.Company = strings.TrimSpace(request.Company)
+	experience.Title = strings.TrimSpace(request.Title)
+	experience.EmploymentType = request.EmploymentType
+	experience.Location = strings.TrimSpace(request.Location)
+	experience.Start = request.Start
+	experience.End = request.End
+	experience.Current = request.Current
+	experience.Highlights = entry.TrimAll(request.Highlights)
+	experience.Tags = entry.Dedupe(entry.TrimAll(request.Tags))
+	experience.UpdatedAt = time.Now()
+
+	details := validate(experience)
+
+	if experience.Current && experience.Company != "" {
+		taken, err := e.experienceRepo.HasCurrentExperience(ctx, experience.OwnerID, experience.Company, experience.ID)
+		if err != nil {
+			return err
+		}
+		if taken {
+			details["current"] = "another role at this company is already current"
+		}
+	}
+
+	if len(details) > 0 {
+		err := errorx.New(errorx.TypeInvalidParameter, invalidExperienceMessage, nil)
+		err.Details = details
+		return err
+	}
+
+	return nil
+}
+
+func validate(experience *model.Experience) map[string]interface{} {
+	details := map[string]interface{}{}
+
+	validateName(details, "company", experience.Company)
+	validateName(details, "title", experience.Title)
+	if utf8.RuneCountInString(experience.Location) > maxNameLength {
+		details["location"] = fmt.Sprintf("must not be longer than %d characters", maxNameLength)
+	}
+	if !slices.Contains(model.EmploymentTypes, experience.EmploymentType) {
+		details["employment_type"] = "must be one of full_time, part_time, contract, freelance, internship, volunteer"
+	}
+
+	if experience.Start.IsZero() {
+		details["start"] = "must not be empty"
+	} else if experience.Start.After(model.MonthOf(time.Now())) {
+		details["start"] = "must not be in the future"
+	}
+	switch {
+	case experience.Current && experience.End != nil:
+		details["end"] = "must be empty for the current role"
+	case !experience.Current && experience.End == nil:
+		details["end"] = "must not be empty unless the role is current"
+	case experience.End != nil && experience.End.Before(experience.Start):
+		details["end"] = "must not be before start"
+	}
+
+	if len(experience.Highlights) > maxHighlights {
+		details["highlights"] = fmt.Sprintf("must not have more than %d items", maxHighlights)
+	}
+	for _, highlight := range experience.Highlights {
+		if utf8.RuneCountInString(highlight) > maxHighlightLength {
+			details["highlights"] = fmt.Sprintf("must not have items longer than %d characters", maxHighlightLength)
+		}
+	}
+
+	if len(experience.Tags) > maxTags {
+		details["tags"] = fmt.Sprintf("must not have more than %d items", maxTags)
+	}
+	for _, tag := range experience.Tags {
+		if utf8.RuneCountInString(tag) > maxTagLength {
+			details["tags"] = fmt.Sprintf("must not have items longer than %d characters", maxTagLength)
+		}
+	}
+
+	return details
+}
+
+func validateName(details map[string]interface{}, field, value string) {
+	if value == "" {
+		details[field] = "must not be empty"
+	} else if utf8.RuneCountInString(value) > maxNameLength {
+		details[field] = fmt.Sprintf("must not be longer than %d characters", maxNameLength)
+	}
+}
@@ -0,0 +1,202 @@
+package usecase_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"devoratio.dev/web-resume/experience/usecase"
+	"devoratio.dev/web-resume/experience/usecase/repositorymock"
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/model"
+)
+
+var _ = Describe("Experience", Label("experience"), func() {
+	var (
+		mockController *gomock.Controller
+
+		experienceRepoMock *repositorymock.MockExperienceRepository
+
+		experienceUsecase *usecase.Experience
+		commonCtx         context.Context
+		claim             model.Claim
+		request           model.ExperienceRequest
+	)
+
+	BeforeEach(func() {
+		mockController = gomock.NewController(GinkgoT())
+
+		experienceRepoMock = repositorymock.NewMockExperienceRepository(mockController)
+
+		experienceUsecase = usecase.NewUsecase(experienceRepoMock)
+
+		claim = model.Claim{UserID: 1, Username: "devoratio", Scopes: []string{model.ScopeResumeWrite}}
+		end := model.NewMonth(2023, time.June)
+		request = model.ExperienceRequest{
+			Company:        "Devoratio",
+			Title:          "Backend Engineer",
+			EmploymentType: model.EmploymentFullTime,
+			Location:       "Jakarta",
+			Start:          model.NewMonth(2021, time.March),
+			End:            &end,
+			Highlights:     []string{" Built the billing API ", ""},
+			Tags:           []string{"Go", "go", "PostgreSQL"},
+		}
+
+		commonCtx = context.Background()
+	})
+
+	AfterEach(func() {
+		mockController.Finish()
+	})
+
+	Describe("Create an experience", func() {
+		When("the token cannot write the resume", func() {
+			It("forbids it", func(ctx SpecContext) {
+				claim.Scopes = []string{model.ScopeResumeRead}
+
+				result, err := experienceUsecase.Create(commonCtx, claim, request)
+				Expect(err).Should(Equal(errorx.ErrForbidden))
+				Expect(result).Should(BeNil())
+			}, SpecTimeout(time.Second*2))
+		})
+
+		When("the experience is invalid", func() {
+			It("tells the owner which fields are invalid", func(ctx SpecContext) {
+				end := model.NewMonth(2020, time.January)
+				request.Company = ""
+				request.EmploymentType = "gig"
+				request.End = &end
+
+				result, err := experienceUsecase.Create(commonCtx, claim, request)
+				Expect(err.(*errorx.Error).Type).Should(Equal(errorx.TypeInvalidParameter))
+				Expect(err.(*errorx.Error).Details).Should(HaveKey("company"))
+				Expect(err.(*errorx.Error).Details).Should(HaveKey("employment_type"))
+				Expect(err.(*errorx.Error).Details).Should(HaveKeyWithValue("end", "must not be before start"))
+				Expect(result).Should(BeNil())
+			}, SpecTimeout(time.Second*2))
+		})
+
+		When("a past role has no end", func() {
+			It("asks for the end", func(ctx SpecContext) {
+				request.End = nil
+
+				_, err := experienceUsecase.Create(commonCtx, claim, request)
+				Expect(err.(*errorx.Error).Details).Should(HaveKey("end"))
+			}, SpecTimeout(time.Second*2))
+		})
+
+		When("another role at the company is already current", func() {
+			It("refuses a second current role", func(ctx SpecContext) {
+				request.End = nil
+				request.Current = true
+
+				experienceRepoMock.EXPECT().HasCurrentExperience(commonCtx, claim.UserID, "Devoratio", uint(0)).Return(true, nil).Times(1)
+
+				result, err := experienceUsecase.Create(commonCtx, claim, request)
+				Expect(err.(*errorx.Error).Type).Should(Equal(errorx.TypeInvalidParameter))
+				Expect(err.(*errorx.Error).Details).Should(HaveKey("current"))
+				Expect(result).Should(BeNil())
+			}, SpecTimeout(time.Second*2))
+		})
+
+		When("the experience is valid", func() {
+			It("stores the cleaned up experience", func(ctx SpecContext) {
+				experienceRepoMock.EXPECT().CreateExperience(commonCtx, gomock.Any()).DoAndReturn(
+					func(_ context.Context, experience *model.Experience) error {
+						experience.ID = 3
+						return nil
+					}).Times(1)
+
+				result, err := experienceUsecase.Create(commonCtx, claim, request)
+				Expect(err).Should(BeNil())
+				Expect(result.ID).Should(Equal(uint(3)))
+				Expect(result.OwnerID).Should(Equal(claim.UserID))
+				Expect(result.Highlights).Should(Equal([]string{"Built the billing API"}))
+				Expect(result.Tags).Should(Equal([]string{"Go", "PostgreSQL"}))
+			}, SpecTimeout(time.Second*2))
+		})
+	})
+
+	Describe("Update an experience", func() {
+		When("the experience belongs to someone else", func() {
+			It("tells the owner it does not exist", func(ctx SpecContext) {
+				experienceRepoMock.EXPECT().GetExperience(commonCtx, claim.UserID, uint(9)).Return(nil, errorx.ErrNotFound).Times(1)
+
+				result, err := experienceUsecase.Update(commonCtx, claim, 9, request)
+				Expect(err).Should(Equal(errorx.ErrNotFound))
+				Expect(result).Should(BeNil())
+			}, SpecTimeout(time.Second*2))
+		})
+
+		When("the role becomes current", func() {
+			It("ignores the experience itself when looking for another current role", func(ctx SpecContext) {
+				request.End = nil
+				request.Current = true
+
+				experienceRepoMock.EXPECT().GetExperience(commonCtx, claim.UserID, uint(3)).
+					Return(&model.Experience{ID: 3, OwnerID: claim.UserID}, nil).Times(1)
+				experienceRepoMock.EXPECT().HasCurrentExperience(commonCtx, claim.UserID, "Devoratio", uint(3)).Return(false, nil).Times(1)
+				experienceRepoMock.EXPECT().UpdateExperience(commonCtx, gomock.Any()).Return(nil).Times(1)
+
+				result, err := experienceUsecase.Update(commonCtx, claim, 3, request)
+				Expect(err).Should(BeNil())
+				Expect(result.Current).Should(BeTrue())
+			}, SpecTimeout(time.Second*2))
+		})
+	})
+
+	Describe("List the experiences", func() {
+		It("lists the current role first, then the others most recent first", func(ctx SpecContext) {
+			older, newer := model.NewMonth(2019, time.May), model.NewMonth(2022, time.February)
+			experienceRepoMock.EXPECT().ListExperiences(commonCtx, claim.UserID).Return([]model.Experience{
+				{ID: 1, Start: model.NewMonth(2017, time.January), End: &older},
+				{ID: 2, Start: model.NewMonth(2022, time.March), Current: true},
+				{ID: 3, Start: model.NewMonth(2019, time.June), End: &newer},
+			}, nil).Times(1)
+
+			result, err := experienceUsecase.List(commonCtx, claim)
+			Expect(err).Should(BeNil())
+			Expect([]uint{result[0].ID, result[1].ID, result[2].ID}).Should(Equal([]uint{2, 3, 1}))
+		}, SpecTimeout(time.Second*2))
+
+		It("follows the order set by the owner", func(ctx SpecContext) {
+			first, second := 0, 1
+			experienceRepoMock.EXPECT().ListExperiences(commonCtx, claim.UserID).Return([]model.Experience{
+				{ID: 1, Start: model.NewMonth(2017, time.January), Position: &first},
+				{ID: 2, Start: model.NewMonth(2022, time.March), Current: true, Position: &second},
+			}, nil).Times(1)
+
+			result, err := experienceUsecase.List(commonCtx, claim)
+			Expect(err).Should(BeNil())
+			Expect([]uint{result[0].ID, result[1].ID}).Should(Equal([]uint{1, 2}))
+		}, SpecTimeout(time.Second*2))
+	})
+
+	Describe("Reorder the experiences", func() {
+		BeforeEach(func() {
+			experienceRepoMock.EXPECT().ListExperiences(commonCtx, claim.UserID).
+				Return([]model.Experience{{ID: 1}, {ID: 2}}, nil).AnyTimes()
+		})
+
+		When("the order leaves out an experience", func() {
+			It("refuses the order", func(ctx SpecContext) {
+				result, err := experienceUsecase.Reorder(commonCtx, claim, model.OrderRequest{IDs: []uint{2}})
+				Expect(err.(*errorx.Error).Type).Should(Equal(errorx.TypeInvalidParameter))
+				Expect(result).Should(BeNil())
+			}, SpecTimeout(time.Second*2))
+		})
+
+		When("the order lists every experience", func() {
+			It("stores the positions", func(ctx SpecContext) {
+				experienceRepoMock.EXPECT().SetExperiencePositions(commonCtx, claim.UserID, []uint{2, 1}).Return(nil).Times(1)
+
+				_, err := experienceUsecase.Reorder(commonCtx, claim, model.OrderRequest{IDs: []uint{2, 1}})
+				Expect(err).Should(BeNil())
+			}, SpecTimeout(time.Second*2))
+		})
+	})
+})
@@ -0,0 +1,137 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: devoratio.dev/web-resume/experience/usecase (interfaces: ExperienceRepository)
+
+// Package repositorymock is a generated GoMock package.
+package repositorymock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "devoratio.dev/web-resume/model"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockExperienceRepository is a mock of ExperienceRepository interface.
+type MockExperienceRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockExperienceRepositoryMockRecorder
+}
+
+// MockExperienceRepositoryMockRecorder is the mock recorder for MockExperienceRepository.
+type MockExperienceRepositoryMockRecorder struct {
+	mock *MockExperienceRepository
+}
+
+// NewMockExperienceRepository creates a new mock instance.
+func NewMockExperienceRepository(ctrl *gomock.Controller) *MockExperienceRepository {
+	mock := &MockExperienceRepository{ctrl: ctrl}
+	mock.recorder = &MockExperienceRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExperienceRepository) EXPECT() *MockExperienceRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateExperience mocks base method.
+func (m *MockExperienceRepository) CreateExperience(arg0 context.Context, arg1 *model.Experience) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateExperience", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateExperience indicates an expected call of CreateExperience.
+func (mr *MockExperienceRepositoryMockRecorder) CreateExperience(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateExperience", reflect.TypeOf((*MockExperienceRepository)(nil).CreateExperience), arg0, arg1)
+}
+
+// DeleteExperience mocks base method.
+func (m *MockExperienceRepository) DeleteExperience(arg0 context.Context, arg1, arg2 uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExperience", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteExperience indicates an expected call of DeleteExperience.
+func (mr *MockExperienceRepositoryMockRecorder) DeleteExperience(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExperience", reflect.TypeOf((*MockExperienceRepository)(nil).DeleteExperience), arg0, arg1, arg2)
+}
+
+// GetExperience mocks base method.
+func (m *MockExperienceRepository) GetExperience(arg0 context.Context, arg1, arg2 uint) (*model.Experience, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExperience", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*model.Experience)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExperience indicates an expected call of GetExperience.
+func (mr *MockExperienceRepositoryMockRecorder) GetExperience(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExperience", reflect.TypeOf((*MockExperienceRepository)(nil).GetExperience), arg0, arg1, arg2)
+}
+
+// HasCurrentExperience mocks base method.
+func (m *MockExperienceRepository) HasCurrentExperience(arg0 context.Context, arg1 uint, arg2 string, arg3 uint) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasCurrentExperience", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasCurrentExperience indicates an expected call of HasCurrentExperience.
+func (mr *MockExperienceRepositoryMockRecorder) HasCurrentExperience(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasCurrentExperience", reflect.TypeOf((*MockExperienceRepository)(nil).HasCurrentExperience), arg0, arg1, arg2, arg3)
+}
+
+// ListExperiences mocks base method.
+func (m *MockExperienceRepository) ListExperiences(arg0 context.Context, arg1 uint) ([]model.Experience, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListExperiences", arg0, arg1)
+	ret0, _ := ret[0].([]model.Experience)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListExperiences indicates an expected call of ListExperiences.
+func (mr *MockExperienceRepositoryMockRecorder) ListExperiences(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExperiences", reflect.TypeOf((*MockExperienceRepository)(nil).ListExperiences), arg0, arg1)
+}
+
+// SetExperiencePositions mocks base method.
+func (m *MockExperienceRepository) SetExperiencePositions(arg0 context.Context, arg1 uint, arg2 []uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetExperiencePositions", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetExperiencePositions indicates an expected call of SetExperiencePositions.
+func (mr *MockExperienceRepositoryMockRecorder) SetExperiencePositions(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetExperiencePositions", reflect.TypeOf((*MockExperienceRepository)(nil).SetExperiencePositions), arg0, arg1, arg2)
+}
+
+// UpdateExperience mocks base method.
+func (m *MockExperienceRepository) UpdateExperience(arg0 context.Context, arg1 *model.Experience) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateExperience", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateExperience indicates an expected call of UpdateExperience.
+func (mr *MockExperienceRepositoryMockRecorder) UpdateExperience(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateExperience", reflect.TypeOf((*MockExperienceRepository)(nil).UpdateExperience), arg0, arg1)
+}
@@ -1,6 +1,6 @@
 module devoratio.dev/web-resume
 
-go 1.21.3
+go 1.22
 
 require (
 	github.com/brianvoe/gofakeit/v6 v6.27.0
@@ -46,7 +46,7 @@ require (
 	golang.org/x/exp v0.0.0-20230905200255-921286631fa9 // indirect
 	golang.org/x/sync v0.6.0 // indirect
 	golang.org/x/sys v0.16.0 // indirect
-	golang.org/x/text v0.14.0 // indirect
+	golang.org/x/text v0.14.0
 	gopkg.in/ini.v1 v1.67.0 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 	gorm.io/driver/postgres v1.5.4
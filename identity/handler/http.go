@@ -0,0 +1,119 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/internal/httpx"
+	"devoratio.dev/web-resume/model"
+)
+
+type IdentityUsecase interface {
+	RequestLink(ctx context.Context, claim model.Claim, provider string) (string, error)
+	Link(ctx context.Context, claim model.Claim, provider, code, state string) (*model.ExternalIdentity, error)
+	List(ctx context.Context, claim model.Claim) ([]model.ExternalIdentity, error)
+	Unlink(ctx context.Context, claim model.Claim, identityID uint) error
+}
+
+type HTTP struct {
+	identityUsecase IdentityUsecase
+}
+
+func NewHTTP(identityUsecase IdentityUsecase) *HTTP {
+	return &HTTP{
+		identityUsecase: identityUsecase,
+	}
+}
+
+func (h *HTTP) RegisterRoutes(mux *http.ServeMux, authenticate httpx.Middleware) {
+	mux.Handle("GET /v1/owner/identities", authenticate(http.HandlerFunc(h.list)))
+	mux.Handle("POST /v1/owner/identities/{provider}", authenticate(http.HandlerFunc(h.requestLink)))
+	mux.Handle("POST /v1/owner/identities/{provider}/callback", authenticate(http.HandlerFunc(h.link)))
+	mux.Handle("DELETE /v1/owner/identities/{id}", authenticate(http.HandlerFunc(h.unlink)))
+}
+
+type linkResponse struct {
+	AuthorizationURL string `json:"authorization_url"`
+}
+
+func (h *HTTP) requestLink(w http.ResponseWriter, r *http.Request) {
+	claim, ok := httpx.ClaimFromContext(r.Context())
+	if !ok {
+		httpx.WriteError(w, errorx.ErrUnauthorized)
+		return
+	}
+
+	authURL, err := h.identityUsecase.RequestLink(r.Context(), *claim, r.PathValue("provider"))
+	if err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, http.StatusOK, linkResponse{AuthorizationURL: authURL})
+}
+
+type callbackRequest struct {
+	Code  string `json:"code"`
+	State string `json:"state"`
+}
+
+func (h *HTTP) link(w http.ResponseWriter, r *http.Request) {
+	claim, ok := httpx.ClaimFromContext(r.Context())
+	if !ok {
+		httpx.WriteError(w, errorx.ErrUnauthorized)
+		return
+	}
+
+	var request callbackRequest
+	if err := httpx.DecodeJSON(w, r, &request); err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	identity, err := h.identityUsecase.Link(r.Context(), *claim, r.PathValue("provider"), request.Code, request.State)
+	if err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, http.StatusCreated, identity)
+}
+
+func (h *HTTP) list(w http.ResponseWriter, r *http.Request) {
+	claim, ok := httpx.ClaimFromContext(r.Context())
+	if !ok {
+		httpx.WriteError(w, errorx.ErrUnauthorized)
+		return
+	}
+
+	identities, err := h.identityUsecase.List(r.Context(), *claim)
+	if err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, http.StatusOK, identities)
+}
+
+func (h *HTTP) unlink(w http.ResponseWriter, r *http.Request) {
+	claim, ok := httpx.ClaimFromContext(r.Context())
+	if !ok {
+		httpx.WriteError(w, errorx.ErrUnauthorized)
+		return
+	}
+
+	identityID, err := strconv.ParseUint(r.PathValue("id"), 10, 0)
+	if err != nil {
+		httpx.WriteError(w, errorx.ErrNotFound)
+		return
+	}
+
+	if err := h.identityUsecase.Unlink(r.Context(), *claim, uint(identityID)); err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	httpx.WriteNoContent(w)
+}
@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/internal/tenancy"
+	"devoratio.dev/web-resume/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type PostgreSQLDatabase struct {
+	db *gorm.DB
+}
+
+func NewPostgreSQL(db *gorm.DB) *PostgreSQLDatabase {
+	return &PostgreSQLDatabase{
+		db: db,
+	}
+}
+
+func (p *PostgreSQLDatabase) CreateOAuthState(ctx context.Context, state *model.OAuthState) error {
+	if err := p.db.WithContext(ctx).Create(state).Error; err != nil {
+		return errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), err)
+	}
+
+	return nil
+}
+
+func (p *PostgreSQLDatabase) ConsumeOAuthState(ctx context.Context, stateHash, provider string, purpose model.OAuthPurpose) (*model.OAuthState, error) {
+	now := time.Now()
+	state := &model.OAuthState{}
+
+	result := p.db.WithContext(ctx).Model(state).Clauses(clause.Returning{}).
+		Scopes(tenancy.Scope(ctx, "owner_id")).
+		Where("state_hash = ? AND provider = ? AND purpose = ? AND used_at IS NULL AND expires_at > ?", stateHash, provider, purpose, now).
+		Update("used_at", now)
+	if result.Error != nil {
+		return nil, errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil, errorx.ErrNotFound
+	}
+
+	return state, nil
+}
+
+func (p *PostgreSQLDatabase) GetIdentity(ctx context.Context, provider, subject string) (*model.ExternalIdentity, error) {
+	identity := &model.ExternalIdentity{}
+	result := p.db.WithContext(ctx).Where("provider = ? AND subject = ?", provider, subject).First(identity)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, errorx.ErrNotFound
+		}
+		return nil, errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+
+	return identity, nil
+}
+
+func (p *PostgreSQLDatabase) CreateIdentity(ctx context.Context, identity *model.ExternalIdentity) error {
+	if err := p.db.WithContext(ctx).Create(identity).Error; err != nil {
+		return errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), err)
+	}
+
+	return nil
+}
+
+func (p *PostgreSQLDatabase) ListIdentities(ctx context.Context, ownerID uint) ([]model.ExternalIdentity, error) {
+	var identities []model.ExternalIdentity
+	result := p.db.WithContext(ctx).Where("owner_id = ?", ownerID).Order("id").Find(&identities)
+	if result.Error != nil {
+		return nil, errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+
+	return identities, nil
+}
+
+func (p *PostgreSQLDatabase) DeleteIdentity(ctx context.Context, ownerID, identityID uint) error {
+	result := p.db.WithContext(ctx).Where("id = ? AND owner_id = ?", identityID, ownerID).Delete(&model.ExternalIdentity{})
+	if result.Error != nil {
+		return errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return errorx.ErrNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,144 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"devoratio.dev/web-resume/config"
+	"devoratio.dev/web-resume/internal/authz"
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/internal/generator"
+	"devoratio.dev/web-resume/internal/hasher"
+	"devoratio.dev/web-resume/internal/oauth"
+	"devoratio.dev/web-resume/model"
+)
+
+const invalidOAuthStateMessage = "link request is invalid or has expired"
+
+//go:generate mockgen -destination=repositorymock/postgresqlmock.go -package=repositorymock . IdentityRepository
+type IdentityRepository interface {
+	CreateOAuthState(ctx context.Context, state *model.OAuthState) error
+	// ConsumeOAuthState marks a state as used and returns it. It returns
+	// errorx.ErrNotFound when the state is unknown, already used or expired.
+	ConsumeOAuthState(ctx context.Context, stateHash, provider string, purpose model.OAuthPurpose) (*model.OAuthState, error)
+	GetIdentity(ctx context.Context, provider, subject string) (*model.ExternalIdentity, error)
+	CreateIdentity(ctx context.Context, identity *model.ExternalIdentity) error
+	ListIdentities(ctx context.Context, ownerID uint) ([]model.ExternalIdentity, error)
+	DeleteIdentity(ctx context.Context, ownerID, identityID uint) error
+}
+
+//go:generate mockgen -destination=usecasemock/identityprovidermock.go -package=usecasemock . IdentityProvider
+type IdentityProvider interface {
+	AuthCodeURL(ctx context.Context, provider, state, codeChallenge string) (string, error)
+	Exchange(ctx context.Context, provider, code, codeVerifier string) (*model.ExternalProfile, error)
+}
+
+type Identity struct {
+	identityRepo     IdentityRepository
+	identityProvider IdentityProvider
+	appConfig        *config.Application
+}
+
+func NewUsecase(identityRepo IdentityRepository, identityProvider IdentityProvider, appConfig *config.Application) *Identity {
+	return &Identity{
+		identityRepo:     identityRepo,
+		identityProvider: identityProvider,
+		appConfig:        appConfig,
+	}
+}
+
+// RequestLink starts linking an account at an identity provider to the signed
+// in owner and returns the provider page the owner has to be sent to
+func (i *Identity) RequestLink(ctx context.Context, claim model.Claim, provider string) (string, error) {
+	if err := authz.RequireScope(claim, model.ScopeAccountManage); err != nil {
+		return "", err
+	}
+
+	state, err := generator.GenerateRandomToken()
+	if err != nil {
+		return "", err
+	}
+	codeVerifier, err := oauth.NewCodeVerifier()
+	if err != nil {
+		return "", err
+	}
+
+	authURL, err := i.identityProvider.AuthCodeURL(ctx, provider, state, oauth.CodeChallenge(codeVerifier))
+	if err != nil {
+		return "", err
+	}
+
+	now := time.Now()
+	err = i.identityRepo.CreateOAuthState(ctx, &model.OAuthState{
+		StateHash:    hasher.HashToken(state),
+		Provider:     provider,
+		Purpose:      model.OAuthPurposeLink,
+		OwnerID:      &claim.UserID,
+		CodeVerifier: codeVerifier,
+		ExpiresAt:    now.Add(i.appConfig.Authentication.OAuth.StateTTL),
+		CreatedAt:    now,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return authURL, nil
+}
+
+// Link completes a link started by RequestLink once the provider redirected
+// the owner back with an authorization code
+func (i *Identity) Link(ctx context.Context, claim model.Claim, provider, code, state string) (*model.ExternalIdentity, error) {
+	if err := authz.RequireScope(claim, model.ScopeAccountManage); err != nil {
+		return nil, err
+	}
+
+	oauthState, err := i.identityRepo.ConsumeOAuthState(ctx, hasher.HashToken(state), provider, model.OAuthPurposeLink)
+	if err != nil {
+		if errorx.Is(err, errorx.ErrNotFound) {
+			return nil, errorx.New(errorx.TypeInvalidParameter, invalidOAuthStateMessage, err)
+		}
+		return nil, err
+	}
+
+	// A state is bound to the owner who started the link, so a callback can't be
+	// replayed into another signed in browser
+	if oauthState.OwnerID == nil || *oauthState.OwnerID != claim.UserID {
+		return nil, errorx.New(errorx.TypeInvalidParameter, invalidOAuthStateMessage, nil)
+	}
+
+	profile, err := i.identityProvider.Exchange(ctx, provider, code, oauthState.CodeVerifier)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = i.identityRepo.GetIdentity(ctx, provider, profile.Subject)
+	if err == nil {
+		return nil, errorx.New(errorx.TypeInvalidParameter, "identity is already linked", nil)
+	}
+	if !errorx.Is(err, errorx.ErrNotFound) {
+		return nil, err
+	}
+
+	identity := &model.ExternalIdentity{
+		OwnerID:   claim.UserID,
+		Provider:  provider,
+		Subject:   profile.Subject,
+		Username:  profile.Username,
+		Email:     profile.Email,
+		CreatedAt: time.Now(),
+	}
+	if err := i.identityRepo.CreateIdentity(ctx, identity); err != nil {
+		return nil, err
+	}
+
+	return identity, nil
+}
+
+func (i *Identity) List(ctx context.Context, claim model.Claim) ([]model.ExternalIdentity, error) {
+	return i.identityRepo.ListIdentities(ctx, claim.UserID)
+}
+
+// Unlink stops an identity from signing in as the owner
+func (i *Identity) Unlink(ctx context.Context, claim model.Claim, identityID uint) error {
+	return i.identityRepo.DeleteIdentity(ctx, claim.UserID, identityID)
+}
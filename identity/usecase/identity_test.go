@@ -0,0 +1,151 @@
+package usecase_test
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"devoratio.dev/web-resume/config"
+	"devoratio.dev/web-resume/identity/usecase"
+	"devoratio.dev/web-resume/identity/usecase/repositorymock"
+	"devoratio.dev/web-resume/identity/usecase/usecasemock"
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/internal/hasher"
+	"devoratio.dev/web-resume/internal/oauth"
+	"devoratio.dev/web-resume/model"
+)
+
+var _ = Describe("Linked identities", func() {
+	var (
+		mockController *gomock.Controller
+
+		identityRepoMock     *repositorymock.MockIdentityRepository
+		identityProviderMock *usecasemock.MockIdentityProvider
+
+		commonCtx       context.Context
+		identityUsecase *usecase.Identity
+		claimStub       model.Claim
+		profileStub     model.ExternalProfile
+		oauthStateStub  model.OAuthState
+		stateHash       = hasher.HashToken("state")
+	)
+
+	BeforeEach(func() {
+		gofakeit.Seed(time.Now().UnixNano())
+		mockController = gomock.NewController(GinkgoT())
+
+		identityRepoMock = repositorymock.NewMockIdentityRepository(mockController)
+		identityProviderMock = usecasemock.NewMockIdentityProvider(mockController)
+		appConfig := &config.Application{
+			Authentication: config.Authentication{
+				OAuth: config.OAuth{
+					StateTTL: 10 * time.Minute,
+				},
+			},
+		}
+
+		identityUsecase = usecase.NewUsecase(identityRepoMock, identityProviderMock, appConfig)
+
+		gofakeit.Struct(&claimStub)
+		claimStub.Scopes = slices.Clone(model.SessionScopes)
+		gofakeit.Struct(&profileStub)
+		ownerID := claimStub.UserID
+		oauthStateStub = model.OAuthState{Provider: "github", Purpose: model.OAuthPurposeLink, OwnerID: &ownerID, CodeVerifier: "codeverifier"}
+
+		commonCtx = context.Background()
+	})
+
+	AfterEach(func() {
+		mockController.Finish()
+	})
+
+	When("the owner starts linking an identity", func() {
+		It("stores a state bound to the owner and returns the provider page", func(ctx SpecContext) {
+			var state, codeChallenge string
+
+			identityProviderMock.EXPECT().AuthCodeURL(commonCtx, "github", gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, _, s, challenge string) (string, error) {
+					state, codeChallenge = s, challenge
+					return "https://github.com/login/oauth/authorize", nil
+				})
+			identityRepoMock.EXPECT().CreateOAuthState(commonCtx, gomock.Any()).DoAndReturn(
+				func(_ context.Context, oauthState *model.OAuthState) error {
+					Expect(oauthState.StateHash).Should(Equal(hasher.HashToken(state)))
+					Expect(oauthState.Purpose).Should(Equal(model.OAuthPurposeLink))
+					Expect(*oauthState.OwnerID).Should(Equal(claimStub.UserID))
+					Expect(oauth.CodeChallenge(oauthState.CodeVerifier)).Should(Equal(codeChallenge))
+					return nil
+				})
+
+			authURL, err := identityUsecase.RequestLink(commonCtx, claimStub, "github")
+			Expect(err).Should(BeNil())
+			Expect(authURL).Should(Equal("https://github.com/login/oauth/authorize"))
+		}, SpecTimeout(time.Second*2))
+	})
+
+	When("the provider redirects the owner back", func() {
+		Context("the state is unknown, used or expired", func() {
+			It("tells the owner that the request is invalid", func(ctx SpecContext) {
+				identityRepoMock.EXPECT().ConsumeOAuthState(commonCtx, stateHash, "github", model.OAuthPurposeLink).Return(nil, errorx.ErrNotFound)
+
+				result, err := identityUsecase.Link(commonCtx, claimStub, "github", "code", "state")
+				Expect(err.(*errorx.Error).Type).Should(Equal(errorx.TypeInvalidParameter))
+				Expect(result).Should(BeNil())
+			}, SpecTimeout(time.Second*2))
+		})
+
+		Context("the state was started by another owner", func() {
+			It("tells the owner that the request is invalid without exchanging the code", func(ctx SpecContext) {
+				otherOwnerID := claimStub.UserID + 1
+				oauthStateStub.OwnerID = &otherOwnerID
+				identityRepoMock.EXPECT().ConsumeOAuthState(commonCtx, stateHash, "github", model.OAuthPurposeLink).Return(&oauthStateStub, nil)
+
+				result, err := identityUsecase.Link(commonCtx, claimStub, "github", "code", "state")
+				Expect(err.(*errorx.Error).Type).Should(Equal(errorx.TypeInvalidParameter))
+				Expect(result).Should(BeNil())
+			}, SpecTimeout(time.Second*2))
+		})
+
+		Context("the identity is already linked", func() {
+			It("tells the owner that the identity is already linked", func(ctx SpecContext) {
+				identityRepoMock.EXPECT().ConsumeOAuthState(commonCtx, stateHash, "github", model.OAuthPurposeLink).Return(&oauthStateStub, nil)
+				identityProviderMock.EXPECT().Exchange(commonCtx, "github", "code", "codeverifier").Return(&profileStub, nil)
+				identityRepoMock.EXPECT().GetIdentity(commonCtx, "github", profileStub.Subject).Return(&model.ExternalIdentity{}, nil)
+
+				result, err := identityUsecase.Link(commonCtx, claimStub, "github", "code", "state")
+				Expect(err.(*errorx.Error).Type).Should(Equal(errorx.TypeInvalidParameter))
+				Expect(result).Should(BeNil())
+			}, SpecTimeout(time.Second*2))
+		})
+
+		Context("the identity is not linked yet", func() {
+			It("links the identity to the owner", func(ctx SpecContext) {
+				identityRepoMock.EXPECT().ConsumeOAuthState(commonCtx, stateHash, "github", model.OAuthPurposeLink).Return(&oauthStateStub, nil)
+				identityProviderMock.EXPECT().Exchange(commonCtx, "github", "code", "codeverifier").Return(&profileStub, nil)
+				identityRepoMock.EXPECT().GetIdentity(commonCtx, "github", profileStub.Subject).Return(nil, errorx.ErrNotFound)
+				identityRepoMock.EXPECT().CreateIdentity(commonCtx, gomock.Any()).Return(nil)
+
+				result, err := identityUsecase.Link(commonCtx, claimStub, "github", "code", "state")
+				Expect(err).Should(BeNil())
+				Expect(result.OwnerID).Should(Equal(claimStub.UserID))
+				Expect(result.Provider).Should(Equal("github"))
+				Expect(result.Subject).Should(Equal(profileStub.Subject))
+				Expect(result.Username).Should(Equal(profileStub.Username))
+			}, SpecTimeout(time.Second*2))
+		})
+	})
+
+	When("the owner unlinks an identity they do not have", func() {
+		It("tells the owner the identity does not exist", func(ctx SpecContext) {
+			identityRepoMock.EXPECT().DeleteIdentity(commonCtx, claimStub.UserID, uint(7)).Return(errorx.ErrNotFound)
+
+			err := identityUsecase.Unlink(commonCtx, claimStub, 7)
+			Expect(err).Should(Equal(errorx.ErrNotFound))
+		}, SpecTimeout(time.Second*2))
+	})
+})
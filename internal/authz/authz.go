@@ -0,0 +1,30 @@
+// Package authz decides what an authenticated claim is allowed to do. The
+// checks are shared by the HTTP middlewares and the usecases, so every
+// mutating usecase repeats the scope its route requires.
+package authz
+
+import (
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/model"
+)
+
+// RequireScope returns errorx.ErrForbidden unless claim was granted every one
+// of scopes
+func RequireScope(claim model.Claim, scopes ...string) error {
+	for _, scope := range scopes {
+		if !claim.HasScope(scope) {
+			return errorx.ErrForbidden
+		}
+	}
+
+	return nil
+}
+
+// RequireRole returns errorx.ErrForbidden unless claim holds role
+func RequireRole(claim model.Claim, role string) error {
+	if !claim.HasRole(role) {
+		return errorx.ErrForbidden
+	}
+
+	return nil
+}
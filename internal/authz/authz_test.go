@@ -0,0 +1,55 @@
+package authz
+
+import (
+	"testing"
+
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/model"
+)
+
+func TestRequireScope(t *testing.T) {
+	claim := model.Claim{Scopes: []string{model.ScopeResumeRead, model.ScopeResumeWrite}}
+
+	tests := []struct {
+		name    string
+		scopes  []string
+		wantErr error
+	}{
+		{name: "no scope required", scopes: nil},
+		{name: "granted scope", scopes: []string{model.ScopeResumeRead}},
+		{name: "every granted scope", scopes: []string{model.ScopeResumeRead, model.ScopeResumeWrite}},
+		{name: "missing scope", scopes: []string{model.ScopeAccountManage}, wantErr: errorx.ErrForbidden},
+		{name: "one missing scope", scopes: []string{model.ScopeResumeRead, model.ScopeAnalyticsRead}, wantErr: errorx.ErrForbidden},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := RequireScope(claim, tt.scopes...); err != tt.wantErr {
+				t.Errorf("RequireScope() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRequireRole(t *testing.T) {
+	owner := &model.Owner{}
+	admin := &model.Owner{SiteAdmin: true}
+
+	tests := []struct {
+		name    string
+		claim   model.Claim
+		role    string
+		wantErr error
+	}{
+		{name: "owner acting as owner", claim: model.Claim{Roles: owner.Roles()}, role: model.RoleOwner},
+		{name: "owner acting as admin", claim: model.Claim{Roles: owner.Roles()}, role: model.RoleAdmin, wantErr: errorx.ErrForbidden},
+		{name: "admin acting as admin", claim: model.Claim{Roles: admin.Roles()}, role: model.RoleAdmin},
+		{name: "token without roles", claim: model.Claim{}, role: model.RoleOwner, wantErr: errorx.ErrForbidden},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := RequireRole(tt.claim, tt.role); err != tt.wantErr {
+				t.Errorf("RequireRole() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,108 @@
+// Package entry holds what the sections of a resume, such as experience and
+// education, have in common.
+package entry
+
+import (
+	"slices"
+	"strings"
+
+	"devoratio.dev/web-resume/model"
+)
+
+// Span is the months an entry ran for, both ends included
+type Span struct {
+	Start model.Month
+	End   model.Month
+}
+
+// Sort orders entries by the position the owner gave them, entries without one
+// following in the order compare puts them in
+func Sort[T any](entries []T, position func(T) *int, compare func(a, b T) int) {
+	slices.SortStableFunc(entries, func(a, b T) int {
+		positionA, positionB := position(a), position(b)
+		switch {
+		case positionA != nil && positionB != nil:
+			return *positionA - *positionB
+		case positionA != nil:
+			return -1
+		case positionB != nil:
+			return 1
+		default:
+			return compare(a, b)
+		}
+	})
+}
+
+// IsPermutation reports whether ids lists every one of existing exactly once
+func IsPermutation(ids, existing []uint) bool {
+	if len(ids) != len(existing) {
+		return false
+	}
+
+	sorted := slices.Clone(ids)
+	slices.Sort(sorted)
+	expected := slices.Clone(existing)
+	slices.Sort(expected)
+
+	return slices.Equal(sorted, expected)
+}
+
+// TrimAll trims every value, dropping the empty ones. It never returns nil so
+// that lists are stored and rendered as empty lists.
+func TrimAll(values []string) []string {
+	trimmed := make([]string, 0, len(values))
+	for _, value := range values {
+		if value = strings.TrimSpace(value); value != "" {
+			trimmed = append(trimmed, value)
+		}
+	}
+
+	return trimmed
+}
+
+// Dedupe drops values repeating an earlier one regardless of case
+func Dedupe(values []string) []string {
+	seen := map[string]bool{}
+	deduped := make([]string, 0, len(values))
+	for _, value := range values {
+		key := strings.ToLower(value)
+		if !seen[key] {
+			seen[key] = true
+			deduped = append(deduped, value)
+		}
+	}
+
+	return deduped
+}
+
+// Months returns the number of months spans cover, counting months covered by
+// more than one span once. Spans ending before they start are ignored.
+func Months(spans []Span) int {
+	sorted := slices.Clone(spans)
+	slices.SortFunc(sorted, func(a, b Span) int {
+		return b.Start.MonthsUntil(a.Start)
+	})
+
+	months := 0
+	var covered *Span
+	for _, span := range sorted {
+		if span.End.Before(span.Start) {
+			continue
+		}
+
+		switch {
+		case covered == nil || covered.End.Next().Before(span.Start):
+			if covered != nil {
+				months += covered.Start.MonthsUntil(covered.End) + 1
+			}
+			covered = &Span{Start: span.Start, End: span.End}
+		case span.End.After(covered.End):
+			covered.End = span.End
+		}
+	}
+	if covered != nil {
+		months += covered.Start.MonthsUntil(covered.End) + 1
+	}
+
+	return months
+}
@@ -0,0 +1,175 @@
+package entry
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"devoratio.dev/web-resume/model"
+)
+
+type positioned struct {
+	name     string
+	position *int
+	rank     int
+}
+
+func TestSort(t *testing.T) {
+	first, second := 0, 1
+
+	tests := []struct {
+		name    string
+		entries []positioned
+		want    []string
+	}{
+		{
+			name:    "fall back to compare without positions",
+			entries: []positioned{{name: "b", rank: 2}, {name: "a", rank: 1}, {name: "c", rank: 3}},
+			want:    []string{"a", "b", "c"},
+		},
+		{
+			name:    "follow positions",
+			entries: []positioned{{name: "a", rank: 1, position: &second}, {name: "b", rank: 2, position: &first}},
+			want:    []string{"b", "a"},
+		},
+		{
+			name: "put entries without a position last",
+			entries: []positioned{
+				{name: "c", rank: 3},
+				{name: "a", rank: 1},
+				{name: "b", rank: 2, position: &first},
+			},
+			want: []string{"b", "a", "c"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			Sort(tt.entries, func(e positioned) *int { return e.position }, func(a, b positioned) int { return a.rank - b.rank })
+
+			var got []string
+			for _, e := range tt.entries {
+				got = append(got, e.name)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Sort() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsPermutation(t *testing.T) {
+	tests := []struct {
+		name     string
+		ids      []uint
+		existing []uint
+		want     bool
+	}{
+		{
+			name:     "same ids in another order",
+			ids:      []uint{3, 1, 2},
+			existing: []uint{1, 2, 3},
+			want:     true,
+		},
+		{
+			name:     "missing id",
+			ids:      []uint{3, 1},
+			existing: []uint{1, 2, 3},
+			want:     false,
+		},
+		{
+			name:     "repeated id",
+			ids:      []uint{1, 1, 2},
+			existing: []uint{1, 2, 3},
+			want:     false,
+		},
+		{
+			name:     "unknown id",
+			ids:      []uint{1, 2, 4},
+			existing: []uint{1, 2, 3},
+			want:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsPermutation(tt.ids, tt.existing); got != tt.want {
+				t.Errorf("IsPermutation() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTrimAll(t *testing.T) {
+	got := TrimAll([]string{" Go ", "", "  ", "PostgreSQL"})
+	if want := []string{"Go", "PostgreSQL"}; !slices.Equal(got, want) {
+		t.Errorf("TrimAll() = %v, want %v", got, want)
+	}
+
+	if got := TrimAll(nil); got == nil {
+		t.Errorf("TrimAll(nil) = nil, want an empty list")
+	}
+}
+
+func TestDedupe(t *testing.T) {
+	got := Dedupe([]string{"Go", "go", "Docker", "GO"})
+	if want := []string{"Go", "Docker"}; !slices.Equal(got, want) {
+		t.Errorf("Dedupe() = %v, want %v", got, want)
+	}
+}
+
+func TestMonths(t *testing.T) {
+	month := func(year int, month time.Month) model.Month {
+		return model.NewMonth(year, month)
+	}
+
+	tests := []struct {
+		name  string
+		spans []Span
+		want  int
+	}{
+		{
+			name: "no spans",
+			want: 0,
+		},
+		{
+			name:  "count both ends",
+			spans: []Span{{Start: month(2020, time.January), End: month(2020, time.December)}},
+			want:  12,
+		},
+		{
+			name: "count overlapping months once",
+			spans: []Span{
+				{Start: month(2021, time.January), End: month(2021, time.December)},
+				{Start: month(2020, time.July), End: month(2021, time.June)},
+			},
+			want: 18,
+		},
+		{
+			name: "count a span within another once",
+			spans: []Span{
+				{Start: month(2020, time.January), End: month(2022, time.December)},
+				{Start: month(2021, time.March), End: month(2021, time.April)},
+			},
+			want: 36,
+		},
+		{
+			name: "leave gaps out",
+			spans: []Span{
+				{Start: month(2018, time.January), End: month(2018, time.June)},
+				{Start: month(2019, time.January), End: month(2019, time.March)},
+			},
+			want: 9,
+		},
+		{
+			name:  "ignore spans ending before they start",
+			spans: []Span{{Start: month(2020, time.May), End: month(2020, time.April)}},
+			want:  0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Months(tt.spans); got != tt.want {
+				t.Errorf("Months() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
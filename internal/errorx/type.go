@@ -21,6 +21,7 @@ const (
 	TypeBadGateway         Type = "BAD_GATEWAY"
 	TypeServiceUnavailable Type = "SERVICE_UNAVAILABLE"
 	TypeNotMatch           Type = "NOT_MATCH"
+	TypeTooManyRequests    Type = "TOO_MANY_REQUESTS"
 )
 
 var TypeToCode = map[Type]int{
@@ -33,6 +34,7 @@ var TypeToCode = map[Type]int{
 	TypeBadGateway:         http.StatusBadGateway,
 	TypeServiceUnavailable: http.StatusServiceUnavailable,
 	TypeNotMatch:           http.StatusBadRequest,
+	TypeTooManyRequests:    http.StatusTooManyRequests,
 }
 
 // Predefined Errors
@@ -91,4 +93,10 @@ var (
 		Code:    TypeToCode[TypeNotMatch],
 		Err:     errors.New(TypeNotMatch.String()),
 	}
+	ErrTooManyRequests = &Error{
+		Type:    TypeTooManyRequests,
+		Message: TypeTooManyRequests.String(),
+		Code:    TypeToCode[TypeTooManyRequests],
+		Err:     errors.New(TypeTooManyRequests.String()),
+	}
 )
@@ -0,0 +1,22 @@
+package generator
+
+import (
+	"net/url"
+
+	"devoratio.dev/web-resume/internal/errorx"
+)
+
+// GenerateTokenURL appends token as the token query parameter of baseURL,
+// keeping any query parameters baseURL already has.
+func GenerateTokenURL(baseURL, token string) (string, error) {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return "", errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), err)
+	}
+
+	query := u.Query()
+	query.Set("token", token)
+	u.RawQuery = query.Encode()
+
+	return u.String(), nil
+}
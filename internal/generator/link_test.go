@@ -0,0 +1,53 @@
+package generator
+
+import "testing"
+
+func TestGenerateTokenURL(t *testing.T) {
+	type args struct {
+		baseURL string
+		token   string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "base url without query",
+			args: args{
+				baseURL: "https://resume.devoratio.dev/reset-password",
+				token:   "abc-123_",
+			},
+			want: "https://resume.devoratio.dev/reset-password?token=abc-123_",
+		},
+		{
+			name: "base url with query",
+			args: args{
+				baseURL: "https://resume.devoratio.dev/verify?lang=en",
+				token:   "abc",
+			},
+			want: "https://resume.devoratio.dev/verify?lang=en&token=abc",
+		},
+		{
+			name: "invalid base url",
+			args: args{
+				baseURL: "://resume",
+				token:   "abc",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := GenerateTokenURL(tt.args.baseURL, tt.args.token)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GenerateTokenURL() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("GenerateTokenURL() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,21 @@
+package generator
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+
+	"devoratio.dev/web-resume/internal/errorx"
+)
+
+const randomTokenSize = 32
+
+// GenerateRandomToken returns a URL-safe random string backed by 32 bytes of
+// entropy.
+func GenerateRandomToken() (string, error) {
+	buf := make([]byte, randomTokenSize)
+	if _, err := rand.Read(buf); err != nil {
+		return "", errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
@@ -0,0 +1,26 @@
+package generator
+
+import (
+	"encoding/base64"
+	"testing"
+)
+
+func TestGenerateRandomToken(t *testing.T) {
+	first, err := GenerateRandomToken()
+	if err != nil {
+		t.Fatalf("GenerateRandomToken() error = %v", err)
+	}
+
+	decoded, err := base64.RawURLEncoding.DecodeString(first)
+	if err != nil {
+		t.Fatalf("GenerateRandomToken() returned non base64url token: %v", err)
+	}
+	if len(decoded) != randomTokenSize {
+		t.Errorf("GenerateRandomToken() entropy = %d bytes, want %d", len(decoded), randomTokenSize)
+	}
+
+	second, _ := GenerateRandomToken()
+	if first == second {
+		t.Errorf("GenerateRandomToken() returned the same token twice")
+	}
+}
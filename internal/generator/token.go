@@ -14,6 +14,9 @@ const (
 	audience = "web-resume"
 )
 
+// AccessTokenTTL is how long an access token can be used
+const AccessTokenTTL = 2 * time.Hour
+
 type CustomClaims struct {
 	Data model.Claim `json:"data"`
 	jwt.RegisteredClaims
@@ -21,14 +24,18 @@ type CustomClaims struct {
 
 func GenerateAccessToken(claim model.Claim, signingKey []byte) (string, error) {
 	currentTime := time.Now()
+	tokenID := claim.TokenID
+	if tokenID == "" {
+		tokenID = uuid.New().String()
+	}
 	claims := CustomClaims{
 		claim,
 		jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(currentTime.Add(2 * time.Hour)),
+			ExpiresAt: jwt.NewNumericDate(currentTime.Add(AccessTokenTTL)),
 			IssuedAt:  jwt.NewNumericDate(currentTime),
 			NotBefore: jwt.NewNumericDate(currentTime),
 			Issuer:    issuer,
-			ID:        uuid.New().String(),
+			ID:        tokenID,
 			Audience:  []string{audience},
 		},
 	}
@@ -41,10 +48,16 @@ func VerifyAccessToken(accessToken string, signingKey []byte) (*model.Claim, err
 		return signingKey, nil
 	})
 
-	if !token.Valid || err != nil {
+	if err != nil || !token.Valid {
 		return nil, errorx.ErrUnauthorized
 	}
 
 	customClaim := token.Claims.(*CustomClaims)
-	return &customClaim.Data, nil
+	claim := customClaim.Data
+	claim.TokenID = customClaim.ID
+	if customClaim.IssuedAt != nil {
+		claim.IssuedAt = customClaim.IssuedAt.Time
+	}
+
+	return &claim, nil
 }
@@ -149,6 +149,78 @@ func TestVerifyAccessToken(t *testing.T) {
 	}
 }
 
+func TestVerifyAccessToken_RegisteredClaims(t *testing.T) {
+	signature := []byte("random_sign_key")
+	issuedAt := time.Now().Truncate(time.Second)
+
+	accessToken, _ := generateAccessTokenWithCustomClaim(CustomClaims{
+		Data: model.Claim{UserID: 168, Username: "devoratio"},
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(issuedAt.Add(time.Hour)),
+			IssuedAt:  jwt.NewNumericDate(issuedAt),
+			NotBefore: jwt.NewNumericDate(issuedAt),
+			Issuer:    issuer,
+			ID:        "a1b2c3",
+			Audience:  []string{audience},
+		},
+	}, signature)
+
+	claim, err := VerifyAccessToken(accessToken, signature)
+	if err != nil {
+		t.Fatalf("VerifyAccessToken() error = %v", err)
+	}
+	if claim.TokenID != "a1b2c3" {
+		t.Errorf("VerifyAccessToken() TokenID = %v, want %v", claim.TokenID, "a1b2c3")
+	}
+	if !claim.IssuedAt.Equal(issuedAt) {
+		t.Errorf("VerifyAccessToken() IssuedAt = %v, want %v", claim.IssuedAt, issuedAt)
+	}
+}
+
+func TestGenerateAccessToken_TokenID(t *testing.T) {
+	signature := []byte("random_sign_key")
+
+	accessToken, err := GenerateAccessToken(model.Claim{UserID: 168, SessionID: "family", TokenID: "d4e5f6"}, signature)
+	if err != nil {
+		t.Fatalf("GenerateAccessToken() error = %v", err)
+	}
+
+	claim, err := VerifyAccessToken(accessToken, signature)
+	if err != nil {
+		t.Fatalf("VerifyAccessToken() error = %v", err)
+	}
+	if claim.TokenID != "d4e5f6" {
+		t.Errorf("VerifyAccessToken() TokenID = %v, want %v", claim.TokenID, "d4e5f6")
+	}
+	if claim.SessionID != "family" {
+		t.Errorf("VerifyAccessToken() SessionID = %v, want %v", claim.SessionID, "family")
+	}
+}
+
+func TestGenerateAccessToken_RolesAndScopes(t *testing.T) {
+	signature := []byte("random_sign_key")
+
+	accessToken, err := GenerateAccessToken(model.Claim{
+		UserID: 168,
+		Roles:  []string{model.RoleOwner},
+		Scopes: []string{model.ScopeResumeRead},
+	}, signature)
+	if err != nil {
+		t.Fatalf("GenerateAccessToken() error = %v", err)
+	}
+
+	claim, err := VerifyAccessToken(accessToken, signature)
+	if err != nil {
+		t.Fatalf("VerifyAccessToken() error = %v", err)
+	}
+	if !claim.HasRole(model.RoleOwner) || claim.HasRole(model.RoleAdmin) {
+		t.Errorf("VerifyAccessToken() Roles = %v, want %v", claim.Roles, []string{model.RoleOwner})
+	}
+	if !claim.HasScope(model.ScopeResumeRead) || claim.HasScope(model.ScopeResumeWrite) {
+		t.Errorf("VerifyAccessToken() Scopes = %v, want %v", claim.Scopes, []string{model.ScopeResumeRead})
+	}
+}
+
 func generateAccessTokenWithCustomClaim(claim jwt.Claims, signingKey []byte) (string, error) {
 	tokenString := jwt.NewWithClaims(jwt.SigningMethodHS256, claim)
 	return tokenString.SignedString(signingKey)
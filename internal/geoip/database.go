@@ -0,0 +1,124 @@
+package geoip
+
+import (
+	"encoding/csv"
+	"io"
+	"net/netip"
+	"os"
+	"sort"
+	"strings"
+
+	"devoratio.dev/web-resume/internal/errorx"
+)
+
+// Columns of the DB-IP "IP to City Lite" CSV format:
+// ip_start,ip_end,continent,country,stateprov,city,latitude,longitude
+const (
+	columnStart = iota
+	columnEnd
+	columnContinent
+	columnCountry
+	columnRegion
+	columnCity
+	minimumColumns
+)
+
+type ipRange struct {
+	start    netip.Addr
+	end      netip.Addr
+	location string
+}
+
+// Database approximates the location of an IP address from a file kept on
+// disk, so no lookup ever leaves the server.
+type Database struct {
+	ranges []ipRange
+}
+
+// Open loads the CSV database at path. An empty path gives a database that
+// knows no location.
+func Open(path string) (*Database, error) {
+	if path == "" {
+		return &Database{}, nil
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, errorx.Errorf("failed to open geoip database: %s", err)
+	}
+	defer file.Close()
+
+	return Read(file)
+}
+
+func Read(r io.Reader) (*Database, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.ReuseRecord = true
+
+	db := &Database{}
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errorx.Errorf("failed to read geoip database: %s", err)
+		}
+		if len(record) < minimumColumns {
+			return nil, errorx.Errorf("geoip database record %q has too few columns", strings.Join(record, ","))
+		}
+
+		start, err := netip.ParseAddr(record[columnStart])
+		if err != nil {
+			return nil, errorx.Errorf("failed to read geoip database: %s", err)
+		}
+		end, err := netip.ParseAddr(record[columnEnd])
+		if err != nil {
+			return nil, errorx.Errorf("failed to read geoip database: %s", err)
+		}
+
+		db.ranges = append(db.ranges, ipRange{
+			start:    start.Unmap(),
+			end:      end.Unmap(),
+			location: describe(record[columnCity], record[columnRegion], record[columnCountry]),
+		})
+	}
+
+	sort.Slice(db.ranges, func(i, j int) bool {
+		return db.ranges[i].start.Less(db.ranges[j].start)
+	})
+
+	return db, nil
+}
+
+// Locate returns a human readable location such as "Bandung, West Java, ID",
+// or an empty string when the address is unknown.
+func (d *Database) Locate(ip string) string {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return ""
+	}
+	addr = addr.Unmap()
+
+	// Find the last range starting at or before the address
+	i := sort.Search(len(d.ranges), func(i int) bool {
+		return addr.Less(d.ranges[i].start)
+	}) - 1
+	if i < 0 || d.ranges[i].end.Less(addr) {
+		return ""
+	}
+
+	return d.ranges[i].location
+}
+
+func describe(parts ...string) string {
+	var known []string
+	for _, part := range parts {
+		if part = strings.TrimSpace(part); part != "" && part != "ZZ" {
+			known = append(known, part)
+		}
+	}
+
+	return strings.Join(known, ", ")
+}
@@ -0,0 +1,68 @@
+package geoip
+
+import (
+	"strings"
+	"testing"
+)
+
+const sampleDatabase = `"1.0.0.0","1.0.0.255","OC","AU","Queensland","South Brisbane",-27.4767,153.017
+"36.64.0.0","36.95.255.255","AS","ID","West Java","Bandung",-6.9175,107.619
+"2001:df0::","2001:df0:ffff:ffff:ffff:ffff:ffff:ffff","AS","ID","Jakarta","",-6.2,106.8
+"203.0.113.0","203.0.113.255","ZZ","ZZ","","",0,0
+`
+
+func TestDatabase_Locate(t *testing.T) {
+	db, err := Read(strings.NewReader(sampleDatabase))
+	if err != nil {
+		t.Fatalf("Read() error = %v", err)
+	}
+
+	tests := []struct {
+		name string
+		ip   string
+		want string
+	}{
+		{name: "start of a range", ip: "1.0.0.0", want: "South Brisbane, Queensland, AU"},
+		{name: "inside a range", ip: "36.80.12.7", want: "Bandung, West Java, ID"},
+		{name: "ipv4 mapped ipv6", ip: "::ffff:36.80.12.7", want: "Bandung, West Java, ID"},
+		{name: "ipv6 range without city", ip: "2001:df0::1", want: "Jakarta, ID"},
+		{name: "reserved range", ip: "203.0.113.7", want: ""},
+		{name: "between ranges", ip: "8.8.8.8", want: ""},
+		{name: "before every range", ip: "0.0.0.1", want: ""},
+		{name: "not an ip", ip: "localhost", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := db.Locate(tt.ip); got != tt.want {
+				t.Errorf("Locate() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRead_Malformed(t *testing.T) {
+	tests := []struct {
+		name     string
+		database string
+	}{
+		{name: "too few columns", database: `"1.0.0.0","1.0.0.255","OC"`},
+		{name: "invalid address", database: `"1.0.0","1.0.0.255","OC","AU","Queensland","South Brisbane",0,0`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Read(strings.NewReader(tt.database)); err == nil {
+				t.Errorf("Read() error = nil, want error")
+			}
+		})
+	}
+}
+
+func TestOpen_EmptyPath(t *testing.T) {
+	db, err := Open("")
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	if got := db.Locate("1.0.0.1"); got != "" {
+		t.Errorf("Locate() = %q, want empty", got)
+	}
+}
@@ -0,0 +1,14 @@
+package hasher
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// HashToken hashes high entropy tokens before they are stored. Unlike
+// passwords these tokens are random, so a fast unsalted hash is sufficient and
+// allows looking tokens up by their hash.
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,29 @@
+package hasher
+
+import "testing"
+
+func TestHashToken(t *testing.T) {
+	tests := []struct {
+		name  string
+		token string
+		want  string
+	}{
+		{
+			name:  "hash empty token",
+			token: "",
+			want:  "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+		},
+		{
+			name:  "hash random token",
+			token: "veryveryrandomtoken",
+			want:  "f97747683318593b375a6949c6c829a13a2fbd68c074e0f50ec9635707392c45",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := HashToken(tt.token); got != tt.want {
+				t.Errorf("HashToken() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package httpx
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"devoratio.dev/web-resume/internal/authz"
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/internal/generator"
+	"devoratio.dev/web-resume/model"
+)
+
+type Middleware func(http.Handler) http.Handler
+
+type claimContextKey struct{}
+
+// ClaimValidator performs the checks that need state, such as making sure the
+// token was not revoked, after the token signature has been verified. It also
+// turns personal access tokens into the claim of the owner who created them.
+type ClaimValidator interface {
+	ValidateClaim(ctx context.Context, claim *model.Claim) error
+	ResolvePersonalToken(ctx context.Context, personalToken string) (*model.Claim, error)
+}
+
+// RequireScope lets through authenticated requests whose token was granted
+// every one of scopes. It has to be wrapped by
+// TokenTransport.RequireAuthentication.
+func RequireScope(scopes ...string) Middleware {
+	return authorize(func(claim model.Claim) error {
+		return authz.RequireScope(claim, scopes...)
+	})
+}
+
+// RequireRole lets through authenticated requests whose token holds role. It
+// has to be wrapped by TokenTransport.RequireAuthentication.
+func RequireRole(role string) Middleware {
+	return authorize(func(claim model.Claim) error {
+		return authz.RequireRole(claim, role)
+	})
+}
+
+// Chain combines middlewares, the first one being the outermost
+func Chain(middlewares ...Middleware) Middleware {
+	return func(next http.Handler) http.Handler {
+		for i := len(middlewares) - 1; i >= 0; i-- {
+			next = middlewares[i](next)
+		}
+
+		return next
+	}
+}
+
+// ClaimFromContext returns the claim of the authenticated owner. It is only
+// available to handlers wrapped by TokenTransport.RequireAuthentication.
+func ClaimFromContext(ctx context.Context) (*model.Claim, bool) {
+	claim, ok := ctx.Value(claimContextKey{}).(*model.Claim)
+	return claim, ok
+}
+
+func withClaim(ctx context.Context, claim *model.Claim) context.Context {
+	return context.WithValue(ctx, claimContextKey{}, claim)
+}
+
+func authorize(check func(claim model.Claim) error) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			claim, ok := ClaimFromContext(r.Context())
+			if !ok {
+				WriteError(w, errorx.ErrUnauthorized)
+				return
+			}
+
+			if err := check(*claim); err != nil {
+				WriteError(w, err)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func verifyAccessToken(ctx context.Context, accessToken string, signingKey []byte, validator ClaimValidator) (*model.Claim, error) {
+	claim, err := generator.VerifyAccessToken(accessToken, signingKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validator.ValidateClaim(ctx, claim); err != nil {
+		return nil, err
+	}
+
+	return claim, nil
+}
+
+func isPersonalToken(token string) bool {
+	return strings.HasPrefix(token, model.PersonalTokenPrefix)
+}
+
+func bearerToken(r *http.Request) (string, bool) {
+	scheme, token, found := strings.Cut(r.Header.Get("Authorization"), " ")
+	if !found || !strings.EqualFold(scheme, "Bearer") || token == "" {
+		return "", false
+	}
+
+	return token, true
+}
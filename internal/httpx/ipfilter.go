@@ -0,0 +1,39 @@
+package httpx
+
+import (
+	"context"
+	"net/http"
+	"net/netip"
+
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/internal/ipfilter"
+	"devoratio.dev/web-resume/internal/requestinfo"
+	"devoratio.dev/web-resume/model"
+)
+
+// Auditor records requests turned away to the audit trail
+type Auditor interface {
+	Record(ctx context.Context, entry model.AuditLog, result error)
+}
+
+// RestrictIP only lets through requests whose client address is allowed by
+// filter. It has to be wrapped by WithRequestInfo, which resolves the client
+// address.
+func RestrictIP(filter *ipfilter.Filter, auditor Auditor) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			addr, err := netip.ParseAddr(requestinfo.FromContext(r.Context()).ClientIP)
+			if err != nil || !filter.Allows(addr) {
+				auditor.Record(r.Context(), model.AuditLog{
+					Method:     model.AuditMethodRequest,
+					Event:      model.AuditEventIPDenied,
+					Identifier: r.Method + " " + r.URL.Path,
+				}, errorx.ErrForbidden)
+				WriteError(w, errorx.ErrForbidden)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
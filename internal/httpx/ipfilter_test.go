@@ -0,0 +1,76 @@
+package httpx
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"devoratio.dev/web-resume/config"
+	"devoratio.dev/web-resume/internal/ipfilter"
+	"devoratio.dev/web-resume/model"
+)
+
+type auditorFunc func(entry model.AuditLog, result error)
+
+func (f auditorFunc) Record(ctx context.Context, entry model.AuditLog, result error) {
+	f(entry, result)
+}
+
+func TestRestrictIP(t *testing.T) {
+	filter, err := ipfilter.New(config.IPFilter{Allow: []string{"198.51.100.0/24"}, Deny: []string{"198.51.100.13"}})
+	if err != nil {
+		t.Fatalf("ipfilter.New() error = %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		remoteAddr string
+		wantStatus int
+		wantAudit  bool
+	}{
+		{
+			name:       "let an allowed address through",
+			remoteAddr: "198.51.100.7:52110",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "refuse an address outside the allow list",
+			remoteAddr: "203.0.113.7:52110",
+			wantStatus: http.StatusForbidden,
+			wantAudit:  true,
+		},
+		{
+			name:       "refuse a denied address within the allow list",
+			remoteAddr: "198.51.100.13:52110",
+			wantStatus: http.StatusForbidden,
+			wantAudit:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var entries []model.AuditLog
+			auditor := auditorFunc(func(entry model.AuditLog, result error) {
+				entries = append(entries, entry)
+			})
+			handler := WithRequestInfo(nil)(RestrictIP(filter, auditor)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			})))
+
+			request := httptest.NewRequest(http.MethodPut, "/v1/owner/password", nil)
+			request.RemoteAddr = tt.remoteAddr
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, request)
+
+			if recorder.Code != tt.wantStatus {
+				t.Errorf("RestrictIP() status = %v, want %v", recorder.Code, tt.wantStatus)
+			}
+			if (len(entries) > 0) != tt.wantAudit {
+				t.Fatalf("RestrictIP() audit entries = %v, want audit %v", entries, tt.wantAudit)
+			}
+			if tt.wantAudit && (entries[0].Event != model.AuditEventIPDenied || entries[0].Identifier != "PUT /v1/owner/password") {
+				t.Errorf("RestrictIP() audit entry = %+v", entries[0])
+			}
+		})
+	}
+}
@@ -0,0 +1,21 @@
+package httpx
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"devoratio.dev/web-resume/internal/errorx"
+)
+
+const maxBodySize = 1 << 20
+
+func DecodeJSON(w http.ResponseWriter, r *http.Request, v interface{}) error {
+	decoder := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxBodySize))
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(v); err != nil {
+		return errorx.New(errorx.TypeInvalidParameter, "request body is invalid", err)
+	}
+
+	return nil
+}
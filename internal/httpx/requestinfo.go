@@ -0,0 +1,79 @@
+package httpx
+
+import (
+	"net"
+	"net/http"
+	"net/netip"
+	"regexp"
+	"strings"
+
+	"devoratio.dev/web-resume/internal/ipfilter"
+	"devoratio.dev/web-resume/internal/requestinfo"
+	"github.com/google/uuid"
+)
+
+const (
+	requestIDHeader    = "X-Request-ID"
+	forwardedForHeader = "X-Forwarded-For"
+)
+
+// validRequestID keeps arbitrary client input out of logs and the audit trail
+var validRequestID = regexp.MustCompile(`^[A-Za-z0-9._-]{1,64}$`)
+
+// WithRequestInfo attaches a request ID, the client IP and user agent to the
+// request context. A request ID sent by the client is reused when it is sane.
+// The client IP is read from X-Forwarded-For when the request comes through
+// one of trustedProxies.
+func WithRequestInfo(trustedProxies []netip.Prefix) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requestID := r.Header.Get(requestIDHeader)
+			if !validRequestID.MatchString(requestID) {
+				requestID = uuid.New().String()
+			}
+			w.Header().Set(requestIDHeader, requestID)
+
+			ctx := requestinfo.WithInfo(r.Context(), requestinfo.Info{
+				RequestID: requestID,
+				ClientIP:  clientIP(r, trustedProxies),
+				UserAgent: r.UserAgent(),
+			})
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// clientIP walks X-Forwarded-For from the closest hop, skipping the trusted
+// proxies. Entries left of the first untrusted hop could have been forged by
+// the client and are ignored.
+func clientIP(r *http.Request, trustedProxies []netip.Prefix) string {
+	remoteIP, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		remoteIP = r.RemoteAddr
+	}
+
+	addr, err := netip.ParseAddr(remoteIP)
+	if err != nil || !ipfilter.Contains(trustedProxies, addr.Unmap()) {
+		return remoteIP
+	}
+
+	var hops []string
+	for _, header := range r.Header.Values(forwardedForHeader) {
+		hops = append(hops, strings.Split(header, ",")...)
+	}
+
+	client := remoteIP
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop, err := netip.ParseAddr(strings.TrimSpace(hops[i]))
+		if err != nil {
+			break
+		}
+
+		client = hop.Unmap().String()
+		if !ipfilter.Contains(trustedProxies, hop.Unmap()) {
+			break
+		}
+	}
+
+	return client
+}
@@ -0,0 +1,130 @@
+package httpx
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+
+	"devoratio.dev/web-resume/internal/requestinfo"
+)
+
+func TestWithRequestInfo(t *testing.T) {
+	tests := []struct {
+		name          string
+		requestID     string
+		wantRequestID string
+	}{
+		{
+			name:          "reuse request id sent by the client",
+			requestID:     "d3b07384-d9a0-4c9b",
+			wantRequestID: "d3b07384-d9a0-4c9b",
+		},
+		{
+			name:      "replace malformed request id",
+			requestID: "<script>alert(1)</script>",
+		},
+		{
+			name: "generate missing request id",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var info requestinfo.Info
+			handler := WithRequestInfo(nil)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				info = requestinfo.FromContext(r.Context())
+			}))
+
+			request := httptest.NewRequest(http.MethodPost, "/v1/login", nil)
+			request.RemoteAddr = "203.0.113.7:52110"
+			request.Header.Set("User-Agent", "curl/8.5.0")
+			if tt.requestID != "" {
+				request.Header.Set(requestIDHeader, tt.requestID)
+			}
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, request)
+
+			if info.ClientIP != "203.0.113.7" {
+				t.Errorf("WithRequestInfo() ClientIP = %v, want %v", info.ClientIP, "203.0.113.7")
+			}
+			if info.UserAgent != "curl/8.5.0" {
+				t.Errorf("WithRequestInfo() UserAgent = %v, want %v", info.UserAgent, "curl/8.5.0")
+			}
+			if tt.wantRequestID != "" && info.RequestID != tt.wantRequestID {
+				t.Errorf("WithRequestInfo() RequestID = %v, want %v", info.RequestID, tt.wantRequestID)
+			}
+			if !validRequestID.MatchString(info.RequestID) {
+				t.Errorf("WithRequestInfo() RequestID = %v is not valid", info.RequestID)
+			}
+			if recorder.Header().Get(requestIDHeader) != info.RequestID {
+				t.Errorf("WithRequestInfo() response header = %v, want %v", recorder.Header().Get(requestIDHeader), info.RequestID)
+			}
+		})
+	}
+}
+
+func TestWithRequestInfo_ClientIP(t *testing.T) {
+	trustedProxies := []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8")}
+
+	tests := []struct {
+		name         string
+		remoteAddr   string
+		forwardedFor []string
+		want         string
+	}{
+		{
+			name:         "ignore forwarded for sent by an untrusted client",
+			remoteAddr:   "203.0.113.7:52110",
+			forwardedFor: []string{"198.51.100.1"},
+			want:         "203.0.113.7",
+		},
+		{
+			name:       "use the proxy address without forwarded for",
+			remoteAddr: "10.0.0.2:52110",
+			want:       "10.0.0.2",
+		},
+		{
+			name:         "use the client address reported by a trusted proxy",
+			remoteAddr:   "10.0.0.2:52110",
+			forwardedFor: []string{"203.0.113.7"},
+			want:         "203.0.113.7",
+		},
+		{
+			name:         "skip every trusted proxy in the chain",
+			remoteAddr:   "10.0.0.2:52110",
+			forwardedFor: []string{"203.0.113.7, 10.1.0.3", "10.2.0.4"},
+			want:         "203.0.113.7",
+		},
+		{
+			name:         "ignore addresses forged by the client left of it",
+			remoteAddr:   "10.0.0.2:52110",
+			forwardedFor: []string{"192.0.2.1, 203.0.113.7"},
+			want:         "203.0.113.7",
+		},
+		{
+			name:         "stop at a malformed hop",
+			remoteAddr:   "10.0.0.2:52110",
+			forwardedFor: []string{"203.0.113.7, unknown, 10.1.0.3"},
+			want:         "10.1.0.3",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var info requestinfo.Info
+			handler := WithRequestInfo(trustedProxies)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				info = requestinfo.FromContext(r.Context())
+			}))
+
+			request := httptest.NewRequest(http.MethodPost, "/v1/login", nil)
+			request.RemoteAddr = tt.remoteAddr
+			for _, forwardedFor := range tt.forwardedFor {
+				request.Header.Add(forwardedForHeader, forwardedFor)
+			}
+			handler.ServeHTTP(httptest.NewRecorder(), request)
+
+			if info.ClientIP != tt.want {
+				t.Errorf("WithRequestInfo() ClientIP = %v, want %v", info.ClientIP, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package httpx
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+
+	"devoratio.dev/web-resume/internal/errorx"
+)
+
+type errorBody struct {
+	Error errorPayload `json:"error"`
+}
+
+type errorPayload struct {
+	Type    errorx.Type            `json:"type"`
+	Message string                 `json:"message"`
+	Details map[string]interface{} `json:"details,omitempty"`
+}
+
+type dataBody struct {
+	Data interface{} `json:"data"`
+}
+
+func WriteJSON(w http.ResponseWriter, status int, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	if err := json.NewEncoder(w).Encode(dataBody{Data: data}); err != nil {
+		log.Printf("failed to encode response: %s", err)
+	}
+}
+
+func WriteNoContent(w http.ResponseWriter) {
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// WriteError translates err into an HTTP response. Errors that are not
+// created by the errorx package are reported as internal errors so their
+// message never leaks to the client.
+func WriteError(w http.ResponseWriter, err error) {
+	e := errorx.Wrap(err)
+	if e.Type == "" {
+		e = errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), e)
+	}
+
+	if e.Code >= http.StatusInternalServerError {
+		log.Print(e.ErrorStack())
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(e.Code)
+
+	body := errorBody{
+		Error: errorPayload{
+			Type:    e.Type,
+			Message: e.Message,
+			Details: e.Details,
+		},
+	}
+	if encodeErr := json.NewEncoder(w).Encode(body); encodeErr != nil {
+		log.Printf("failed to encode error response: %s", encodeErr)
+	}
+}
@@ -0,0 +1,72 @@
+package httpx
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"devoratio.dev/web-resume/internal/errorx"
+)
+
+func TestWriteError(t *testing.T) {
+	invalidParameter := errorx.New(errorx.TypeInvalidParameter, "owner data is invalid", nil)
+	invalidParameter.Details = map[string]interface{}{"email": "must be a valid email address"}
+
+	tests := []struct {
+		name        string
+		err         error
+		wantStatus  int
+		wantType    errorx.Type
+		wantMessage string
+		wantDetails bool
+	}{
+		{
+			name:        "predefined error",
+			err:         errorx.ErrForbidden,
+			wantStatus:  http.StatusForbidden,
+			wantType:    errorx.TypeForbidden,
+			wantMessage: errorx.TypeForbidden.String(),
+		},
+		{
+			name:        "error with details",
+			err:         invalidParameter,
+			wantStatus:  http.StatusBadRequest,
+			wantType:    errorx.TypeInvalidParameter,
+			wantMessage: "owner data is invalid",
+			wantDetails: true,
+		},
+		{
+			name:        "standard error does not leak its message",
+			err:         errors.New("pq: connection refused"),
+			wantStatus:  http.StatusInternalServerError,
+			wantType:    errorx.TypeInternal,
+			wantMessage: errorx.TypeInternal.String(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			WriteError(recorder, tt.err)
+
+			if recorder.Code != tt.wantStatus {
+				t.Errorf("WriteError() status = %v, want %v", recorder.Code, tt.wantStatus)
+			}
+
+			var body errorBody
+			if err := json.NewDecoder(recorder.Body).Decode(&body); err != nil {
+				t.Fatalf("WriteError() wrote invalid json: %v", err)
+			}
+			if body.Error.Type != tt.wantType {
+				t.Errorf("WriteError() type = %v, want %v", body.Error.Type, tt.wantType)
+			}
+			if body.Error.Message != tt.wantMessage {
+				t.Errorf("WriteError() message = %v, want %v", body.Error.Message, tt.wantMessage)
+			}
+			if (body.Error.Details != nil) != tt.wantDetails {
+				t.Errorf("WriteError() details = %v, wantDetails %v", body.Error.Details, tt.wantDetails)
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package httpx
+
+import (
+	"context"
+	"net/http"
+
+	"devoratio.dev/web-resume/internal/tenancy"
+	"devoratio.dev/web-resume/model"
+)
+
+// TenantResolver maps the host a request was addressed to onto its tenant. A
+// nil tenant without an error means the host serves the deployment itself.
+type TenantResolver interface {
+	Resolve(ctx context.Context, host string) (*model.Tenant, error)
+}
+
+// ResolveTenant attaches the tenant of the request host to the request context,
+// see tenancy.FromContext. Requests on an unknown host are rejected.
+func ResolveTenant(resolver TenantResolver) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			tenant, err := resolver.Resolve(r.Context(), r.Host)
+			if err != nil {
+				WriteError(w, err)
+				return
+			}
+
+			ctx := r.Context()
+			if tenant != nil {
+				ctx = tenancy.WithTenant(ctx, tenant)
+			}
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
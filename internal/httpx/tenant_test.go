@@ -0,0 +1,75 @@
+package httpx
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/internal/tenancy"
+	"devoratio.dev/web-resume/model"
+)
+
+type tenantResolverFunc func(host string) (*model.Tenant, error)
+
+func (f tenantResolverFunc) Resolve(ctx context.Context, host string) (*model.Tenant, error) {
+	return f(host)
+}
+
+func TestResolveTenant(t *testing.T) {
+	resolver := tenantResolverFunc(func(host string) (*model.Tenant, error) {
+		switch host {
+		case "resume.example.com":
+			return nil, nil
+		case "jane.resume.example.com":
+			return &model.Tenant{ID: 7, OwnerID: 3, Slug: "jane"}, nil
+		default:
+			return nil, errorx.ErrNotFound
+		}
+	})
+
+	tests := []struct {
+		name         string
+		host         string
+		wantStatus   int
+		wantTenantID uint
+	}{
+		{
+			name:         "attach the tenant of a tenant host",
+			host:         "jane.resume.example.com",
+			wantStatus:   http.StatusOK,
+			wantTenantID: 7,
+		},
+		{
+			name:       "serve the base domain without a tenant",
+			host:       "resume.example.com",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "reject unknown host",
+			host:       "unknown.example.org",
+			wantStatus: http.StatusNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var tenantID uint
+			handler := ResolveTenant(resolver)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				tenantID = tenancy.ID(r.Context())
+			}))
+
+			request := httptest.NewRequest(http.MethodGet, "/v1/setup", nil)
+			request.Host = tt.host
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, request)
+
+			if recorder.Code != tt.wantStatus {
+				t.Errorf("ResolveTenant() status = %v, want %v", recorder.Code, tt.wantStatus)
+			}
+			if tenantID != tt.wantTenantID {
+				t.Errorf("ResolveTenant() tenant ID = %v, want %v", tenantID, tt.wantTenantID)
+			}
+		})
+	}
+}
@@ -0,0 +1,221 @@
+package httpx
+
+import (
+	"crypto/subtle"
+	"fmt"
+	"net/http"
+	"time"
+
+	"devoratio.dev/web-resume/config"
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/internal/generator"
+	"devoratio.dev/web-resume/model"
+)
+
+const (
+	ModeBearer = "bearer"
+	ModeCookie = "cookie"
+	// ModeHeader lets a client opt into cookies when both modes are active
+	ModeHeader = "X-Auth-Mode"
+)
+
+const (
+	AccessTokenCookie = "__Host-access_token"
+	// RefreshTokenCookie is only sent along refresh requests, which rules out
+	// the __Host- prefix that requires the root path
+	RefreshTokenCookie = "__Secure-refresh_token"
+	refreshTokenPath   = "/v1/token/refresh"
+	// CSRFCookie is readable by the page, which echoes it in CSRFHeader on every
+	// state-changing request
+	CSRFCookie = "__Host-csrf_token"
+	CSRFHeader = "X-CSRF-Token"
+)
+
+const tokenTypeCookie = "Cookie"
+
+var errInvalidCSRFToken = errorx.New(errorx.TypeForbidden, "CSRF token is missing or invalid", nil)
+
+// TokenTransport hands tokens to clients and reads them back, either as
+// bearer tokens or as cookies depending on the active modes
+type TokenTransport struct {
+	signingKey      []byte
+	bearer          bool
+	cookie          bool
+	sameSite        http.SameSite
+	refreshTokenTTL time.Duration
+}
+
+func NewTokenTransport(authConfig config.Authentication) (*TokenTransport, error) {
+	t := &TokenTransport{
+		signingKey:      authConfig.SigningKey,
+		refreshTokenTTL: authConfig.RefreshTokenTTL,
+	}
+
+	for _, mode := range authConfig.Modes {
+		switch mode {
+		case ModeBearer:
+			t.bearer = true
+		case ModeCookie:
+			t.cookie = true
+		default:
+			return nil, fmt.Errorf("authentication mode %q is not supported", mode)
+		}
+	}
+	if !t.bearer && !t.cookie {
+		return nil, fmt.Errorf("at least one authentication mode has to be active")
+	}
+
+	switch authConfig.Cookie.SameSite {
+	case "strict":
+		t.sameSite = http.SameSiteStrictMode
+	case "lax":
+		t.sameSite = http.SameSiteLaxMode
+	default:
+		return nil, fmt.Errorf("cookie samesite %q is not supported", authConfig.Cookie.SameSite)
+	}
+
+	return t, nil
+}
+
+type cookieSessionResponse struct {
+	TokenType string `json:"token_type"`
+	CSRFToken string `json:"csrf_token"`
+}
+
+// WriteTokenPair responds with freshly issued tokens. In cookie mode the
+// tokens are only set as HttpOnly cookies and never reach the page.
+func (t *TokenTransport) WriteTokenPair(w http.ResponseWriter, r *http.Request, tokenPair *model.TokenPair) {
+	if !t.usesCookies(r) {
+		WriteJSON(w, http.StatusOK, tokenPair)
+		return
+	}
+
+	csrfToken, err := generator.GenerateRandomToken()
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	http.SetCookie(w, t.newCookie(AccessTokenCookie, tokenPair.AccessToken, "/", generator.AccessTokenTTL, true))
+	http.SetCookie(w, t.newCookie(RefreshTokenCookie, tokenPair.RefreshToken, refreshTokenPath, t.refreshTokenTTL, true))
+	http.SetCookie(w, t.newCookie(CSRFCookie, csrfToken, "/", t.refreshTokenTTL, false))
+
+	WriteJSON(w, http.StatusOK, cookieSessionResponse{TokenType: tokenTypeCookie, CSRFToken: csrfToken})
+}
+
+// ClearTokens removes the cookies of a signed out session
+func (t *TokenTransport) ClearTokens(w http.ResponseWriter) {
+	if !t.cookie {
+		return
+	}
+
+	http.SetCookie(w, t.newCookie(AccessTokenCookie, "", "/", -1, true))
+	http.SetCookie(w, t.newCookie(RefreshTokenCookie, "", refreshTokenPath, -1, true))
+	http.SetCookie(w, t.newCookie(CSRFCookie, "", "/", -1, false))
+}
+
+type refreshRequest struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+// RefreshToken reads the refresh token of a refresh request, from its cookie
+// or from the request body
+func (t *TokenTransport) RefreshToken(w http.ResponseWriter, r *http.Request) (string, error) {
+	if t.cookie {
+		if cookie, err := r.Cookie(RefreshTokenCookie); err == nil {
+			if !validCSRFToken(r) {
+				return "", errInvalidCSRFToken
+			}
+			return cookie.Value, nil
+		}
+	}
+	if !t.bearer {
+		return "", errorx.ErrUnauthorized
+	}
+
+	var request refreshRequest
+	if err := DecodeJSON(w, r, &request); err != nil {
+		return "", err
+	}
+
+	return request.RefreshToken, nil
+}
+
+// RequireAuthentication lets through requests carrying either a valid access
+// token, in the Authorization header or its cookie depending on the active
+// modes, or a valid personal access token. Cookies only authenticate
+// state-changing requests that echo the CSRF token. What the token may do is
+// left to RequireScope and RequireRole.
+func (t *TokenTransport) RequireAuthentication(validator ClaimValidator) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			claim, err := t.authenticate(r, validator)
+			if err != nil {
+				WriteError(w, err)
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(withClaim(r.Context(), claim)))
+		})
+	}
+}
+
+func (t *TokenTransport) authenticate(r *http.Request, validator ClaimValidator) (*model.Claim, error) {
+	if token, found := bearerToken(r); found {
+		// Personal access tokens are meant for scripts and always work as bearer
+		// tokens
+		if isPersonalToken(token) {
+			return validator.ResolvePersonalToken(r.Context(), token)
+		}
+		if t.bearer {
+			return verifyAccessToken(r.Context(), token, t.signingKey, validator)
+		}
+	}
+
+	if t.cookie {
+		if cookie, err := r.Cookie(AccessTokenCookie); err == nil {
+			if !isSafeMethod(r.Method) && !validCSRFToken(r) {
+				return nil, errInvalidCSRFToken
+			}
+			return verifyAccessToken(r.Context(), cookie.Value, t.signingKey, validator)
+		}
+	}
+
+	return nil, errorx.ErrUnauthorized
+}
+
+func (t *TokenTransport) usesCookies(r *http.Request) bool {
+	return t.cookie && (!t.bearer || r.Header.Get(ModeHeader) == ModeCookie)
+}
+
+func (t *TokenTransport) newCookie(name, value, path string, maxAge time.Duration, httpOnly bool) *http.Cookie {
+	cookie := &http.Cookie{
+		Name:     name,
+		Value:    value,
+		Path:     path,
+		MaxAge:   int(maxAge.Seconds()),
+		Secure:   true,
+		HttpOnly: httpOnly,
+		SameSite: t.sameSite,
+	}
+	if maxAge < 0 {
+		cookie.MaxAge = -1
+	}
+
+	return cookie
+}
+
+// validCSRFToken tells whether the request echoes the CSRF cookie in its
+// header, which a cross-site page can't do since it can't read the cookie
+func validCSRFToken(r *http.Request) bool {
+	cookie, err := r.Cookie(CSRFCookie)
+	if err != nil || cookie.Value == "" {
+		return false
+	}
+
+	return subtle.ConstantTimeCompare([]byte(cookie.Value), []byte(r.Header.Get(CSRFHeader))) == 1
+}
+
+func isSafeMethod(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead || method == http.MethodOptions
+}
@@ -0,0 +1,243 @@
+package httpx
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"devoratio.dev/web-resume/config"
+	"devoratio.dev/web-resume/internal/generator"
+	"devoratio.dev/web-resume/model"
+)
+
+func newTestTransport(t *testing.T, modes ...string) *TokenTransport {
+	t.Helper()
+
+	transport, err := NewTokenTransport(config.Authentication{
+		SigningKey: []byte("random_sign_key"),
+		Modes:      modes,
+		Cookie:     config.Cookie{SameSite: "strict"},
+	})
+	if err != nil {
+		t.Fatalf("NewTokenTransport() error = %v", err)
+	}
+
+	return transport
+}
+
+func TestNewTokenTransport_InvalidConfig(t *testing.T) {
+	tests := []struct {
+		name       string
+		authConfig config.Authentication
+	}{
+		{name: "no mode", authConfig: config.Authentication{Cookie: config.Cookie{SameSite: "strict"}}},
+		{name: "unknown mode", authConfig: config.Authentication{Modes: []string{"basic"}, Cookie: config.Cookie{SameSite: "strict"}}},
+		{name: "unknown samesite", authConfig: config.Authentication{Modes: []string{ModeCookie}, Cookie: config.Cookie{SameSite: "none"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewTokenTransport(tt.authConfig); err == nil {
+				t.Errorf("NewTokenTransport() error = nil, want error")
+			}
+		})
+	}
+}
+
+func TestTokenTransport_WriteTokenPair(t *testing.T) {
+	tokenPair := &model.TokenPair{AccessToken: "accesstoken", RefreshToken: "refreshtoken", TokenType: "Bearer"}
+
+	tests := []struct {
+		name        string
+		modes       []string
+		modeHeader  string
+		wantCookies bool
+	}{
+		{name: "bearer mode", modes: []string{ModeBearer}},
+		{name: "cookie mode", modes: []string{ModeCookie}, wantCookies: true},
+		{name: "both modes", modes: []string{ModeBearer, ModeCookie}},
+		{name: "both modes opting into cookies", modes: []string{ModeBearer, ModeCookie}, modeHeader: ModeCookie, wantCookies: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := httptest.NewRequest(http.MethodPost, "/v1/login", nil)
+			if tt.modeHeader != "" {
+				request.Header.Set(ModeHeader, tt.modeHeader)
+			}
+			recorder := httptest.NewRecorder()
+			newTestTransport(t, tt.modes...).WriteTokenPair(recorder, request, tokenPair)
+
+			cookies := map[string]*http.Cookie{}
+			for _, cookie := range recorder.Result().Cookies() {
+				cookies[cookie.Name] = cookie
+			}
+			body := recorder.Body.String()
+
+			if !tt.wantCookies {
+				if len(cookies) != 0 || !strings.Contains(body, "accesstoken") {
+					t.Errorf("WriteTokenPair() cookies = %v, body = %v, want tokens in the body", cookies, body)
+				}
+				return
+			}
+
+			if strings.Contains(body, "accesstoken") || strings.Contains(body, "refreshtoken") {
+				t.Errorf("WriteTokenPair() body = %v, want no token", body)
+			}
+			access, refresh, csrf := cookies[AccessTokenCookie], cookies[RefreshTokenCookie], cookies[CSRFCookie]
+			if access == nil || access.Value != "accesstoken" || !access.HttpOnly || !access.Secure || access.SameSite != http.SameSiteStrictMode {
+				t.Errorf("WriteTokenPair() access cookie = %v", access)
+			}
+			if refresh == nil || refresh.Value != "refreshtoken" || !refresh.HttpOnly || refresh.Path != refreshTokenPath {
+				t.Errorf("WriteTokenPair() refresh cookie = %v", refresh)
+			}
+
+			var response struct {
+				Data cookieSessionResponse `json:"data"`
+			}
+			if err := json.Unmarshal(recorder.Body.Bytes(), &response); err != nil {
+				t.Fatalf("WriteTokenPair() body = %v", body)
+			}
+			if csrf == nil || csrf.HttpOnly || csrf.Value == "" || csrf.Value != response.Data.CSRFToken {
+				t.Errorf("WriteTokenPair() CSRF cookie = %v, body = %v", csrf, body)
+			}
+		})
+	}
+}
+
+func TestTokenTransport_RequireAuthentication(t *testing.T) {
+	signingKey := []byte("random_sign_key")
+	accessToken, _ := generator.GenerateAccessToken(model.Claim{UserID: 168, Username: "devoratio"}, signingKey)
+	acceptAll := claimValidatorFunc(func(ctx context.Context, claim *model.Claim) error { return nil })
+
+	tests := []struct {
+		name          string
+		modes         []string
+		method        string
+		authorization string
+		accessCookie  string
+		csrfCookie    string
+		csrfHeader    string
+		wantStatus    int
+	}{
+		{
+			name:          "bearer token when only cookies are active",
+			modes:         []string{ModeCookie},
+			method:        http.MethodGet,
+			authorization: "Bearer " + accessToken,
+			wantStatus:    http.StatusUnauthorized,
+		},
+		{
+			name:          "personal token when only cookies are active",
+			modes:         []string{ModeCookie},
+			method:        http.MethodPut,
+			authorization: "Bearer " + model.PersonalTokenPrefix + "valid",
+			wantStatus:    http.StatusOK,
+		},
+		{
+			name:         "cookie when only bearer tokens are active",
+			modes:        []string{ModeBearer},
+			method:       http.MethodGet,
+			accessCookie: accessToken,
+			wantStatus:   http.StatusUnauthorized,
+		},
+		{
+			name:         "cookie on a safe request",
+			modes:        []string{ModeCookie},
+			method:       http.MethodGet,
+			accessCookie: accessToken,
+			wantStatus:   http.StatusOK,
+		},
+		{
+			name:         "cookie on a state-changing request without CSRF token",
+			modes:        []string{ModeCookie},
+			method:       http.MethodPut,
+			accessCookie: accessToken,
+			csrfCookie:   "csrftoken",
+			wantStatus:   http.StatusForbidden,
+		},
+		{
+			name:         "cookie on a state-changing request with another CSRF token",
+			modes:        []string{ModeCookie},
+			method:       http.MethodDelete,
+			accessCookie: accessToken,
+			csrfCookie:   "csrftoken",
+			csrfHeader:   "forged",
+			wantStatus:   http.StatusForbidden,
+		},
+		{
+			name:         "cookie on a state-changing request with the CSRF token",
+			modes:        []string{ModeBearer, ModeCookie},
+			method:       http.MethodPut,
+			accessCookie: accessToken,
+			csrfCookie:   "csrftoken",
+			csrfHeader:   "csrftoken",
+			wantStatus:   http.StatusOK,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := newTestTransport(t, tt.modes...).RequireAuthentication(acceptAll)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			}))
+
+			request := httptest.NewRequest(tt.method, "/v1/profile", nil)
+			if tt.authorization != "" {
+				request.Header.Set("Authorization", tt.authorization)
+			}
+			if tt.accessCookie != "" {
+				request.AddCookie(&http.Cookie{Name: AccessTokenCookie, Value: tt.accessCookie})
+			}
+			if tt.csrfCookie != "" {
+				request.AddCookie(&http.Cookie{Name: CSRFCookie, Value: tt.csrfCookie})
+			}
+			if tt.csrfHeader != "" {
+				request.Header.Set(CSRFHeader, tt.csrfHeader)
+			}
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, request)
+
+			if recorder.Code != tt.wantStatus {
+				t.Errorf("RequireAuthentication() status = %v, want %v", recorder.Code, tt.wantStatus)
+			}
+		})
+	}
+}
+
+func TestTokenTransport_RefreshToken(t *testing.T) {
+	tests := []struct {
+		name       string
+		modes      []string
+		body       string
+		cookie     string
+		csrfHeader string
+		want       string
+		wantErr    bool
+	}{
+		{name: "from the body", modes: []string{ModeBearer}, body: `{"refresh_token":"fromthebody"}`, want: "fromthebody"},
+		{name: "from the cookie", modes: []string{ModeCookie}, cookie: "fromthecookie", csrfHeader: "csrftoken", want: "fromthecookie"},
+		{name: "from the cookie without CSRF token", modes: []string{ModeCookie}, cookie: "fromthecookie", wantErr: true},
+		{name: "from the body when only cookies are active", modes: []string{ModeCookie}, body: `{"refresh_token":"fromthebody"}`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := httptest.NewRequest(http.MethodPost, refreshTokenPath, strings.NewReader(tt.body))
+			if tt.cookie != "" {
+				request.AddCookie(&http.Cookie{Name: RefreshTokenCookie, Value: tt.cookie})
+				request.AddCookie(&http.Cookie{Name: CSRFCookie, Value: "csrftoken"})
+			}
+			if tt.csrfHeader != "" {
+				request.Header.Set(CSRFHeader, tt.csrfHeader)
+			}
+
+			got, err := newTestTransport(t, tt.modes...).RefreshToken(httptest.NewRecorder(), request)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("RefreshToken() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("RefreshToken() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
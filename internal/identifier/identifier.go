@@ -0,0 +1,77 @@
+// Package identifier canonicalizes the usernames and email addresses owners
+// sign in with, so that every spelling of an identifier finds the same owner.
+package identifier
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/unicode/norm"
+)
+
+// Normalize applies NFKC normalization and Unicode case folding, so that for
+// example "Alice@Example.com" and "ａｌｉｃｅ@example.com" are the same identifier.
+// It is applied both to the values stored and to the values looked up.
+func Normalize(identifier string) string {
+	folded := cases.Fold().String(norm.NFKC.String(strings.TrimSpace(identifier)))
+	// Folding can produce characters which compose differently
+	return norm.NFKC.String(folded)
+}
+
+// eastAsianScripts are routinely mixed within a single name
+var eastAsianScripts = []string{"Han", "Hiragana", "Katakana", "Hangul", "Bopomofo"}
+
+// latinLookalikes are the letters of a script that render like Latin letters,
+// a name made only of them passes for a Latin one
+var latinLookalikes = map[string]string{
+	"Cyrillic": "аеорсухіјѕԁһԛԝӏ",
+	"Greek":    "οινκρυα",
+}
+
+// IsConfusable reports whether the normalized username could be mistaken for
+// another one: it contains characters other than letters, digits, dots,
+// hyphens and underscores, mixes scripts or is made only of letters resembling
+// Latin ones.
+func IsConfusable(username string) bool {
+	var script string
+	lookalikesOnly := true
+
+	for _, r := range username {
+		switch {
+		case r == '.' || r == '-' || r == '_' || unicode.IsDigit(r) || unicode.Is(unicode.Mn, r):
+			continue
+		case !unicode.IsLetter(r):
+			return true
+		}
+
+		runeScript := scriptOf(r)
+		if script == "" {
+			script = runeScript
+		} else if runeScript != script {
+			return true
+		}
+
+		if !strings.ContainsRune(latinLookalikes[runeScript], r) {
+			lookalikesOnly = false
+		}
+	}
+
+	_, lookalikeScript := latinLookalikes[script]
+	return lookalikeScript && lookalikesOnly
+}
+
+func scriptOf(r rune) string {
+	for name, table := range unicode.Scripts {
+		if unicode.Is(table, r) {
+			for _, eastAsian := range eastAsianScripts {
+				if name == eastAsian {
+					return "EastAsian"
+				}
+			}
+			return name
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,110 @@
+package identifier
+
+import "testing"
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name       string
+		identifier string
+		want       string
+	}{
+		{
+			name:       "fold the case of an email address",
+			identifier: "Alice@Example.com",
+			want:       "alice@example.com",
+		},
+		{
+			name:       "trim surrounding whitespace",
+			identifier: "  devoratio ",
+			want:       "devoratio",
+		},
+		{
+			name:       "map fullwidth characters to their compatibility form",
+			identifier: "ＡＬＩＣＥ@example.com",
+			want:       "alice@example.com",
+		},
+		{
+			name:       "compose decomposed characters",
+			identifier: "José",
+			want:       "josé",
+		},
+		{
+			name:       "fold characters without a simple lowercase",
+			identifier: "STRASSE",
+			want:       "strasse",
+		},
+		{
+			name:       "fold sharp s",
+			identifier: "straße",
+			want:       "strasse",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Normalize(tt.identifier); got != tt.want {
+				t.Errorf("Normalize() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsConfusable(t *testing.T) {
+	tests := []struct {
+		name     string
+		username string
+		want     bool
+	}{
+		{
+			name:     "latin username",
+			username: "andre.febrianto_1",
+			want:     false,
+		},
+		{
+			name:     "latin username with accents",
+			username: "josé",
+			want:     false,
+		},
+		{
+			name:     "cyrillic username",
+			username: "андрей",
+			want:     false,
+		},
+		{
+			name:     "japanese username mixing kanji and kana",
+			username: "山田たろう",
+			want:     false,
+		},
+		{
+			name:     "latin username with a cyrillic letter",
+			username: "pаypal",
+			want:     true,
+		},
+		{
+			name:     "cyrillic letters resembling a latin username",
+			username: "аре",
+			want:     true,
+		},
+		{
+			name:     "greek letters resembling a latin username",
+			username: "κο",
+			want:     true,
+		},
+		{
+			name:     "zero width joiner",
+			username: "devo‍ratio",
+			want:     true,
+		},
+		{
+			name:     "whitespace",
+			username: "devo ratio",
+			want:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsConfusable(tt.username); got != tt.want {
+				t.Errorf("IsConfusable(%q) = %v, want %v", tt.username, got, tt.want)
+			}
+		})
+	}
+}
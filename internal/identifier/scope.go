@@ -0,0 +1,17 @@
+package identifier
+
+import "gorm.io/gorm"
+
+// Scope limits a query on owner accounts to the one whose username or email is
+// ownerIdentifier, in any spelling. With verifiedEmailOnly an email only
+// matches once the owner verified it.
+func Scope(ownerIdentifier string, verifiedEmailOnly bool) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		normalized := Normalize(ownerIdentifier)
+		if verifiedEmailOnly {
+			return db.Where("lower(username) = ? OR (lower(email) = ? AND email_verified)", normalized, normalized)
+		}
+
+		return db.Where("lower(username) = ? OR lower(email) = ?", normalized, normalized)
+	}
+}
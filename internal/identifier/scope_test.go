@@ -0,0 +1,49 @@
+package identifier
+
+import (
+	"testing"
+
+	"devoratio.dev/web-resume/model"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+func TestScope(t *testing.T) {
+	db, err := gorm.Open(postgres.New(postgres.Config{DSN: "postgres://resume@localhost/resume"}), &gorm.Config{
+		DryRun:               true,
+		DisableAutomaticPing: true,
+	})
+	if err != nil {
+		t.Fatalf("gorm.Open() error = %v", err)
+	}
+
+	tests := []struct {
+		name              string
+		verifiedEmailOnly bool
+		wantSQL           string
+	}{
+		{
+			name:    "match the username or any email",
+			wantSQL: `SELECT * FROM "owner_accounts" WHERE lower(username) = $1 OR lower(email) = $2 ORDER BY "owner_accounts"."id" LIMIT 1`,
+		},
+		{
+			name:              "match the username or a verified email",
+			verifiedEmailOnly: true,
+			wantSQL:           `SELECT * FROM "owner_accounts" WHERE lower(username) = $1 OR (lower(email) = $2 AND email_verified) ORDER BY "owner_accounts"."id" LIMIT 1`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			statement := db.Scopes(Scope(" Alice@Example.com ", tt.verifiedEmailOnly)).First(&model.OwnerAccount{}).Statement
+
+			if got := statement.SQL.String(); got != tt.wantSQL {
+				t.Errorf("Scope() SQL = %v, want %v", got, tt.wantSQL)
+			}
+			for _, got := range statement.Vars {
+				if got != "alice@example.com" {
+					t.Errorf("Scope() vars = %v, want the normalized identifier", statement.Vars)
+				}
+			}
+		})
+	}
+}
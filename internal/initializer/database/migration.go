@@ -0,0 +1,94 @@
+package database
+
+import (
+	"log"
+
+	"devoratio.dev/web-resume/config"
+	"devoratio.dev/web-resume/model"
+)
+
+// models lists every table owned by the service, in dependency order
+var models = []interface{}{
+	&model.OwnerAccount{},
+	&model.SetupState{},
+	&model.OneTimeToken{},
+	&model.AuditLog{},
+	&model.Session{},
+	&model.RefreshToken{},
+	&model.ExternalIdentity{},
+	&model.OAuthState{},
+	&model.PersonalToken{},
+	&model.Tenant{},
+	&model.Profile{},
+	&model.Experience{},
+	&model.Education{},
+	&model.SkillCategory{},
+	&model.Skill{},
+	&model.SkillAlias{},
+	&model.Project{},
+	&model.Certification{},
+	&model.Publication{},
+	&model.Language{},
+	&model.Link{},
+	&model.Variant{},
+}
+
+// statements run after the tables are migrated, they have to be idempotent
+var statements = []string{
+	// The audit trail is append-only, even for the service's own credential
+	`CREATE OR REPLACE FUNCTION reject_audit_log_change() RETURNS trigger AS $$
+	BEGIN
+		RAISE EXCEPTION 'audit_logs is append-only';
+	END;
+	$$ LANGUAGE plpgsql`,
+	`DROP TRIGGER IF EXISTS audit_logs_append_only ON audit_logs`,
+	`CREATE TRIGGER audit_logs_append_only BEFORE UPDATE OR DELETE ON audit_logs
+	FOR EACH ROW EXECUTE FUNCTION reject_audit_log_change()`,
+	`DROP TRIGGER IF EXISTS audit_logs_no_truncate ON audit_logs`,
+	`CREATE TRIGGER audit_logs_no_truncate BEFORE TRUNCATE ON audit_logs
+	FOR EACH STATEMENT EXECUTE FUNCTION reject_audit_log_change()`,
+	// Deployments set up before site administrators existed make their first
+	// owner one
+	`UPDATE owner_accounts SET site_admin = true
+	WHERE id = (SELECT min(id) FROM owner_accounts)
+	AND NOT EXISTS (SELECT 1 FROM owner_accounts WHERE site_admin)`,
+	// Identifiers are stored normalized, see identifier.Normalize. Rows written
+	// before are brought in line, lower approximating the case folding.
+	`UPDATE owner_accounts
+	SET username = normalize(lower(username), NFKC), email = normalize(lower(email), NFKC)
+	WHERE username <> normalize(lower(username), NFKC) OR email <> normalize(lower(email), NFKC)`,
+	`CREATE UNIQUE INDEX IF NOT EXISTS idx_owner_accounts_username_lower ON owner_accounts (lower(username))`,
+	`CREATE UNIQUE INDEX IF NOT EXISTS idx_owner_accounts_email_lower ON owner_accounts (lower(email))`,
+	// An owner has at most one default resume variant
+	`CREATE UNIQUE INDEX IF NOT EXISTS idx_variants_owner_default ON variants (owner_id) WHERE is_default`,
+}
+
+// Migrate brings the schema up to date using the migration credential, which
+// is the only credential allowed to run DDL statements.
+func Migrate(dbConfig config.PostgreSQL) error {
+	db, err := open(dbConfig.MigrationCredential, dbConfig.Primary)
+	if err != nil {
+		log.Printf("failed to connect to postgresql instances: %s", err)
+		return err
+	}
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		return err
+	}
+	defer sqlDB.Close()
+
+	if err := db.AutoMigrate(models...); err != nil {
+		log.Printf("failed to migrate postgresql schema: %s", err)
+		return err
+	}
+
+	for _, statement := range statements {
+		if err := db.Exec(statement).Error; err != nil {
+			log.Printf("failed to migrate postgresql schema: %s", err)
+			return err
+		}
+	}
+
+	return nil
+}
@@ -11,27 +11,34 @@ import (
 )
 
 func PostgreSQL(dbConfig config.PostgreSQL) *gorm.DB {
-	username := dbConfig.Credential.Username
-	password := dbConfig.Credential.Password
-	instance := dbConfig.Primary
+	db, err := open(dbConfig.Credential, dbConfig.Primary)
+	if err != nil {
+		log.Fatalf("failed to connect to postgresql instances: %s", err)
+	}
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		log.Fatalf("failed to configure postgresql connection pool: %s", err)
+	}
+	sqlDB.SetMaxOpenConns(int(dbConfig.ConnConfig.MaxOpen))
+	sqlDB.SetMaxIdleConns(int(dbConfig.ConnConfig.MaxIdle))
+	sqlDB.SetConnMaxIdleTime(dbConfig.ConnConfig.MaxIdleTime)
 
+	return db
+}
+
+func open(credential config.DatabaseCredential, instance config.PostgreSQLInstance) (*gorm.DB, error) {
 	dsn := fmt.Sprintf(
 		"postgres://%s:%s@%s:%s/%s",
-		username,
-		url.QueryEscape(password),
+		credential.Username,
+		url.QueryEscape(credential.Password),
 		instance.Host,
 		instance.Port,
 		instance.DBName,
 	)
 
-	db, err := gorm.Open(postgres.New(postgres.Config{
+	return gorm.Open(postgres.New(postgres.Config{
 		DSN:                  dsn,
 		PreferSimpleProtocol: true, // disables implicit prepared statement usage
 	}), &gorm.Config{})
-
-	if err != nil {
-		log.Fatalf("failed to connect to postgresql instances: %s", err)
-	}
-
-	return db
 }
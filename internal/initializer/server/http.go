@@ -0,0 +1,16 @@
+package server
+
+import (
+	"net/http"
+
+	"devoratio.dev/web-resume/config"
+)
+
+func HTTP(serverConfig config.Server, handler http.Handler) *http.Server {
+	return &http.Server{
+		Addr:         serverConfig.Address,
+		Handler:      handler,
+		ReadTimeout:  serverConfig.ReadTimeout,
+		WriteTimeout: serverConfig.WriteTimeout,
+	}
+}
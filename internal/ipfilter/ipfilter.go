@@ -0,0 +1,109 @@
+// Package ipfilter decides which client addresses may reach a group of routes.
+package ipfilter
+
+import (
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"devoratio.dev/web-resume/config"
+)
+
+// Filter allows the addresses within its allow list, or every address when the
+// allow list is empty, except those within its deny list
+type Filter struct {
+	allow []netip.Prefix
+	deny  []netip.Prefix
+}
+
+func New(filterConfig config.IPFilter) (*Filter, error) {
+	allow, err := ParsePrefixes(filterConfig.Allow)
+	if err != nil {
+		return nil, err
+	}
+
+	deny, err := ParsePrefixes(filterConfig.Deny)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Filter{allow: allow, deny: deny}, nil
+}
+
+func (f *Filter) Allows(addr netip.Addr) bool {
+	addr = addr.Unmap()
+
+	if Contains(f.deny, addr) {
+		return false
+	}
+
+	return len(f.allow) == 0 || Contains(f.allow, addr)
+}
+
+// ParsePrefixes parses CIDR ranges, a single address standing for a range of
+// its own
+func ParsePrefixes(cidrs []string) ([]netip.Prefix, error) {
+	prefixes := make([]netip.Prefix, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		cidr = strings.TrimSpace(cidr)
+
+		if !strings.Contains(cidr, "/") {
+			addr, err := netip.ParseAddr(cidr)
+			if err != nil {
+				return nil, fmt.Errorf("invalid address %q: %w", cidr, err)
+			}
+			addr = addr.Unmap()
+			prefixes = append(prefixes, netip.PrefixFrom(addr, addr.BitLen()))
+			continue
+		}
+
+		prefix, err := netip.ParsePrefix(cidr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid CIDR range %q: %w", cidr, err)
+		}
+		prefixes = append(prefixes, prefix.Masked())
+	}
+
+	return prefixes, nil
+}
+
+func Contains(prefixes []netip.Prefix, addr netip.Addr) bool {
+	for _, prefix := range prefixes {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// AccessControl holds the filters of the route groups and the proxies trusted
+// to report the client address
+type AccessControl struct {
+	TrustedProxies []netip.Prefix
+	Login          *Filter
+	Owner          *Filter
+}
+
+func NewAccessControl(accessConfig config.AccessControl) (*AccessControl, error) {
+	trustedProxies, err := ParsePrefixes(accessConfig.TrustedProxies)
+	if err != nil {
+		return nil, fmt.Errorf("trusted proxies: %w", err)
+	}
+
+	login, err := New(accessConfig.Login)
+	if err != nil {
+		return nil, fmt.Errorf("login filter: %w", err)
+	}
+
+	owner, err := New(accessConfig.Owner)
+	if err != nil {
+		return nil, fmt.Errorf("owner filter: %w", err)
+	}
+
+	return &AccessControl{
+		TrustedProxies: trustedProxies,
+		Login:          login,
+		Owner:          owner,
+	}, nil
+}
@@ -0,0 +1,119 @@
+package ipfilter
+
+import (
+	"net/netip"
+	"testing"
+
+	"devoratio.dev/web-resume/config"
+)
+
+func TestFilter_Allows(t *testing.T) {
+	tests := []struct {
+		name   string
+		config config.IPFilter
+		addr   string
+		want   bool
+	}{
+		{
+			name: "allow every address without lists",
+			addr: "203.0.113.7",
+			want: true,
+		},
+		{
+			name:   "allow an address within the allow list",
+			config: config.IPFilter{Allow: []string{"10.8.0.0/16"}},
+			addr:   "10.8.3.4",
+			want:   true,
+		},
+		{
+			name:   "refuse an address outside the allow list",
+			config: config.IPFilter{Allow: []string{"10.8.0.0/16"}},
+			addr:   "10.9.3.4",
+			want:   false,
+		},
+		{
+			name:   "refuse a denied address",
+			config: config.IPFilter{Deny: []string{"203.0.113.7"}},
+			addr:   "203.0.113.7",
+			want:   false,
+		},
+		{
+			name:   "deny wins over allow",
+			config: config.IPFilter{Allow: []string{"10.8.0.0/16"}, Deny: []string{"10.8.3.0/24"}},
+			addr:   "10.8.3.4",
+			want:   false,
+		},
+		{
+			name:   "match an IPv4-mapped IPv6 address against IPv4 ranges",
+			config: config.IPFilter{Allow: []string{"10.8.0.0/16"}},
+			addr:   "::ffff:10.8.3.4",
+			want:   true,
+		},
+		{
+			name:   "match IPv6 ranges",
+			config: config.IPFilter{Allow: []string{"2001:db8::/32"}},
+			addr:   "2001:db8::1",
+			want:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter, err := New(tt.config)
+			if err != nil {
+				t.Fatalf("New() error = %v", err)
+			}
+
+			if got := filter.Allows(netip.MustParseAddr(tt.addr)); got != tt.want {
+				t.Errorf("Filter.Allows() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParsePrefixes(t *testing.T) {
+	tests := []struct {
+		name    string
+		cidrs   []string
+		want    []netip.Prefix
+		wantErr bool
+	}{
+		{
+			name:  "parse ranges and single addresses",
+			cidrs: []string{"10.8.1.2/16", " 203.0.113.7 ", "2001:db8::1"},
+			want: []netip.Prefix{
+				netip.MustParsePrefix("10.8.0.0/16"),
+				netip.MustParsePrefix("203.0.113.7/32"),
+				netip.MustParsePrefix("2001:db8::1/128"),
+			},
+		},
+		{
+			name:    "refuse a malformed range",
+			cidrs:   []string{"10.8.0.0/33"},
+			wantErr: true,
+		},
+		{
+			name:    "refuse a host name",
+			cidrs:   []string{"vpn.example.com"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParsePrefixes(tt.cidrs)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParsePrefixes() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("ParsePrefixes() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("ParsePrefixes()[%d] = %v, want %v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"devoratio.dev/web-resume/internal/errorx"
+	"github.com/google/uuid"
+)
+
+// File writes every message as an .eml file into a directory
+type File struct {
+	directory string
+	from      string
+}
+
+func NewFile(directory, from string) *File {
+	return &File{
+		directory: directory,
+		from:      from,
+	}
+}
+
+func (f *File) Send(ctx context.Context, message Message) error {
+	if err := os.MkdirAll(f.directory, 0o700); err != nil {
+		return errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), err)
+	}
+
+	now := time.Now()
+	name := fmt.Sprintf("%s-%s.eml", now.Format("20060102T150405"), uuid.New().String())
+
+	err := os.WriteFile(filepath.Join(f.directory, name), message.Bytes(f.from, now), 0o600)
+	if err != nil {
+		return errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,23 @@
+package mailer
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// Log prints every message to the standard logger instead of delivering it
+type Log struct {
+	from string
+}
+
+func NewLog(from string) *Log {
+	return &Log{
+		from: from,
+	}
+}
+
+func (l *Log) Send(ctx context.Context, message Message) error {
+	log.Printf("mail not delivered, log driver is active:\n%s", message.Bytes(l.from, time.Now()))
+	return nil
+}
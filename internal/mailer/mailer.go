@@ -0,0 +1,66 @@
+package mailer
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"mime"
+	"strings"
+	"time"
+
+	"devoratio.dev/web-resume/config"
+	"devoratio.dev/web-resume/internal/errorx"
+)
+
+const (
+	DriverSMTP = "smtp"
+	DriverFile = "file"
+	DriverLog  = "log"
+)
+
+type Mailer interface {
+	Send(ctx context.Context, message Message) error
+}
+
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// New creates the mailer selected by the configured driver. The file and log
+// drivers let tests and local development run without a mail server.
+func New(mailerConfig config.Mailer) (Mailer, error) {
+	switch mailerConfig.Driver {
+	case DriverSMTP:
+		return NewSMTP(mailerConfig.SMTP, mailerConfig.From)
+	case DriverFile:
+		return NewFile(mailerConfig.Directory, mailerConfig.From), nil
+	case DriverLog:
+		return NewLog(mailerConfig.From), nil
+	default:
+		return nil, errorx.Errorf("unknown mailer driver %q", mailerConfig.Driver)
+	}
+}
+
+// Bytes renders the message as an RFC 5322 plain text email
+func (m Message) Bytes(from string, date time.Time) []byte {
+	buf := bytes.Buffer{}
+
+	fmt.Fprintf(&buf, "From: %s\r\n", sanitizeHeader(from))
+	fmt.Fprintf(&buf, "To: %s\r\n", sanitizeHeader(m.To))
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", sanitizeHeader(m.Subject)))
+	fmt.Fprintf(&buf, "Date: %s\r\n", date.Format(time.RFC1123Z))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	buf.WriteString("Content-Transfer-Encoding: 8bit\r\n")
+	buf.WriteString("\r\n")
+	buf.WriteString(strings.ReplaceAll(strings.ReplaceAll(m.Body, "\r\n", "\n"), "\n", "\r\n"))
+
+	return buf.Bytes()
+}
+
+// sanitizeHeader prevents header injection through user controlled values
+func sanitizeHeader(value string) string {
+	return strings.NewReplacer("\r", "", "\n", "").Replace(value)
+}
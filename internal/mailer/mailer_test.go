@@ -0,0 +1,105 @@
+package mailer
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"devoratio.dev/web-resume/config"
+)
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name    string
+		driver  string
+		from    string
+		wantErr bool
+	}{
+		{name: "smtp driver", driver: DriverSMTP, from: "Web Resume <no-reply@devoratio.dev>"},
+		{name: "smtp driver with an invalid sender", driver: DriverSMTP, from: "no-reply", wantErr: true},
+		{name: "file driver", driver: DriverFile},
+		{name: "log driver", driver: DriverLog},
+		{name: "unknown driver", driver: "carrier-pigeon", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := New(config.Mailer{Driver: tt.driver, From: tt.from, SMTP: config.SMTP{Timeout: time.Second}})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("New() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMessage_Bytes(t *testing.T) {
+	date := time.Date(2024, time.January, 2, 3, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		message Message
+		want    []string
+		notWant []string
+	}{
+		{
+			name: "plain message",
+			message: Message{
+				To:      "owner@devoratio.dev",
+				Subject: "Reset your password",
+				Body:    "first line\nsecond line",
+			},
+			want: []string{
+				"From: Web Resume <no-reply@devoratio.dev>\r\n",
+				"To: owner@devoratio.dev\r\n",
+				"Subject: Reset your password\r\n",
+				"Date: Tue, 02 Jan 2024 03:04:05 +0000\r\n",
+				"\r\n\r\nfirst line\r\nsecond line",
+			},
+		},
+		{
+			name: "header injection is stripped",
+			message: Message{
+				To:      "owner@devoratio.dev\r\nBcc: attacker@example.com",
+				Subject: "Hello\nBcc: attacker@example.com",
+			},
+			notWant: []string{"\r\nBcc:", "\nBcc:"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := string(tt.message.Bytes("Web Resume <no-reply@devoratio.dev>", date))
+			for _, want := range tt.want {
+				if !strings.Contains(got, want) {
+					t.Errorf("Message.Bytes() = %q, want it to contain %q", got, want)
+				}
+			}
+			for _, notWant := range tt.notWant {
+				if strings.Contains(got, notWant) {
+					t.Errorf("Message.Bytes() = %q, must not contain %q", got, notWant)
+				}
+			}
+		})
+	}
+}
+
+func TestFile_Send(t *testing.T) {
+	directory := filepath.Join(t.TempDir(), "mail")
+	fileMailer := NewFile(directory, "no-reply@devoratio.dev")
+
+	err := fileMailer.Send(context.Background(), Message{To: "owner@devoratio.dev", Subject: "Hello", Body: "World"})
+	if err != nil {
+		t.Fatalf("File.Send() error = %v", err)
+	}
+
+	files, _ := filepath.Glob(filepath.Join(directory, "*.eml"))
+	if len(files) != 1 {
+		t.Fatalf("File.Send() wrote %d files, want 1", len(files))
+	}
+
+	content, _ := os.ReadFile(files[0])
+	if !strings.Contains(string(content), "To: owner@devoratio.dev") {
+		t.Errorf("File.Send() wrote %q", content)
+	}
+}
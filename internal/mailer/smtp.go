@@ -0,0 +1,107 @@
+package mailer
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"time"
+
+	"devoratio.dev/web-resume/config"
+	"devoratio.dev/web-resume/internal/errorx"
+)
+
+type SMTP struct {
+	host    string
+	address string
+	// from is the From header, sender the bare address given as envelope sender
+	from    string
+	sender  string
+	auth    smtp.Auth
+	timeout time.Duration
+}
+
+func NewSMTP(smtpConfig config.SMTP, from string) (*SMTP, error) {
+	sender, err := mail.ParseAddress(from)
+	if err != nil {
+		return nil, errorx.Errorf("invalid mailer sender %q: %w", from, err)
+	}
+
+	if smtpConfig.Timeout <= 0 {
+		return nil, errorx.Errorf("mailer smtp timeout must be positive, got %s", smtpConfig.Timeout)
+	}
+
+	var auth smtp.Auth
+	if smtpConfig.Username != "" {
+		auth = smtp.PlainAuth("", smtpConfig.Username, smtpConfig.Password, smtpConfig.Host)
+	}
+
+	return &SMTP{
+		host:    smtpConfig.Host,
+		address: net.JoinHostPort(smtpConfig.Host, smtpConfig.Port),
+		from:    from,
+		sender:  sender.Address,
+		auth:    auth,
+		timeout: smtpConfig.Timeout,
+	}, nil
+}
+
+// Send delivers message, giving up after the configured timeout even when ctx
+// has no deadline, as most messages are sent in the background
+func (s *SMTP) Send(ctx context.Context, message Message) error {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	dialer := net.Dialer{}
+	conn, err := dialer.DialContext(ctx, "tcp", s.address)
+	if err != nil {
+		return errorx.New(errorx.TypeBadGateway, errorx.TypeBadGateway.String(), err)
+	}
+	deadline, _ := ctx.Deadline()
+	conn.SetDeadline(deadline)
+
+	client, err := smtp.NewClient(conn, s.host)
+	if err != nil {
+		conn.Close()
+		return errorx.New(errorx.TypeBadGateway, errorx.TypeBadGateway.String(), err)
+	}
+	defer client.Close()
+
+	if err := s.deliver(client, message); err != nil {
+		return errorx.New(errorx.TypeBadGateway, errorx.TypeBadGateway.String(), err)
+	}
+
+	return client.Quit()
+}
+
+func (s *SMTP) deliver(client *smtp.Client, message Message) error {
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: s.host}); err != nil {
+			return err
+		}
+	}
+
+	if s.auth != nil {
+		if err := client.Auth(s.auth); err != nil {
+			return err
+		}
+	}
+
+	if err := client.Mail(s.sender); err != nil {
+		return err
+	}
+	if err := client.Rcpt(message.To); err != nil {
+		return err
+	}
+
+	writer, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := writer.Write(message.Bytes(s.from, time.Now())); err != nil {
+		return err
+	}
+
+	return writer.Close()
+}
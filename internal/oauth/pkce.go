@@ -0,0 +1,21 @@
+package oauth
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+
+	"devoratio.dev/web-resume/internal/generator"
+)
+
+// NewCodeVerifier returns a PKCE code verifier (RFC 7636), 43 characters of
+// URL-safe randomness.
+func NewCodeVerifier() (string, error) {
+	return generator.GenerateRandomToken()
+}
+
+// CodeChallenge derives the S256 code challenge sent along the authorization
+// request from a code verifier.
+func CodeChallenge(codeVerifier string) string {
+	sum := sha256.Sum256([]byte(codeVerifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
@@ -0,0 +1,319 @@
+package oauth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"devoratio.dev/web-resume/config"
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/model"
+)
+
+const (
+	TypeGitHub = "github"
+	TypeGoogle = "google"
+	TypeOIDC   = "oidc"
+
+	googleIssuer = "https://accounts.google.com"
+
+	requestTimeout  = 10 * time.Second
+	maxResponseSize = 1 << 20
+)
+
+const invalidCodeMessage = "authorization code is invalid or has expired"
+
+// Endpoint is where the authorization code flow of a provider takes place
+type Endpoint struct {
+	AuthURL     string `json:"authorization_endpoint"`
+	TokenURL    string `json:"token_endpoint"`
+	UserInfoURL string `json:"userinfo_endpoint"`
+}
+
+var githubEndpoint = Endpoint{
+	AuthURL:     "https://github.com/login/oauth/authorize",
+	TokenURL:    "https://github.com/login/oauth/access_token",
+	UserInfoURL: "https://api.github.com/user",
+}
+
+var defaultScopes = map[string][]string{
+	TypeGitHub: {"read:user", "user:email"},
+	TypeGoogle: {"openid", "email", "profile"},
+	TypeOIDC:   {"openid", "email", "profile"},
+}
+
+type provider struct {
+	config config.OAuthProvider
+	scopes []string
+
+	// endpoint is discovered on first use for OpenID Connect providers
+	mu       sync.Mutex
+	endpoint *Endpoint
+}
+
+// Registry runs the authorization code flow with PKCE against every
+// configured identity provider, addressed by name.
+type Registry struct {
+	providers map[string]*provider
+	client    *http.Client
+}
+
+func NewRegistry(oauthConfig config.OAuth) (*Registry, error) {
+	registry := &Registry{
+		providers: map[string]*provider{},
+		client:    &http.Client{Timeout: requestTimeout},
+	}
+
+	for _, providerConfig := range oauthConfig.Providers {
+		if _, exists := registry.providers[providerConfig.Name]; exists || providerConfig.Name == "" {
+			return nil, errorx.Errorf("oauth provider name %q is empty or used twice", providerConfig.Name)
+		}
+
+		p := &provider{config: providerConfig, scopes: providerConfig.Scopes}
+		switch providerConfig.Type {
+		case TypeGitHub:
+			endpoint := githubEndpoint
+			p.endpoint = &endpoint
+		case TypeGoogle:
+			if p.config.Issuer == "" {
+				p.config.Issuer = googleIssuer
+			}
+		case TypeOIDC:
+			if p.config.Issuer == "" {
+				return nil, errorx.Errorf("oauth provider %q needs an issuer", providerConfig.Name)
+			}
+		default:
+			return nil, errorx.Errorf("oauth provider %q has unknown type %q", providerConfig.Name, providerConfig.Type)
+		}
+		if len(p.scopes) == 0 {
+			p.scopes = defaultScopes[providerConfig.Type]
+		}
+
+		registry.providers[providerConfig.Name] = p
+	}
+
+	return registry, nil
+}
+
+// AuthCodeURL returns the page of the provider the owner has to be sent to.
+// It returns errorx.ErrNotFound for providers that are not configured.
+func (r *Registry) AuthCodeURL(ctx context.Context, name, state, codeChallenge string) (string, error) {
+	p, endpoint, err := r.lookup(ctx, name)
+	if err != nil {
+		return "", err
+	}
+
+	authURL, err := url.Parse(endpoint.AuthURL)
+	if err != nil {
+		return "", errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), err)
+	}
+
+	query := authURL.Query()
+	query.Set("response_type", "code")
+	query.Set("client_id", p.config.ClientID)
+	query.Set("redirect_uri", p.config.RedirectURL)
+	query.Set("scope", strings.Join(p.scopes, " "))
+	query.Set("state", state)
+	query.Set("code_challenge", codeChallenge)
+	query.Set("code_challenge_method", "S256")
+	authURL.RawQuery = query.Encode()
+
+	return authURL.String(), nil
+}
+
+// Exchange redeems the authorization code the provider sent the owner back
+// with, and returns the account it was issued for.
+func (r *Registry) Exchange(ctx context.Context, name, code, codeVerifier string) (*model.ExternalProfile, error) {
+	p, endpoint, err := r.lookup(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	accessToken, err := r.redeem(ctx, p, endpoint, code, codeVerifier)
+	if err != nil {
+		return nil, err
+	}
+
+	profile, err := r.userInfo(ctx, p, endpoint, accessToken)
+	if err != nil {
+		return nil, err
+	}
+	profile.Provider = name
+
+	return profile, nil
+}
+
+func (r *Registry) lookup(ctx context.Context, name string) (*provider, *Endpoint, error) {
+	p, found := r.providers[name]
+	if !found {
+		return nil, nil, errorx.ErrNotFound
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.endpoint == nil {
+		endpoint, err := r.discover(ctx, p.config.Issuer)
+		if err != nil {
+			return nil, nil, err
+		}
+		p.endpoint = endpoint
+	}
+
+	return p, p.endpoint, nil
+}
+
+// discover reads the endpoints of an OpenID Connect provider from its
+// well-known configuration
+func (r *Registry) discover(ctx context.Context, issuer string) (*Endpoint, error) {
+	discoveryURL := strings.TrimSuffix(issuer, "/") + "/.well-known/openid-configuration"
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, discoveryURL, nil)
+	if err != nil {
+		return nil, errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), err)
+	}
+
+	endpoint := &Endpoint{}
+	if err := r.do(request, endpoint); err != nil {
+		return nil, err
+	}
+	if endpoint.AuthURL == "" || endpoint.TokenURL == "" || endpoint.UserInfoURL == "" {
+		return nil, errorx.New(errorx.TypeBadGateway, errorx.TypeBadGateway.String(),
+			fmt.Errorf("openid configuration of %s is missing endpoints", issuer))
+	}
+
+	return endpoint, nil
+}
+
+type tokenResponse struct {
+	AccessToken string `json:"access_token"`
+	// Error is set by providers, such as GitHub, that report errors with a
+	// successful status
+	Error string `json:"error"`
+}
+
+func (r *Registry) redeem(ctx context.Context, p *provider, endpoint *Endpoint, code, codeVerifier string) (string, error) {
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {p.config.RedirectURL},
+		"client_id":     {p.config.ClientID},
+		"client_secret": {p.config.ClientSecret},
+		"code_verifier": {codeVerifier},
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint.TokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), err)
+	}
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	response := tokenResponse{}
+	if err := r.do(request, &response); err != nil {
+		if errorx.Is(err, errorx.ErrInvalidParameter) {
+			return "", errorx.New(errorx.TypeInvalidParameter, invalidCodeMessage, err)
+		}
+		return "", err
+	}
+	if response.Error != "" || response.AccessToken == "" {
+		return "", errorx.New(errorx.TypeInvalidParameter, invalidCodeMessage, nil)
+	}
+
+	return response.AccessToken, nil
+}
+
+type userInfoResponse struct {
+	// OpenID Connect
+	Subject           string      `json:"sub"`
+	PreferredUsername string      `json:"preferred_username"`
+	EmailVerified     interface{} `json:"email_verified"`
+
+	// GitHub
+	ID    json.Number `json:"id"`
+	Login string      `json:"login"`
+
+	Email string `json:"email"`
+}
+
+func (r *Registry) userInfo(ctx context.Context, p *provider, endpoint *Endpoint, accessToken string) (*model.ExternalProfile, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint.UserInfoURL, nil)
+	if err != nil {
+		return nil, errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), err)
+	}
+	request.Header.Set("Authorization", "Bearer "+accessToken)
+
+	response := userInfoResponse{}
+	if err := r.do(request, &response); err != nil {
+		return nil, err
+	}
+
+	profile := &model.ExternalProfile{Email: response.Email}
+	if p.config.Type == TypeGitHub {
+		// GitHub only shows an email the account made public, which it does not
+		// vouch for
+		profile.Subject = response.ID.String()
+		profile.Username = response.Login
+	} else {
+		profile.Subject = response.Subject
+		profile.Username = response.PreferredUsername
+		profile.EmailVerified = isTrue(response.EmailVerified)
+	}
+
+	if profile.Subject == "" {
+		return nil, errorx.New(errorx.TypeBadGateway, errorx.TypeBadGateway.String(),
+			fmt.Errorf("oauth provider %s did not identify the account", p.config.Name))
+	}
+
+	return profile, nil
+}
+
+// do sends the request and decodes the JSON response into v. Client errors
+// are reported as invalid parameters, anything else as a bad gateway.
+func (r *Registry) do(request *http.Request, v interface{}) error {
+	request.Header.Set("Accept", "application/json")
+
+	response, err := r.client.Do(request)
+	if err != nil {
+		return errorx.New(errorx.TypeBadGateway, errorx.TypeBadGateway.String(), err)
+	}
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(response.Body, maxResponseSize))
+	if err != nil {
+		return errorx.New(errorx.TypeBadGateway, errorx.TypeBadGateway.String(), err)
+	}
+
+	switch {
+	case response.StatusCode >= 400 && response.StatusCode < 500:
+		return errorx.New(errorx.TypeInvalidParameter, errorx.TypeInvalidParameter.String(),
+			fmt.Errorf("%s responded with status %d", request.URL.Host, response.StatusCode))
+	case response.StatusCode < 200 || response.StatusCode > 299:
+		return errorx.New(errorx.TypeBadGateway, errorx.TypeBadGateway.String(),
+			fmt.Errorf("%s responded with status %d", request.URL.Host, response.StatusCode))
+	}
+
+	if err := json.Unmarshal(body, v); err != nil {
+		return errorx.New(errorx.TypeBadGateway, errorx.TypeBadGateway.String(), err)
+	}
+
+	return nil
+}
+
+// isTrue reads the email_verified claim, which some providers send as a string
+func isTrue(value interface{}) bool {
+	switch v := value.(type) {
+	case bool:
+		return v
+	case string:
+		verified, _ := strconv.ParseBool(v)
+		return verified
+	default:
+		return false
+	}
+}
@@ -0,0 +1,228 @@
+package oauth
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"devoratio.dev/web-resume/config"
+	"devoratio.dev/web-resume/internal/errorx"
+)
+
+const (
+	mockClientID     = "web-resume"
+	mockClientSecret = "client-secret"
+	mockCode         = "authorization-code"
+	mockAccessToken  = "provider-access-token"
+	mockRedirectURL  = "https://resume.devoratio.dev/oauth/mock"
+)
+
+// newMockProvider starts a minimal OpenID Connect provider that issued
+// mockCode for the given PKCE code challenge.
+func newMockProvider(t *testing.T, codeChallenge string) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	mux.HandleFunc("GET /.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(Endpoint{
+			AuthURL:     server.URL + "/authorize",
+			TokenURL:    server.URL + "/token",
+			UserInfoURL: server.URL + "/userinfo",
+		})
+	})
+	mux.HandleFunc("POST /token", func(w http.ResponseWriter, r *http.Request) {
+		valid := r.PostFormValue("grant_type") == "authorization_code" &&
+			r.PostFormValue("code") == mockCode &&
+			r.PostFormValue("client_id") == mockClientID &&
+			r.PostFormValue("client_secret") == mockClientSecret &&
+			r.PostFormValue("redirect_uri") == mockRedirectURL &&
+			CodeChallenge(r.PostFormValue("code_verifier")) == codeChallenge
+		if !valid {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":"invalid_grant"}`))
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"access_token":"` + mockAccessToken + `","token_type":"Bearer"}`))
+	})
+	mux.HandleFunc("GET /userinfo", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+mockAccessToken {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"sub":"248289761001","preferred_username":"devoratio","email":"owner@devoratio.dev","email_verified":"true"}`))
+	})
+	mux.HandleFunc("GET /user", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+mockAccessToken {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"id":1680,"login":"devoratio","email":"owner@devoratio.dev"}`))
+	})
+
+	return server
+}
+
+func newMockRegistry(t *testing.T, providerType, issuer string) *Registry {
+	t.Helper()
+
+	registry, err := NewRegistry(config.OAuth{
+		Providers: []config.OAuthProvider{{
+			Name:         "mock",
+			Type:         providerType,
+			ClientID:     mockClientID,
+			ClientSecret: mockClientSecret,
+			RedirectURL:  mockRedirectURL,
+			Issuer:       issuer,
+		}},
+	})
+	if err != nil {
+		t.Fatalf("NewRegistry() error = %v", err)
+	}
+
+	return registry
+}
+
+func TestRegistry_AuthCodeURL(t *testing.T) {
+	server := newMockProvider(t, "")
+	registry := newMockRegistry(t, TypeOIDC, server.URL)
+
+	got, err := registry.AuthCodeURL(context.Background(), "mock", "state", "challenge")
+	if err != nil {
+		t.Fatalf("AuthCodeURL() error = %v", err)
+	}
+
+	authURL, err := url.Parse(got)
+	if err != nil {
+		t.Fatalf("AuthCodeURL() = %v, not a URL", got)
+	}
+	if authURL.Path != "/authorize" {
+		t.Errorf("AuthCodeURL() path = %v, want /authorize", authURL.Path)
+	}
+
+	want := map[string]string{
+		"response_type":         "code",
+		"client_id":             mockClientID,
+		"redirect_uri":          mockRedirectURL,
+		"scope":                 "openid email profile",
+		"state":                 "state",
+		"code_challenge":        "challenge",
+		"code_challenge_method": "S256",
+	}
+	for key, value := range want {
+		if got := authURL.Query().Get(key); got != value {
+			t.Errorf("AuthCodeURL() %s = %q, want %q", key, got, value)
+		}
+	}
+}
+
+func TestRegistry_Exchange(t *testing.T) {
+	codeVerifier, err := NewCodeVerifier()
+	if err != nil {
+		t.Fatalf("NewCodeVerifier() error = %v", err)
+	}
+	server := newMockProvider(t, CodeChallenge(codeVerifier))
+
+	tests := []struct {
+		name         string
+		providerType string
+		code         string
+		codeVerifier string
+		wantSubject  string
+		wantVerified bool
+		wantErr      *errorx.Error
+	}{
+		{
+			name:         "openid connect provider",
+			providerType: TypeOIDC,
+			code:         mockCode,
+			codeVerifier: codeVerifier,
+			wantSubject:  "248289761001",
+			wantVerified: true,
+		},
+		{
+			name:         "github",
+			providerType: TypeGitHub,
+			code:         mockCode,
+			codeVerifier: codeVerifier,
+			wantSubject:  "1680",
+		},
+		{
+			name:         "code verifier does not match the challenge",
+			providerType: TypeOIDC,
+			code:         mockCode,
+			codeVerifier: "another-verifier",
+			wantErr:      errorx.ErrInvalidParameter,
+		},
+		{
+			name:         "unknown code",
+			providerType: TypeOIDC,
+			code:         "forged-code",
+			codeVerifier: codeVerifier,
+			wantErr:      errorx.ErrInvalidParameter,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			registry := newMockRegistry(t, tt.providerType, server.URL)
+			if tt.providerType == TypeGitHub {
+				registry.providers["mock"].endpoint = &Endpoint{
+					AuthURL:     server.URL + "/authorize",
+					TokenURL:    server.URL + "/token",
+					UserInfoURL: server.URL + "/user",
+				}
+			}
+
+			profile, err := registry.Exchange(context.Background(), "mock", tt.code, tt.codeVerifier)
+			if tt.wantErr != nil {
+				if err == nil || err.(*errorx.Error).Type != tt.wantErr.Type {
+					t.Fatalf("Exchange() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Exchange() error = %v", err)
+			}
+			if profile.Provider != "mock" || profile.Subject != tt.wantSubject || profile.Username != "devoratio" {
+				t.Errorf("Exchange() = %+v, want subject %v", profile, tt.wantSubject)
+			}
+			if profile.EmailVerified != tt.wantVerified {
+				t.Errorf("Exchange() EmailVerified = %v, want %v", profile.EmailVerified, tt.wantVerified)
+			}
+		})
+	}
+}
+
+func TestRegistry_UnknownProvider(t *testing.T) {
+	registry := newMockRegistry(t, TypeGitHub, "")
+
+	if _, err := registry.AuthCodeURL(context.Background(), "gitlab", "state", "challenge"); err != errorx.ErrNotFound {
+		t.Errorf("AuthCodeURL() error = %v, want %v", err, errorx.ErrNotFound)
+	}
+}
+
+func TestNewRegistry_InvalidConfig(t *testing.T) {
+	tests := []struct {
+		name      string
+		providers []config.OAuthProvider
+	}{
+		{name: "unknown type", providers: []config.OAuthProvider{{Name: "gitlab", Type: "gitlab"}}},
+		{name: "oidc without issuer", providers: []config.OAuthProvider{{Name: "sso", Type: TypeOIDC}}},
+		{name: "duplicate name", providers: []config.OAuthProvider{{Name: "github", Type: TypeGitHub}, {Name: "github", Type: TypeGitHub}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewRegistry(config.OAuth{Providers: tt.providers}); err == nil {
+				t.Errorf("NewRegistry() error = nil, want error")
+			}
+		})
+	}
+}
@@ -0,0 +1,153 @@
+package policy
+
+import (
+	"net/mail"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"devoratio.dev/web-resume/model"
+)
+
+var (
+	// GitHub usernames are alphanumeric with single hyphens in between
+	githubUsernamePattern = regexp.MustCompile(`^[A-Za-z0-9](?:[A-Za-z0-9-]{0,37}[A-Za-z0-9])?$`)
+	linkedInSlugPattern   = regexp.MustCompile(`^[\p{L}\p{N}_-]{3,100}$`)
+	mastodonUserPattern   = regexp.MustCompile(`^[A-Za-z0-9_]{1,30}$`)
+	hostnamePattern       = regexp.MustCompile(`^[a-z0-9](?:[a-z0-9-]{0,61}[a-z0-9])?(?:\.[a-z0-9](?:[a-z0-9-]{0,61}[a-z0-9])?)+$`)
+)
+
+// NormalizeLink checks that value is a link of linkType and returns it the way
+// it is stored, reporting false when it is not:
+//
+//   - github: https://github.com/username, from the username, @username or the
+//     profile URL
+//   - linkedin: https://www.linkedin.com/in/name, from the profile URL
+//   - mastodon: https://server/@username, from @username@server or the
+//     profile URL
+//   - website: the http or https URL, https being assumed without a scheme
+//   - email: the address with its domain lowercased
+func NormalizeLink(linkType model.LinkType, value string) (string, bool) {
+	value = strings.TrimSpace(value)
+
+	switch linkType {
+	case model.LinkGitHub:
+		return normalizeGitHub(value)
+	case model.LinkLinkedIn:
+		return normalizeLinkedIn(value)
+	case model.LinkMastodon:
+		return normalizeMastodon(value)
+	case model.LinkWebsite:
+		return normalizeWebsite(value)
+	case model.LinkEmail:
+		return normalizeEmail(value)
+	default:
+		return "", false
+	}
+}
+
+func normalizeGitHub(value string) (string, bool) {
+	username := strings.TrimPrefix(value, "@")
+	if strings.ContainsAny(value, "./") {
+		host, path, ok := splitProfileURL(value)
+		if !ok || (host != "github.com" && host != "www.github.com") {
+			return "", false
+		}
+		username = path
+	}
+
+	if !githubUsernamePattern.MatchString(username) || strings.Contains(username, "--") {
+		return "", false
+	}
+
+	return "https://github.com/" + username, true
+}
+
+func normalizeLinkedIn(value string) (string, bool) {
+	host, path, ok := splitProfileURL(value)
+	if !ok || (host != "linkedin.com" && !strings.HasSuffix(host, ".linkedin.com")) {
+		return "", false
+	}
+
+	name, found := strings.CutPrefix(path, "in/")
+	if !found || !linkedInSlugPattern.MatchString(name) {
+		return "", false
+	}
+
+	return "https://www.linkedin.com/in/" + name, true
+}
+
+func normalizeMastodon(value string) (string, bool) {
+	var username, server string
+	if handle, found := strings.CutPrefix(value, "@"); found {
+		username, server, found = strings.Cut(handle, "@")
+		if !found {
+			return "", false
+		}
+		server = strings.ToLower(server)
+	} else {
+		var path string
+		var ok bool
+		server, path, ok = splitProfileURL(value)
+		if !ok {
+			return "", false
+		}
+		if username, found = strings.CutPrefix(path, "@"); !found {
+			return "", false
+		}
+	}
+
+	if !mastodonUserPattern.MatchString(username) || !hostnamePattern.MatchString(server) {
+		return "", false
+	}
+
+	return "https://" + server + "/@" + username, true
+}
+
+func normalizeWebsite(value string) (string, bool) {
+	if !strings.Contains(value, "://") {
+		value = "https://" + value
+	}
+	if !IsWebURL(value) {
+		return "", false
+	}
+
+	parsed, err := url.Parse(value)
+	if err != nil {
+		return "", false
+	}
+	parsed.Scheme = strings.ToLower(parsed.Scheme)
+	parsed.Host = strings.ToLower(parsed.Host)
+
+	return parsed.String(), true
+}
+
+func normalizeEmail(value string) (string, bool) {
+	value = strings.TrimPrefix(value, "mailto:")
+	address, err := mail.ParseAddress(value)
+	if err != nil || address.Address != value {
+		return "", false
+	}
+
+	local, domain, _ := strings.Cut(value, "@")
+	return local + "@" + strings.ToLower(domain), true
+}
+
+// splitProfileURL returns the lowercased host and the path of a profile URL
+// without its surrounding slashes. The scheme may be left out, the query and
+// fragment are dropped.
+func splitProfileURL(value string) (string, string, bool) {
+	if !strings.Contains(value, "://") {
+		value = "https://" + value
+	}
+	if !IsWebURL(value) {
+		return "", "", false
+	}
+
+	parsed, err := url.Parse(value)
+	if err != nil || parsed.Port() != "" {
+		return "", "", false
+	}
+
+	return strings.ToLower(parsed.Hostname()), strings.Trim(parsed.Path, "/"), true
+}
@@ -0,0 +1,126 @@
+package policy
+
+import (
+	"testing"
+
+	"devoratio.dev/web-resume/model"
+)
+
+func TestNormalizeLink(t *testing.T) {
+	tests := []struct {
+		name     string
+		linkType model.LinkType
+		value    string
+		want     string
+		wantOK   bool
+	}{
+		{
+			name:     "GitHub username",
+			linkType: model.LinkGitHub,
+			value:    " @devoratio ",
+			want:     "https://github.com/devoratio",
+			wantOK:   true,
+		},
+		{
+			name:     "GitHub profile URL without a scheme",
+			linkType: model.LinkGitHub,
+			value:    "www.github.com/devoratio/",
+			want:     "https://github.com/devoratio",
+			wantOK:   true,
+		},
+		{
+			name:     "GitHub repository URL",
+			linkType: model.LinkGitHub,
+			value:    "https://github.com/devoratio/web-resume",
+			wantOK:   false,
+		},
+		{
+			name:     "GitHub username with a double hyphen",
+			linkType: model.LinkGitHub,
+			value:    "devo--ratio",
+			wantOK:   false,
+		},
+		{
+			name:     "GitHub URL of another host",
+			linkType: model.LinkGitHub,
+			value:    "https://gitlab.com/devoratio",
+			wantOK:   false,
+		},
+		{
+			name:     "LinkedIn profile URL of a country subdomain",
+			linkType: model.LinkLinkedIn,
+			value:    "https://fr.linkedin.com/in/devoratio?trk=profile",
+			want:     "https://www.linkedin.com/in/devoratio",
+			wantOK:   true,
+		},
+		{
+			name:     "LinkedIn company URL",
+			linkType: model.LinkLinkedIn,
+			value:    "linkedin.com/company/devoratio",
+			wantOK:   false,
+		},
+		{
+			name:     "Mastodon handle",
+			linkType: model.LinkMastodon,
+			value:    "@devoratio@Fosstodon.org",
+			want:     "https://fosstodon.org/@devoratio",
+			wantOK:   true,
+		},
+		{
+			name:     "Mastodon profile URL",
+			linkType: model.LinkMastodon,
+			value:    "https://hachyderm.io/@devoratio",
+			want:     "https://hachyderm.io/@devoratio",
+			wantOK:   true,
+		},
+		{
+			name:     "Mastodon handle without a server",
+			linkType: model.LinkMastodon,
+			value:    "@devoratio",
+			wantOK:   false,
+		},
+		{
+			name:     "website without a scheme",
+			linkType: model.LinkWebsite,
+			value:    "Devoratio.dev/about",
+			want:     "https://devoratio.dev/about",
+			wantOK:   true,
+		},
+		{
+			name:     "website with a script scheme",
+			linkType: model.LinkWebsite,
+			value:    "javascript:alert(1)",
+			wantOK:   false,
+		},
+		{
+			name:     "email with a mailto prefix",
+			linkType: model.LinkEmail,
+			value:    "mailto:Hello@Devoratio.dev",
+			want:     "Hello@devoratio.dev",
+			wantOK:   true,
+		},
+		{
+			name:     "email with a display name",
+			linkType: model.LinkEmail,
+			value:    "Devoratio <hello@devoratio.dev>",
+			wantOK:   false,
+		},
+		{
+			name:     "unknown type",
+			linkType: model.LinkType("myspace"),
+			value:    "https://myspace.com/devoratio",
+			wantOK:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := NormalizeLink(tt.linkType, tt.value)
+			if ok != tt.wantOK {
+				t.Fatalf("NormalizeLink() ok = %v, want %v", ok, tt.wantOK)
+			}
+			if got != tt.want {
+				t.Errorf("NormalizeLink() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package policy
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"devoratio.dev/web-resume/internal/errorx"
+)
+
+const (
+	PasswordMinLength = 10
+	// bcrypt silently ignores everything after 72 bytes, see hasher.GenerateFromPassword
+	PasswordMaxBytes = 72
+)
+
+const invalidPasswordMessage = "password does not meet the password policy"
+
+func ValidatePassword(password string) error {
+	var violation string
+
+	switch {
+	case strings.TrimSpace(password) == "":
+		violation = "must not be empty"
+	case utf8.RuneCountInString(password) < PasswordMinLength:
+		violation = "must be at least 10 characters long"
+	case len(password) > PasswordMaxBytes:
+		violation = "must not be longer than 72 bytes"
+	default:
+		return nil
+	}
+
+	err := errorx.New(errorx.TypeInvalidParameter, invalidPasswordMessage, nil)
+	err.Details = map[string]interface{}{"password": violation}
+	return err
+}
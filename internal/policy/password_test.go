@@ -0,0 +1,59 @@
+package policy
+
+import (
+	"strings"
+	"testing"
+
+	"devoratio.dev/web-resume/internal/errorx"
+)
+
+func TestValidatePassword(t *testing.T) {
+	tests := []struct {
+		name     string
+		password string
+		wantErr  bool
+	}{
+		{
+			name:     "empty password",
+			password: "",
+			wantErr:  true,
+		},
+		{
+			name:     "whitespace only password",
+			password: "            ",
+			wantErr:  true,
+		},
+		{
+			name:     "password shorter than minimum length",
+			password: "twinkling",
+			wantErr:  true,
+		},
+		{
+			name:     "password longer than 72 bytes",
+			password: strings.Repeat("a", 73),
+			wantErr:  true,
+		},
+		{
+			name:     "multibyte password within limits",
+			password: "パスワードはとても安全です",
+			wantErr:  false,
+		},
+		{
+			name:     "valid password",
+			password: "veryverysecurepassword",
+			wantErr:  false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidatePassword(tt.password)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidatePassword() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil && err.(*errorx.Error).Type != errorx.TypeInvalidParameter {
+				t.Errorf("ValidatePassword() error type = %v, want %v", err.(*errorx.Error).Type, errorx.TypeInvalidParameter)
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package policy
+
+import (
+	"net/mail"
+	"strings"
+
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/internal/identifier"
+	"devoratio.dev/web-resume/model"
+)
+
+const invalidOwnerMessage = "owner data is invalid"
+
+// ValidateRegistration checks the data a new owner is created with, the error
+// details are keyed by the JSON field names of the registration
+func ValidateRegistration(registration model.OwnerRegistration) error {
+	details := map[string]interface{}{}
+
+	if username := identifier.Normalize(registration.Username); username == "" {
+		details["username"] = "must not be empty"
+	} else if identifier.IsConfusable(username) {
+		details["username"] = "must only be letters of a single script, digits, dots, hyphens and underscores"
+	}
+	if strings.TrimSpace(registration.FirstName) == "" {
+		details["first_name"] = "must not be empty"
+	}
+	if strings.TrimSpace(registration.LastName) == "" {
+		details["last_name"] = "must not be empty"
+	}
+	email := identifier.Normalize(registration.Email)
+	if address, err := mail.ParseAddress(email); err != nil || address.Address != email {
+		details["email"] = "must be a valid email address"
+	}
+
+	if err := ValidatePassword(registration.Password); err != nil {
+		details["password"] = err.(*errorx.Error).Details["password"]
+	}
+
+	if len(details) > 0 {
+		err := errorx.New(errorx.TypeInvalidParameter, invalidOwnerMessage, nil)
+		err.Details = details
+		return err
+	}
+
+	return nil
+}
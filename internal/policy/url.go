@@ -0,0 +1,18 @@
+package policy
+
+import (
+	"net/url"
+	"strings"
+)
+
+// IsWebURL reports whether raw is an absolute http or https URL, the only kind
+// of link rendered on the resume
+func IsWebURL(raw string) bool {
+	parsed, err := url.Parse(raw)
+	if err != nil || parsed.Host == "" || parsed.User != nil {
+		return false
+	}
+
+	scheme := strings.ToLower(parsed.Scheme)
+	return scheme == "http" || scheme == "https"
+}
@@ -0,0 +1,19 @@
+package ratelimit
+
+import (
+	"fmt"
+
+	"devoratio.dev/web-resume/internal/identifier"
+)
+
+// OwnerKey is the key every attempt against an owner is limited on, so that
+// their username and email, in any spelling, share the same budget
+func OwnerKey(ownerID uint) string {
+	return fmt.Sprintf("owner:%d", ownerID)
+}
+
+// IdentifierKey is the key of attempts with an identifier matching no owner,
+// every spelling of it shares the same budget
+func IdentifierKey(loginIdentifier string) string {
+	return "identifier:" + identifier.Normalize(loginIdentifier)
+}
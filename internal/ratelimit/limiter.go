@@ -0,0 +1,68 @@
+package ratelimit
+
+import (
+	"sync"
+	"time"
+)
+
+// Limiter allows a fixed number of events per key within a time window. State
+// is kept in memory, so every instance of the service limits on its own.
+type Limiter struct {
+	mu        sync.Mutex
+	limit     int
+	window    time.Duration
+	buckets   map[string]*bucket
+	nextSweep time.Time
+	now       func() time.Time
+}
+
+type bucket struct {
+	count   int
+	resetAt time.Time
+}
+
+func New(limit int, window time.Duration) *Limiter {
+	return &Limiter{
+		limit:   limit,
+		window:  window,
+		buckets: map[string]*bucket{},
+		now:     time.Now,
+	}
+}
+
+// Allow records an event for key and reports whether it is within the limit
+func (l *Limiter) Allow(key string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.sweep(now)
+
+	b, found := l.buckets[key]
+	if !found || !now.Before(b.resetAt) {
+		b = &bucket{resetAt: now.Add(l.window)}
+		l.buckets[key] = b
+	}
+
+	if b.count >= l.limit {
+		return false
+	}
+
+	b.count++
+	return true
+}
+
+// sweep drops expired buckets once per window so keys that are never seen again
+// don't pile up
+func (l *Limiter) sweep(now time.Time) {
+	if now.Before(l.nextSweep) {
+		return
+	}
+
+	for key, b := range l.buckets {
+		if !now.Before(b.resetAt) {
+			delete(l.buckets, key)
+		}
+	}
+	l.nextSweep = now.Add(l.window)
+}
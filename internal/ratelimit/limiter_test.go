@@ -0,0 +1,52 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLimiter_Allow(t *testing.T) {
+	currentTime := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	limiter := New(2, time.Minute)
+	limiter.now = func() time.Time { return currentTime }
+
+	tests := []struct {
+		name    string
+		key     string
+		advance time.Duration
+		want    bool
+	}{
+		{name: "first event", key: "devoratio", want: true},
+		{name: "second event", key: "devoratio", want: true},
+		{name: "third event exceeds the limit", key: "devoratio", want: false},
+		{name: "other keys are limited separately", key: "someone-else", want: true},
+		{name: "still limited within the window", key: "devoratio", advance: 59 * time.Second, want: false},
+		{name: "allowed again after the window", key: "devoratio", advance: time.Second, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			currentTime = currentTime.Add(tt.advance)
+			if got := limiter.Allow(tt.key); got != tt.want {
+				t.Errorf("Limiter.Allow() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLimiter_sweep(t *testing.T) {
+	currentTime := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	limiter := New(1, time.Minute)
+	limiter.now = func() time.Time { return currentTime }
+
+	limiter.Allow("first")
+	limiter.Allow("second")
+
+	currentTime = currentTime.Add(2 * time.Minute)
+	limiter.Allow("third")
+
+	if len(limiter.buckets) != 1 {
+		t.Errorf("Limiter kept %d buckets, want 1", len(limiter.buckets))
+	}
+}
@@ -0,0 +1,24 @@
+package requestinfo
+
+import "context"
+
+// Info describes the client behind the request being served, so usecases can
+// record it without depending on the transport.
+type Info struct {
+	RequestID string
+	ClientIP  string
+	UserAgent string
+}
+
+type contextKey struct{}
+
+func WithInfo(ctx context.Context, info Info) context.Context {
+	return context.WithValue(ctx, contextKey{}, info)
+}
+
+// FromContext returns the request info, or an empty Info outside of a request
+// such as in command line tools
+func FromContext(ctx context.Context) Info {
+	info, _ := ctx.Value(contextKey{}).(Info)
+	return info
+}
@@ -0,0 +1,36 @@
+// Package schedule runs the background jobs of the service.
+package schedule
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// Every runs job right away and then every interval until ctx is done. A
+// failing run is logged and doesn't stop the following ones. A job with an
+// interval of zero never runs.
+func Every(ctx context.Context, name string, interval time.Duration, job func(context.Context) error) {
+	if interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := job(ctx); err != nil {
+			log.Printf("failed to run %s: %s", name, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			// A tick may be picked even though ctx is done as well
+			if ctx.Err() != nil {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package schedule
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestEvery(t *testing.T) {
+	tests := []struct {
+		name     string
+		interval time.Duration
+		err      error
+		wantRuns int
+	}{
+		{
+			name:     "run until the context is done",
+			interval: time.Millisecond,
+			wantRuns: 3,
+		},
+		{
+			name:     "keep running after a failure",
+			interval: time.Millisecond,
+			err:      errors.New("mail server unavailable"),
+			wantRuns: 3,
+		},
+		{
+			name:     "never run without an interval",
+			interval: 0,
+			wantRuns: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			runs := 0
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				Every(ctx, "test job", tt.interval, func(context.Context) error {
+					runs++
+					if runs == tt.wantRuns {
+						cancel()
+					}
+					return tt.err
+				})
+			}()
+
+			select {
+			case <-done:
+			case <-time.After(time.Second):
+				t.Fatal("Every() did not return once the context was done")
+			}
+			if runs != tt.wantRuns {
+				t.Errorf("Every() ran the job %d times, want %d", runs, tt.wantRuns)
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package tenancy
+
+import (
+	"context"
+
+	"devoratio.dev/web-resume/model"
+	"gorm.io/gorm"
+)
+
+type contextKey struct{}
+
+// WithTenant attaches the tenant the request was addressed to
+func WithTenant(ctx context.Context, tenant *model.Tenant) context.Context {
+	return context.WithValue(ctx, contextKey{}, tenant)
+}
+
+// FromContext returns the tenant the request was addressed to, if any. There is
+// none when tenancy is disabled or the request came in on the base domain.
+func FromContext(ctx context.Context) (*model.Tenant, bool) {
+	tenant, ok := ctx.Value(contextKey{}).(*model.Tenant)
+	return tenant, ok && tenant != nil
+}
+
+// ID returns the ID of the tenant in ctx, or zero without one
+func ID(ctx context.Context) uint {
+	if tenant, ok := FromContext(ctx); ok {
+		return tenant.ID
+	}
+
+	return 0
+}
+
+// Scope limits a query to the owner of the tenant in ctx, column being the
+// column holding the owner ID. Queries are left untouched without a tenant.
+func Scope(ctx context.Context, column string) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		tenant, ok := FromContext(ctx)
+		if !ok {
+			return db
+		}
+
+		return db.Where(column+" = ?", tenant.OwnerID)
+	}
+}
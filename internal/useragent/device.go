@@ -0,0 +1,53 @@
+package useragent
+
+import "strings"
+
+const unknown = "Unknown"
+
+// markers are checked in order, since most user agents also claim to be the
+// products they were derived from
+var (
+	browsers = []struct{ marker, name string }{
+		{"Edg/", "Edge"},
+		{"OPR/", "Opera"},
+		{"Firefox/", "Firefox"},
+		{"Chrome/", "Chrome"},
+		{"Safari/", "Safari"},
+		{"curl/", "curl"},
+	}
+	systems = []struct{ marker, name string }{
+		{"iPhone", "iOS"},
+		{"iPad", "iPadOS"},
+		{"Android", "Android"},
+		{"Windows", "Windows"},
+		{"Mac OS X", "macOS"},
+		{"CrOS", "ChromeOS"},
+		{"Linux", "Linux"},
+	}
+)
+
+// DeviceName gives a human readable name of the device behind a user agent,
+// such as "Firefox on Linux", good enough for the owner to recognize it.
+func DeviceName(userAgent string) string {
+	browser := match(userAgent, browsers)
+	system := match(userAgent, systems)
+
+	switch {
+	case browser == unknown && system == unknown:
+		return "Unknown device"
+	case system == unknown:
+		return browser
+	default:
+		return browser + " on " + system
+	}
+}
+
+func match(userAgent string, candidates []struct{ marker, name string }) string {
+	for _, candidate := range candidates {
+		if strings.Contains(userAgent, candidate.marker) {
+			return candidate.name
+		}
+	}
+
+	return unknown
+}
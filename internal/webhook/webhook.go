@@ -0,0 +1,88 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"devoratio.dev/web-resume/config"
+	"devoratio.dev/web-resume/internal/errorx"
+)
+
+// SignatureHeader carries the hex encoded HMAC-SHA256 of the request body,
+// keyed with the configured secret and prefixed with "sha256=".
+const SignatureHeader = "X-Webresume-Signature"
+
+const defaultTimeout = 10 * time.Second
+
+type event struct {
+	Event  string      `json:"event"`
+	Data   interface{} `json:"data"`
+	SentAt time.Time   `json:"sent_at"`
+}
+
+type Client struct {
+	url    string
+	secret []byte
+	client *http.Client
+}
+
+func New(webhookConfig config.Webhook) *Client {
+	timeout := webhookConfig.Timeout
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+
+	return &Client{
+		url:    webhookConfig.URL,
+		secret: []byte(webhookConfig.Secret),
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+// Send posts data as the named event. Any response other than 2xx is reported
+// as a bad gateway.
+func (c *Client) Send(ctx context.Context, name string, data interface{}) error {
+	if c.url == "" {
+		return errorx.Errorf("webhook url is not configured")
+	}
+
+	body, err := json.Marshal(event{Event: name, Data: data, SentAt: time.Now().UTC()})
+	if err != nil {
+		return errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), err)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, bytes.NewReader(body))
+	if err != nil {
+		return errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), err)
+	}
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set(SignatureHeader, "sha256="+Sign(c.secret, body))
+
+	response, err := c.client.Do(request)
+	if err != nil {
+		return errorx.New(errorx.TypeBadGateway, errorx.TypeBadGateway.String(), err)
+	}
+	defer response.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(response.Body, 64<<10))
+
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return errorx.New(errorx.TypeBadGateway, errorx.TypeBadGateway.String(),
+			errorx.Errorf("webhook responded with status %d", response.StatusCode))
+	}
+
+	return nil
+}
+
+// Sign computes the signature receivers use to check a request came from us
+func Sign(secret, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,56 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"devoratio.dev/web-resume/config"
+)
+
+func TestClient_Send(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		wantErr bool
+	}{
+		{name: "accepted", status: http.StatusNoContent},
+		{name: "rejected", status: http.StatusInternalServerError, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				if got, want := r.Header.Get(SignatureHeader), "sha256="+Sign([]byte("secret"), body); got != want {
+					t.Errorf("%s = %q, want %q", SignatureHeader, got, want)
+				}
+
+				var received event
+				if err := json.Unmarshal(body, &received); err != nil {
+					t.Errorf("failed to decode body: %v", err)
+				}
+				if received.Event != "login.unfamiliar" {
+					t.Errorf("event = %q, want %q", received.Event, "login.unfamiliar")
+				}
+
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			client := New(config.Webhook{URL: server.URL, Secret: "secret"})
+			err := client.Send(context.Background(), "login.unfamiliar", map[string]string{"username": "devoratio"})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Send() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestClient_Send_NotConfigured(t *testing.T) {
+	if err := New(config.Webhook{}).Send(context.Background(), "login.unfamiliar", nil); err == nil {
+		t.Errorf("Send() error = nil, want error")
+	}
+}
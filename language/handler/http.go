@@ -0,0 +1,148 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/internal/httpx"
+	"devoratio.dev/web-resume/model"
+)
+
+type LanguageUsecase interface {
+	Create(ctx context.Context, claim model.Claim, request model.LanguageRequest) (*model.Language, error)
+	Get(ctx context.Context, claim model.Claim, languageID uint) (*model.Language, error)
+	List(ctx context.Context, claim model.Claim) ([]model.Language, error)
+	Update(ctx context.Context, claim model.Claim, languageID uint, request model.LanguageRequest) (*model.Language, error)
+	Delete(ctx context.Context, claim model.Claim, languageID uint) error
+}
+
+type HTTP struct {
+	languageUsecase LanguageUsecase
+}
+
+func NewHTTP(languageUsecase LanguageUsecase) *HTTP {
+	return &HTTP{
+		languageUsecase: languageUsecase,
+	}
+}
+
+// RegisterRoutes registers the language routes, read is required to get the
+// languages and write to change them
+func (h *HTTP) RegisterRoutes(mux *http.ServeMux, read, write httpx.Middleware) {
+	mux.Handle("GET /v1/languages", read(http.HandlerFunc(h.list)))
+	mux.Handle("POST /v1/languages", write(http.HandlerFunc(h.create)))
+	mux.Handle("GET /v1/languages/{id}", read(http.HandlerFunc(h.get)))
+	mux.Handle("PUT /v1/languages/{id}", write(http.HandlerFunc(h.update)))
+	mux.Handle("DELETE /v1/languages/{id}", write(http.HandlerFunc(h.delete)))
+}
+
+func (h *HTTP) list(w http.ResponseWriter, r *http.Request) {
+	claim, ok := httpx.ClaimFromContext(r.Context())
+	if !ok {
+		httpx.WriteError(w, errorx.ErrUnauthorized)
+		return
+	}
+
+	languages, err := h.languageUsecase.List(r.Context(), *claim)
+	if err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, http.StatusOK, languages)
+}
+
+func (h *HTTP) create(w http.ResponseWriter, r *http.Request) {
+	claim, ok := httpx.ClaimFromContext(r.Context())
+	if !ok {
+		httpx.WriteError(w, errorx.ErrUnauthorized)
+		return
+	}
+
+	var request model.LanguageRequest
+	if err := httpx.DecodeJSON(w, r, &request); err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	language, err := h.languageUsecase.Create(r.Context(), *claim, request)
+	if err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, http.StatusCreated, language)
+}
+
+func (h *HTTP) get(w http.ResponseWriter, r *http.Request) {
+	claim, ok := httpx.ClaimFromContext(r.Context())
+	if !ok {
+		httpx.WriteError(w, errorx.ErrUnauthorized)
+		return
+	}
+
+	languageID, err := strconv.ParseUint(r.PathValue("id"), 10, 0)
+	if err != nil {
+		httpx.WriteError(w, errorx.ErrNotFound)
+		return
+	}
+
+	language, err := h.languageUsecase.Get(r.Context(), *claim, uint(languageID))
+	if err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, http.StatusOK, language)
+}
+
+func (h *HTTP) update(w http.ResponseWriter, r *http.Request) {
+	claim, ok := httpx.ClaimFromContext(r.Context())
+	if !ok {
+		httpx.WriteError(w, errorx.ErrUnauthorized)
+		return
+	}
+
+	languageID, err := strconv.ParseUint(r.PathValue("id"), 10, 0)
+	if err != nil {
+		httpx.WriteError(w, errorx.ErrNotFound)
+		return
+	}
+
+	var request model.LanguageRequest
+	if err := httpx.DecodeJSON(w, r, &request); err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	language, err := h.languageUsecase.Update(r.Context(), *claim, uint(languageID), request)
+	if err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, http.StatusOK, language)
+}
+
+func (h *HTTP) delete(w http.ResponseWriter, r *http.Request) {
+	claim, ok := httpx.ClaimFromContext(r.Context())
+	if !ok {
+		httpx.WriteError(w, errorx.ErrUnauthorized)
+		return
+	}
+
+	languageID, err := strconv.ParseUint(r.PathValue("id"), 10, 0)
+	if err != nil {
+		httpx.WriteError(w, errorx.ErrNotFound)
+		return
+	}
+
+	if err := h.languageUsecase.Delete(r.Context(), *claim, uint(languageID)); err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	httpx.WriteNoContent(w)
+}
@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/model"
+	"gorm.io/gorm"
+)
+
+type PostgreSQLDatabase struct {
+	db *gorm.DB
+}
+
+func NewPostgreSQL(db *gorm.DB) *PostgreSQLDatabase {
+	return &PostgreSQLDatabase{
+		db: db,
+	}
+}
+
+func (p *PostgreSQLDatabase) CreateLanguage(ctx context.Context, language *model.Language) error {
+	if err := p.db.WithContext(ctx).Create(language).Error; err != nil {
+		return errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), err)
+	}
+
+	return nil
+}
+
+func (p *PostgreSQLDatabase) GetLanguage(ctx context.Context, ownerID, languageID uint) (*model.Language, error) {
+	language := &model.Language{}
+	result := p.db.WithContext(ctx).Where("id = ? AND owner_id = ?", languageID, ownerID).First(language)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, errorx.ErrNotFound
+		}
+		return nil, errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+
+	return language, nil
+}
+
+func (p *PostgreSQLDatabase) ListLanguages(ctx context.Context, ownerID uint) ([]model.Language, error) {
+	var languages []model.Language
+	result := p.db.WithContext(ctx).Where("owner_id = ?", ownerID).Order("id").Find(&languages)
+	if result.Error != nil {
+		return nil, errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+
+	return languages, nil
+}
+
+func (p *PostgreSQLDatabase) UpdateLanguage(ctx context.Context, language *model.Language) error {
+	result := p.db.WithContext(ctx).Model(language).
+		Where("owner_id = ?", language.OwnerID).
+		Select("*").Omit("id", "owner_id", "created_at").
+		Updates(language)
+	if result.Error != nil {
+		return errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return errorx.ErrNotFound
+	}
+
+	return nil
+}
+
+func (p *PostgreSQLDatabase) DeleteLanguage(ctx context.Context, ownerID, languageID uint) error {
+	result := p.db.WithContext(ctx).Where("id = ? AND owner_id = ?", languageID, ownerID).Delete(&model.Language{})
+	if result.Error != nil {
+		return errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return errorx.ErrNotFound
+	}
+
+	return nil
+}
+
+func (p *PostgreSQLDatabase) LanguageNameTaken(ctx context.Context, ownerID uint, name string, exceptLanguageID uint) (bool, error) {
+	var count int64
+	result := p.db.WithContext(ctx).Model(&model.Language{}).
+		Where("owner_id = ? AND lower(name) = lower(?) AND id <> ?", ownerID, name, exceptLanguageID).
+		Count(&count)
+	if result.Error != nil {
+		return false, errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+
+	return count > 0, nil
+}
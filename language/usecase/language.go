@@ -0,0 +1,148 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"devoratio.dev/web-resume/internal/authz"
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/model"
+)
+
+const (
+	invalidLanguageMessage = "language is invalid"
+
+	maxNameLength = 100
+)
+
+//go:generate mockgen -destination=repositorymock/postgresqlmock.go -package=repositorymock . LanguageRepository
+type LanguageRepository interface {
+	CreateLanguage(ctx context.Context, language *model.Language) error
+	GetLanguage(ctx context.Context, ownerID, languageID uint) (*model.Language, error)
+	ListLanguages(ctx context.Context, ownerID uint) ([]model.Language, error)
+	UpdateLanguage(ctx context.Context, language *model.Language) error
+	DeleteLanguage(ctx context.Context, ownerID, languageID uint) error
+	// LanguageNameTaken reports whether the owner has a language other than
+	// exceptLanguageID with name, ignoring case
+	LanguageNameTaken(ctx context.Context, ownerID uint, name string, exceptLanguageID uint) (bool, error)
+}
+
+type Language struct {
+	languageRepo LanguageRepository
+}
+
+func NewUsecase(languageRepo LanguageRepository) *Language {
+	return &Language{
+		languageRepo: languageRepo,
+	}
+}
+
+func (l *Language) Create(ctx context.Context, claim model.Claim, request model.LanguageRequest) (*model.Language, error) {
+	if err := authz.RequireScope(claim, model.ScopeResumeWrite); err != nil {
+		return nil, err
+	}
+
+	language := &model.Language{OwnerID: claim.UserID}
+	if err := l.apply(ctx, language, request); err != nil {
+		return nil, err
+	}
+
+	if err := l.languageRepo.CreateLanguage(ctx, language); err != nil {
+		return nil, err
+	}
+
+	return language, nil
+}
+
+func (l *Language) Get(ctx context.Context, claim model.Claim, languageID uint) (*model.Language, error) {
+	return l.languageRepo.GetLanguage(ctx, claim.UserID, languageID)
+}
+
+// List returns the languages of the owner, the best spoken first
+func (l *Language) List(ctx context.Context, claim model.Claim) ([]model.Language, error) {
+	languages, err := l.languageRepo.ListLanguages(ctx, claim.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	Sort(languages)
+	return languages, nil
+}
+
+func (l *Language) Update(ctx context.Context, claim model.Claim, languageID uint, request model.LanguageRequest) (*model.Language, error) {
+	if err := authz.RequireScope(claim, model.ScopeResumeWrite); err != nil {
+		return nil, err
+	}
+
+	language, err := l.languageRepo.GetLanguage(ctx, claim.UserID, languageID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := l.apply(ctx, language, request); err != nil {
+		return nil, err
+	}
+
+	if err := l.languageRepo.UpdateLanguage(ctx, language); err != nil {
+		return nil, err
+	}
+
+	return language, nil
+}
+
+func (l *Language) Delete(ctx context.Context, claim model.Claim, languageID uint) error {
+	if err := authz.RequireScope(claim, model.ScopeResumeWrite); err != nil {
+		return err
+	}
+
+	return l.languageRepo.DeleteLanguage(ctx, claim.UserID, languageID)
+}
+
+// Sort orders languages from the highest level to the lowest, then by name
+func Sort(languages []model.Language) {
+	slices.SortStableFunc(languages, func(a, b model.Language) int {
+		if byLevel := slices.Index(model.LanguageLevels, b.Level) - slices.Index(model.LanguageLevels, a.Level); byLevel != 0 {
+			return byLevel
+		}
+		return strings.Compare(strings.ToLower(a.Name), strings.ToLower(b.Name))
+	})
+}
+
+// apply validates request and copies it onto language
+func (l *Language) apply(ctx context.Context, language *model.Language, request model.LanguageRequest) error {
+	language.Name = strings.TrimSpace(request.Name)
+	language.Level = request.Level
+	language.UpdatedAt = time.Now()
+
+	details := map[string]interface{}{}
+	if language.Name == "" {
+		details["name"] = "must not be empty"
+	} else if utf8.RuneCountInString(language.Name) > maxNameLength {
+		details["name"] = fmt.Sprintf("must not be longer than %d characters", maxNameLength)
+	}
+	if !slices.Contains(model.LanguageLevels, language.Level) {
+		details["level"] = "must be one of A1, A2, B1, B2, C1, C2, native"
+	}
+
+	if _, invalid := details["name"]; !invalid {
+		taken, err := l.languageRepo.LanguageNameTaken(ctx, language.OwnerID, language.Name, language.ID)
+		if err != nil {
+			return err
+		}
+		if taken {
+			details["name"] = "is already listed"
+		}
+	}
+
+	if len(details) > 0 {
+		err := errorx.New(errorx.TypeInvalidParameter, invalidLanguageMessage, nil)
+		err.Details = details
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,95 @@
+package usecase_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/language/usecase"
+	"devoratio.dev/web-resume/language/usecase/repositorymock"
+	"devoratio.dev/web-resume/model"
+)
+
+var _ = Describe("Language", Label("language"), func() {
+	var (
+		mockController *gomock.Controller
+
+		languageRepoMock *repositorymock.MockLanguageRepository
+
+		languageUsecase *usecase.Language
+		commonCtx       context.Context
+		claim           model.Claim
+		request         model.LanguageRequest
+	)
+
+	BeforeEach(func() {
+		mockController = gomock.NewController(GinkgoT())
+
+		languageRepoMock = repositorymock.NewMockLanguageRepository(mockController)
+
+		languageUsecase = usecase.NewUsecase(languageRepoMock)
+
+		claim = model.Claim{UserID: 1, Username: "devoratio", Scopes: []string{model.ScopeResumeWrite}}
+		request = model.LanguageRequest{Name: " Spanish ", Level: model.LanguageB2}
+
+		commonCtx = context.Background()
+	})
+
+	AfterEach(func() {
+		mockController.Finish()
+	})
+
+	Describe("Add a language", func() {
+		When("the level is not a CEFR level", func() {
+			It("tells the owner which levels there are", func(ctx SpecContext) {
+				request.Level = "fluent"
+				languageRepoMock.EXPECT().LanguageNameTaken(commonCtx, claim.UserID, "Spanish", uint(0)).Return(false, nil).Times(1)
+
+				result, err := languageUsecase.Create(commonCtx, claim, request)
+				Expect(err.(*errorx.Error).Type).Should(Equal(errorx.TypeInvalidParameter))
+				Expect(err.(*errorx.Error).Details).Should(HaveKeyWithValue("level", "must be one of A1, A2, B1, B2, C1, C2, native"))
+				Expect(result).Should(BeNil())
+			}, SpecTimeout(time.Second*2))
+		})
+
+		When("the language is already listed", func() {
+			It("refuses to list it twice", func(ctx SpecContext) {
+				languageRepoMock.EXPECT().LanguageNameTaken(commonCtx, claim.UserID, "Spanish", uint(0)).Return(true, nil).Times(1)
+
+				_, err := languageUsecase.Create(commonCtx, claim, request)
+				Expect(err.(*errorx.Error).Details).Should(HaveKeyWithValue("name", "is already listed"))
+			}, SpecTimeout(time.Second*2))
+		})
+
+		When("the language is valid", func() {
+			It("stores it for the owner", func(ctx SpecContext) {
+				languageRepoMock.EXPECT().LanguageNameTaken(commonCtx, claim.UserID, "Spanish", uint(0)).Return(false, nil).Times(1)
+				languageRepoMock.EXPECT().CreateLanguage(commonCtx, gomock.Any()).Return(nil).Times(1)
+
+				result, err := languageUsecase.Create(commonCtx, claim, request)
+				Expect(err).Should(BeNil())
+				Expect(result.OwnerID).Should(Equal(claim.UserID))
+				Expect(result.Name).Should(Equal("Spanish"))
+			}, SpecTimeout(time.Second*2))
+		})
+	})
+
+	Describe("List the languages", func() {
+		It("lists the best spoken first", func(ctx SpecContext) {
+			languageRepoMock.EXPECT().ListLanguages(commonCtx, claim.UserID).Return([]model.Language{
+				{ID: 1, Name: "German", Level: model.LanguageA2},
+				{ID: 2, Name: "spanish", Level: model.LanguageC1},
+				{ID: 3, Name: "French", Level: model.LanguageNative},
+				{ID: 4, Name: "Italian", Level: model.LanguageC1},
+			}, nil).Times(1)
+
+			result, err := languageUsecase.List(commonCtx, claim)
+			Expect(err).Should(BeNil())
+			Expect([]uint{result[0].ID, result[1].ID, result[2].ID, result[3].ID}).Should(Equal([]uint{3, 4, 2, 1}))
+		}, SpecTimeout(time.Second*2))
+	})
+})
@@ -0,0 +1,123 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: devoratio.dev/web-resume/language/usecase (interfaces: LanguageRepository)
+
+// Package repositorymock is a generated GoMock package.
+package repositorymock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "devoratio.dev/web-resume/model"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockLanguageRepository is a mock of LanguageRepository interface.
+type MockLanguageRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLanguageRepositoryMockRecorder
+}
+
+// MockLanguageRepositoryMockRecorder is the mock recorder for MockLanguageRepository.
+type MockLanguageRepositoryMockRecorder struct {
+	mock *MockLanguageRepository
+}
+
+// NewMockLanguageRepository creates a new mock instance.
+func NewMockLanguageRepository(ctrl *gomock.Controller) *MockLanguageRepository {
+	mock := &MockLanguageRepository{ctrl: ctrl}
+	mock.recorder = &MockLanguageRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLanguageRepository) EXPECT() *MockLanguageRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateLanguage mocks base method.
+func (m *MockLanguageRepository) CreateLanguage(arg0 context.Context, arg1 *model.Language) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLanguage", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateLanguage indicates an expected call of CreateLanguage.
+func (mr *MockLanguageRepositoryMockRecorder) CreateLanguage(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLanguage", reflect.TypeOf((*MockLanguageRepository)(nil).CreateLanguage), arg0, arg1)
+}
+
+// DeleteLanguage mocks base method.
+func (m *MockLanguageRepository) DeleteLanguage(arg0 context.Context, arg1, arg2 uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLanguage", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteLanguage indicates an expected call of DeleteLanguage.
+func (mr *MockLanguageRepositoryMockRecorder) DeleteLanguage(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLanguage", reflect.TypeOf((*MockLanguageRepository)(nil).DeleteLanguage), arg0, arg1, arg2)
+}
+
+// GetLanguage mocks base method.
+func (m *MockLanguageRepository) GetLanguage(arg0 context.Context, arg1, arg2 uint) (*model.Language, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLanguage", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*model.Language)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLanguage indicates an expected call of GetLanguage.
+func (mr *MockLanguageRepositoryMockRecorder) GetLanguage(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLanguage", reflect.TypeOf((*MockLanguageRepository)(nil).GetLanguage), arg0, arg1, arg2)
+}
+
+// LanguageNameTaken mocks base method.
+func (m *MockLanguageRepository) LanguageNameTaken(arg0 context.Context, arg1 uint, arg2 string, arg3 uint) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LanguageNameTaken", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LanguageNameTaken indicates an expected call of LanguageNameTaken.
+func (mr *MockLanguageRepositoryMockRecorder) LanguageNameTaken(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LanguageNameTaken", reflect.TypeOf((*MockLanguageRepository)(nil).LanguageNameTaken), arg0, arg1, arg2, arg3)
+}
+
+// ListLanguages mocks base method.
+func (m *MockLanguageRepository) ListLanguages(arg0 context.Context, arg1 uint) ([]model.Language, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLanguages", arg0, arg1)
+	ret0, _ := ret[0].([]model.Language)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLanguages indicates an expected call of ListLanguages.
+func (mr *MockLanguageRepositoryMockRecorder) ListLanguages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLanguages", reflect.TypeOf((*MockLanguageRepository)(nil).ListLanguages), arg0, arg1)
+}
+
+// UpdateLanguage mocks base method.
+func (m *MockLanguageRepository) UpdateLanguage(arg0 context.Context, arg1 *model.Language) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLanguage", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateLanguage indicates an expected call of UpdateLanguage.
+func (mr *MockLanguageRepositoryMockRecorder) UpdateLanguage(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLanguage", reflect.TypeOf((*MockLanguageRepository)(nil).UpdateLanguage), arg0, arg1)
+}
@@ -0,0 +1,148 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/internal/httpx"
+	"devoratio.dev/web-resume/model"
+)
+
+type LinkUsecase interface {
+	Create(ctx context.Context, claim model.Claim, request model.LinkRequest) (*model.Link, error)
+	Get(ctx context.Context, claim model.Claim, linkID uint) (*model.Link, error)
+	List(ctx context.Context, claim model.Claim) ([]model.Link, error)
+	Update(ctx context.Context, claim model.Claim, linkID uint, request model.LinkRequest) (*model.Link, error)
+	Delete(ctx context.Context, claim model.Claim, linkID uint) error
+}
+
+type HTTP struct {
+	linkUsecase LinkUsecase
+}
+
+func NewHTTP(linkUsecase LinkUsecase) *HTTP {
+	return &HTTP{
+		linkUsecase: linkUsecase,
+	}
+}
+
+// RegisterRoutes registers the link routes, read is required to get the
+// links and write to change them
+func (h *HTTP) RegisterRoutes(mux *http.ServeMux, read, write httpx.Middleware) {
+	mux.Handle("GET /v1/links", read(http.HandlerFunc(h.list)))
+	mux.Handle("POST /v1/links", write(http.HandlerFunc(h.create)))
+	mux.Handle("GET /v1/links/{id}", read(http.HandlerFunc(h.get)))
+	mux.Handle("PUT /v1/links/{id}", write(http.HandlerFunc(h.update)))
+	mux.Handle("DELETE /v1/links/{id}", write(http.HandlerFunc(h.delete)))
+}
+
+func (h *HTTP) list(w http.ResponseWriter, r *http.Request) {
+	claim, ok := httpx.ClaimFromContext(r.Context())
+	if !ok {
+		httpx.WriteError(w, errorx.ErrUnauthorized)
+		return
+	}
+
+	links, err := h.linkUsecase.List(r.Context(), *claim)
+	if err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, http.StatusOK, links)
+}
+
+func (h *HTTP) create(w http.ResponseWriter, r *http.Request) {
+	claim, ok := httpx.ClaimFromContext(r.Context())
+	if !ok {
+		httpx.WriteError(w, errorx.ErrUnauthorized)
+		return
+	}
+
+	var request model.LinkRequest
+	if err := httpx.DecodeJSON(w, r, &request); err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	link, err := h.linkUsecase.Create(r.Context(), *claim, request)
+	if err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, http.StatusCreated, link)
+}
+
+func (h *HTTP) get(w http.ResponseWriter, r *http.Request) {
+	claim, ok := httpx.ClaimFromContext(r.Context())
+	if !ok {
+		httpx.WriteError(w, errorx.ErrUnauthorized)
+		return
+	}
+
+	linkID, err := strconv.ParseUint(r.PathValue("id"), 10, 0)
+	if err != nil {
+		httpx.WriteError(w, errorx.ErrNotFound)
+		return
+	}
+
+	link, err := h.linkUsecase.Get(r.Context(), *claim, uint(linkID))
+	if err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, http.StatusOK, link)
+}
+
+func (h *HTTP) update(w http.ResponseWriter, r *http.Request) {
+	claim, ok := httpx.ClaimFromContext(r.Context())
+	if !ok {
+		httpx.WriteError(w, errorx.ErrUnauthorized)
+		return
+	}
+
+	linkID, err := strconv.ParseUint(r.PathValue("id"), 10, 0)
+	if err != nil {
+		httpx.WriteError(w, errorx.ErrNotFound)
+		return
+	}
+
+	var request model.LinkRequest
+	if err := httpx.DecodeJSON(w, r, &request); err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	link, err := h.linkUsecase.Update(r.Context(), *claim, uint(linkID), request)
+	if err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, http.StatusOK, link)
+}
+
+func (h *HTTP) delete(w http.ResponseWriter, r *http.Request) {
+	claim, ok := httpx.ClaimFromContext(r.Context())
+	if !ok {
+		httpx.WriteError(w, errorx.ErrUnauthorized)
+		return
+	}
+
+	linkID, err := strconv.ParseUint(r.PathValue("id"), 10, 0)
+	if err != nil {
+		httpx.WriteError(w, errorx.ErrNotFound)
+		return
+	}
+
+	if err := h.linkUsecase.Delete(r.Context(), *claim, uint(linkID)); err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	httpx.WriteNoContent(w)
+}
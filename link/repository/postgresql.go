@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/model"
+	"gorm.io/gorm"
+)
+
+type PostgreSQLDatabase struct {
+	db *gorm.DB
+}
+
+func NewPostgreSQL(db *gorm.DB) *PostgreSQLDatabase {
+	return &PostgreSQLDatabase{
+		db: db,
+	}
+}
+
+func (p *PostgreSQLDatabase) CreateLink(ctx context.Context, link *model.Link) error {
+	if err := p.db.WithContext(ctx).Create(link).Error; err != nil {
+		return errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), err)
+	}
+
+	return nil
+}
+
+func (p *PostgreSQLDatabase) GetLink(ctx context.Context, ownerID, linkID uint) (*model.Link, error) {
+	link := &model.Link{}
+	result := p.db.WithContext(ctx).Where("id = ? AND owner_id = ?", linkID, ownerID).First(link)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, errorx.ErrNotFound
+		}
+		return nil, errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+
+	return link, nil
+}
+
+func (p *PostgreSQLDatabase) ListLinks(ctx context.Context, ownerID uint) ([]model.Link, error) {
+	var links []model.Link
+	result := p.db.WithContext(ctx).Where("owner_id = ?", ownerID).Order("id").Find(&links)
+	if result.Error != nil {
+		return nil, errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+
+	return links, nil
+}
+
+func (p *PostgreSQLDatabase) UpdateLink(ctx context.Context, link *model.Link) error {
+	result := p.db.WithContext(ctx).Model(link).
+		Where("owner_id = ?", link.OwnerID).
+		Select("*").Omit("id", "owner_id", "created_at").
+		Updates(link)
+	if result.Error != nil {
+		return errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return errorx.ErrNotFound
+	}
+
+	return nil
+}
+
+func (p *PostgreSQLDatabase) DeleteLink(ctx context.Context, ownerID, linkID uint) error {
+	result := p.db.WithContext(ctx).Where("id = ? AND owner_id = ?", linkID, ownerID).Delete(&model.Link{})
+	if result.Error != nil {
+		return errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return errorx.ErrNotFound
+	}
+
+	return nil
+}
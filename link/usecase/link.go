@@ -0,0 +1,151 @@
+package usecase
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"devoratio.dev/web-resume/internal/authz"
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/internal/policy"
+	"devoratio.dev/web-resume/model"
+)
+
+const (
+	invalidLinkMessage = "link is invalid"
+
+	maxLabelLength = 100
+)
+
+// valueMessages tell the owner what a link of each type is expected to be
+var valueMessages = map[model.LinkType]string{
+	model.LinkGitHub:   "must be a GitHub username or profile URL",
+	model.LinkLinkedIn: "must be a LinkedIn profile URL",
+	model.LinkMastodon: "must be a Mastodon handle such as @name@server or a profile URL",
+	model.LinkWebsite:  "must be an http or https URL",
+	model.LinkEmail:    "must be an email address",
+}
+
+//go:generate mockgen -destination=repositorymock/postgresqlmock.go -package=repositorymock . LinkRepository
+type LinkRepository interface {
+	CreateLink(ctx context.Context, link *model.Link) error
+	GetLink(ctx context.Context, ownerID, linkID uint) (*model.Link, error)
+	ListLinks(ctx context.Context, ownerID uint) ([]model.Link, error)
+	UpdateLink(ctx context.Context, link *model.Link) error
+	DeleteLink(ctx context.Context, ownerID, linkID uint) error
+}
+
+type Link struct {
+	linkRepo LinkRepository
+}
+
+func NewUsecase(linkRepo LinkRepository) *Link {
+	return &Link{
+		linkRepo: linkRepo,
+	}
+}
+
+func (l *Link) Create(ctx context.Context, claim model.Claim, request model.LinkRequest) (*model.Link, error) {
+	if err := authz.RequireScope(claim, model.ScopeResumeWrite); err != nil {
+		return nil, err
+	}
+
+	link := &model.Link{OwnerID: claim.UserID}
+	if err := apply(link, request); err != nil {
+		return nil, err
+	}
+
+	if err := l.linkRepo.CreateLink(ctx, link); err != nil {
+		return nil, err
+	}
+
+	return link, nil
+}
+
+func (l *Link) Get(ctx context.Context, claim model.Claim, linkID uint) (*model.Link, error) {
+	return l.linkRepo.GetLink(ctx, claim.UserID, linkID)
+}
+
+// List returns the links of the owner, public or not, grouped by type
+func (l *Link) List(ctx context.Context, claim model.Claim) ([]model.Link, error) {
+	links, err := l.linkRepo.ListLinks(ctx, claim.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	Sort(links)
+	return links, nil
+}
+
+func (l *Link) Update(ctx context.Context, claim model.Claim, linkID uint, request model.LinkRequest) (*model.Link, error) {
+	if err := authz.RequireScope(claim, model.ScopeResumeWrite); err != nil {
+		return nil, err
+	}
+
+	link, err := l.linkRepo.GetLink(ctx, claim.UserID, linkID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := apply(link, request); err != nil {
+		return nil, err
+	}
+
+	if err := l.linkRepo.UpdateLink(ctx, link); err != nil {
+		return nil, err
+	}
+
+	return link, nil
+}
+
+func (l *Link) Delete(ctx context.Context, claim model.Claim, linkID uint) error {
+	if err := authz.RequireScope(claim, model.ScopeResumeWrite); err != nil {
+		return err
+	}
+
+	return l.linkRepo.DeleteLink(ctx, claim.UserID, linkID)
+}
+
+// Sort orders links by type as listed in model.LinkTypes, then in the order
+// they were added
+func Sort(links []model.Link) {
+	slices.SortStableFunc(links, func(a, b model.Link) int {
+		if byType := slices.Index(model.LinkTypes, a.Type) - slices.Index(model.LinkTypes, b.Type); byType != 0 {
+			return byType
+		}
+		return cmp.Compare(a.ID, b.ID)
+	})
+}
+
+// apply validates request and copies it onto link, the value being stored
+// normalized
+func apply(link *model.Link, request model.LinkRequest) error {
+	link.Type = request.Type
+	link.Label = strings.TrimSpace(request.Label)
+	link.Public = request.Public
+	link.UpdatedAt = time.Now()
+
+	details := map[string]interface{}{}
+	if message, known := valueMessages[link.Type]; !known {
+		details["type"] = "must be one of github, linkedin, mastodon, website, email"
+	} else if value, ok := policy.NormalizeLink(link.Type, request.Value); !ok {
+		details["value"] = message
+	} else {
+		link.Value = value
+	}
+	if utf8.RuneCountInString(link.Label) > maxLabelLength {
+		details["label"] = fmt.Sprintf("must not be longer than %d characters", maxLabelLength)
+	}
+
+	if len(details) > 0 {
+		err := errorx.New(errorx.TypeInvalidParameter, invalidLinkMessage, nil)
+		err.Details = details
+		return err
+	}
+
+	return nil
+}
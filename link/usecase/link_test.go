@@ -0,0 +1,94 @@
+package usecase_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/link/usecase"
+	"devoratio.dev/web-resume/link/usecase/repositorymock"
+	"devoratio.dev/web-resume/model"
+)
+
+var _ = Describe("Link", Label("link"), func() {
+	var (
+		mockController *gomock.Controller
+
+		linkRepoMock *repositorymock.MockLinkRepository
+
+		linkUsecase *usecase.Link
+		commonCtx   context.Context
+		claim       model.Claim
+		request     model.LinkRequest
+	)
+
+	BeforeEach(func() {
+		mockController = gomock.NewController(GinkgoT())
+
+		linkRepoMock = repositorymock.NewMockLinkRepository(mockController)
+
+		linkUsecase = usecase.NewUsecase(linkRepoMock)
+
+		claim = model.Claim{UserID: 1, Username: "devoratio", Scopes: []string{model.ScopeResumeWrite}}
+		request = model.LinkRequest{Type: model.LinkGitHub, Value: "@devoratio", Public: true}
+
+		commonCtx = context.Background()
+	})
+
+	AfterEach(func() {
+		mockController.Finish()
+	})
+
+	Describe("Add a link", func() {
+		When("the type is unknown", func() {
+			It("tells the owner which types there are", func(ctx SpecContext) {
+				request.Type = "myspace"
+
+				result, err := linkUsecase.Create(commonCtx, claim, request)
+				Expect(err.(*errorx.Error).Type).Should(Equal(errorx.TypeInvalidParameter))
+				Expect(err.(*errorx.Error).Details).Should(HaveKey("type"))
+				Expect(result).Should(BeNil())
+			}, SpecTimeout(time.Second*2))
+		})
+
+		When("the value doesn't match the type", func() {
+			It("tells the owner what a link of the type looks like", func(ctx SpecContext) {
+				request.Type = model.LinkLinkedIn
+
+				_, err := linkUsecase.Create(commonCtx, claim, request)
+				Expect(err.(*errorx.Error).Details).Should(HaveKeyWithValue("value", "must be a LinkedIn profile URL"))
+			}, SpecTimeout(time.Second*2))
+		})
+
+		When("the link is valid", func() {
+			It("stores the normalized URL", func(ctx SpecContext) {
+				linkRepoMock.EXPECT().CreateLink(commonCtx, gomock.Any()).Return(nil).Times(1)
+
+				result, err := linkUsecase.Create(commonCtx, claim, request)
+				Expect(err).Should(BeNil())
+				Expect(result.OwnerID).Should(Equal(claim.UserID))
+				Expect(result.Value).Should(Equal("https://github.com/devoratio"))
+				Expect(result.Public).Should(BeTrue())
+			}, SpecTimeout(time.Second*2))
+		})
+	})
+
+	Describe("List the links", func() {
+		It("groups them by type", func(ctx SpecContext) {
+			linkRepoMock.EXPECT().ListLinks(commonCtx, claim.UserID).Return([]model.Link{
+				{ID: 1, Type: model.LinkMastodon},
+				{ID: 2, Type: model.LinkGitHub},
+				{ID: 3, Type: model.LinkEmail},
+				{ID: 4, Type: model.LinkGitHub},
+			}, nil).Times(1)
+
+			result, err := linkUsecase.List(commonCtx, claim)
+			Expect(err).Should(BeNil())
+			Expect([]uint{result[0].ID, result[1].ID, result[2].ID, result[3].ID}).Should(Equal([]uint{3, 2, 4, 1}))
+		}, SpecTimeout(time.Second*2))
+	})
+})
@@ -0,0 +1,108 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: devoratio.dev/web-resume/link/usecase (interfaces: LinkRepository)
+
+// Package repositorymock is a generated GoMock package.
+package repositorymock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "devoratio.dev/web-resume/model"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockLinkRepository is a mock of LinkRepository interface.
+type MockLinkRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLinkRepositoryMockRecorder
+}
+
+// MockLinkRepositoryMockRecorder is the mock recorder for MockLinkRepository.
+type MockLinkRepositoryMockRecorder struct {
+	mock *MockLinkRepository
+}
+
+// NewMockLinkRepository creates a new mock instance.
+func NewMockLinkRepository(ctrl *gomock.Controller) *MockLinkRepository {
+	mock := &MockLinkRepository{ctrl: ctrl}
+	mock.recorder = &MockLinkRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLinkRepository) EXPECT() *MockLinkRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateLink mocks base method.
+func (m *MockLinkRepository) CreateLink(arg0 context.Context, arg1 *model.Link) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLink", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateLink indicates an expected call of CreateLink.
+func (mr *MockLinkRepositoryMockRecorder) CreateLink(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLink", reflect.TypeOf((*MockLinkRepository)(nil).CreateLink), arg0, arg1)
+}
+
+// DeleteLink mocks base method.
+func (m *MockLinkRepository) DeleteLink(arg0 context.Context, arg1, arg2 uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLink", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteLink indicates an expected call of DeleteLink.
+func (mr *MockLinkRepositoryMockRecorder) DeleteLink(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLink", reflect.TypeOf((*MockLinkRepository)(nil).DeleteLink), arg0, arg1, arg2)
+}
+
+// GetLink mocks base method.
+func (m *MockLinkRepository) GetLink(arg0 context.Context, arg1, arg2 uint) (*model.Link, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLink", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*model.Link)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLink indicates an expected call of GetLink.
+func (mr *MockLinkRepositoryMockRecorder) GetLink(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLink", reflect.TypeOf((*MockLinkRepository)(nil).GetLink), arg0, arg1, arg2)
+}
+
+// ListLinks mocks base method.
+func (m *MockLinkRepository) ListLinks(arg0 context.Context, arg1 uint) ([]model.Link, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLinks", arg0, arg1)
+	ret0, _ := ret[0].([]model.Link)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLinks indicates an expected call of ListLinks.
+func (mr *MockLinkRepositoryMockRecorder) ListLinks(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLinks", reflect.TypeOf((*MockLinkRepository)(nil).ListLinks), arg0, arg1)
+}
+
+// UpdateLink mocks base method.
+func (m *MockLinkRepository) UpdateLink(arg0 context.Context, arg1 *model.Link) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLink", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateLink indicates an expected call of UpdateLink.
+func (mr *MockLinkRepositoryMockRecorder) UpdateLink(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLink", reflect.TypeOf((*MockLinkRepository)(nil).UpdateLink), arg0, arg1)
+}
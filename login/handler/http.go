@@ -0,0 +1,133 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+
+	"devoratio.dev/web-resume/internal/httpx"
+	"devoratio.dev/web-resume/model"
+)
+
+type LoginUsecase interface {
+	Login(ctx context.Context, identifier, password string) (*model.TokenPair, error)
+	RequestMagicLink(ctx context.Context, identifier string) error
+	LoginWithMagicLink(ctx context.Context, magicToken string) (*model.TokenPair, error)
+	RequestProviderLogin(ctx context.Context, provider string) (string, error)
+	LoginWithProvider(ctx context.Context, provider, code, state string) (*model.TokenPair, error)
+}
+
+type HTTP struct {
+	loginUsecase LoginUsecase
+	transport    *httpx.TokenTransport
+}
+
+func NewHTTP(loginUsecase LoginUsecase, transport *httpx.TokenTransport) *HTTP {
+	return &HTTP{
+		loginUsecase: loginUsecase,
+		transport:    transport,
+	}
+}
+
+// RegisterRoutes registers the sign-in routes, wrapped by restrict
+func (h *HTTP) RegisterRoutes(mux *http.ServeMux, restrict httpx.Middleware) {
+	mux.Handle("POST /v1/login", restrict(http.HandlerFunc(h.login)))
+	mux.Handle("POST /v1/login/magic-link", restrict(http.HandlerFunc(h.requestMagicLink)))
+	mux.Handle("POST /v1/login/magic-link/verify", restrict(http.HandlerFunc(h.loginWithMagicLink)))
+	mux.Handle("POST /v1/login/oauth/{provider}", restrict(http.HandlerFunc(h.requestProviderLogin)))
+	mux.Handle("POST /v1/login/oauth/{provider}/callback", restrict(http.HandlerFunc(h.loginWithProvider)))
+}
+
+type loginRequest struct {
+	Identifier string `json:"identifier"`
+	Password   string `json:"password"`
+}
+
+func (h *HTTP) login(w http.ResponseWriter, r *http.Request) {
+	var request loginRequest
+	if err := httpx.DecodeJSON(w, r, &request); err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	tokenPair, err := h.loginUsecase.Login(r.Context(), request.Identifier, request.Password)
+	if err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	h.transport.WriteTokenPair(w, r, tokenPair)
+}
+
+type magicLinkRequest struct {
+	Identifier string `json:"identifier"`
+}
+
+func (h *HTTP) requestMagicLink(w http.ResponseWriter, r *http.Request) {
+	var request magicLinkRequest
+	if err := httpx.DecodeJSON(w, r, &request); err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	if err := h.loginUsecase.RequestMagicLink(r.Context(), request.Identifier); err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+type magicLinkLoginRequest struct {
+	Token string `json:"token"`
+}
+
+func (h *HTTP) loginWithMagicLink(w http.ResponseWriter, r *http.Request) {
+	var request magicLinkLoginRequest
+	if err := httpx.DecodeJSON(w, r, &request); err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	tokenPair, err := h.loginUsecase.LoginWithMagicLink(r.Context(), request.Token)
+	if err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	h.transport.WriteTokenPair(w, r, tokenPair)
+}
+
+type providerLoginResponse struct {
+	AuthorizationURL string `json:"authorization_url"`
+}
+
+func (h *HTTP) requestProviderLogin(w http.ResponseWriter, r *http.Request) {
+	authURL, err := h.loginUsecase.RequestProviderLogin(r.Context(), r.PathValue("provider"))
+	if err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, http.StatusOK, providerLoginResponse{AuthorizationURL: authURL})
+}
+
+type providerCallbackRequest struct {
+	Code  string `json:"code"`
+	State string `json:"state"`
+}
+
+func (h *HTTP) loginWithProvider(w http.ResponseWriter, r *http.Request) {
+	var request providerCallbackRequest
+	if err := httpx.DecodeJSON(w, r, &request); err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	tokenPair, err := h.loginUsecase.LoginWithProvider(r.Context(), r.PathValue("provider"), request.Code, request.State)
+	if err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	h.transport.WriteTokenPair(w, r, tokenPair)
+}
@@ -0,0 +1,130 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"devoratio.dev/web-resume/config"
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/internal/identifier"
+	"devoratio.dev/web-resume/internal/tenancy"
+	"devoratio.dev/web-resume/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type PostgreSQLDatabase struct {
+	db                *gorm.DB
+	verifiedEmailOnly bool
+}
+
+func NewPostgreSQL(db *gorm.DB, authConfig config.Authentication) *PostgreSQLDatabase {
+	return &PostgreSQLDatabase{
+		db:                db,
+		verifiedEmailOnly: authConfig.VerifiedEmailOnly,
+	}
+}
+
+func (p *PostgreSQLDatabase) GetOwnerByUsernameOrEmail(ctx context.Context, ownerIdentifier string) (*model.OwnerAccount, error) {
+	owner := &model.OwnerAccount{}
+	result := p.db.WithContext(ctx).
+		Scopes(tenancy.Scope(ctx, "id"), identifier.Scope(ownerIdentifier, p.verifiedEmailOnly)).
+		First(owner)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, errorx.ErrNotFound
+		}
+		return nil, errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+
+	return owner, nil
+}
+
+func (p *PostgreSQLDatabase) GetOwnerByID(ctx context.Context, ownerID uint) (*model.OwnerAccount, error) {
+	owner := &model.OwnerAccount{}
+	result := p.db.WithContext(ctx).Scopes(tenancy.Scope(ctx, "id")).First(owner, ownerID)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, errorx.ErrNotFound
+		}
+		return nil, errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+
+	return owner, nil
+}
+
+func (p *PostgreSQLDatabase) CreateToken(ctx context.Context, token *model.OneTimeToken) error {
+	if err := p.db.WithContext(ctx).Create(token).Error; err != nil {
+		return errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), err)
+	}
+
+	return nil
+}
+
+func (p *PostgreSQLDatabase) ConsumeToken(ctx context.Context, tokenHash string, purpose model.TokenPurpose) (*model.OneTimeToken, error) {
+	now := time.Now()
+	token := &model.OneTimeToken{}
+
+	// A single conditional update keeps concurrent exchanges of the same token
+	// from both succeeding
+	result := p.db.WithContext(ctx).Model(token).Clauses(clause.Returning{}).
+		Scopes(tenancy.Scope(ctx, "owner_id")).
+		Where("token_hash = ? AND purpose = ? AND used_at IS NULL AND expires_at > ?", tokenHash, purpose, now).
+		Update("used_at", now)
+	if result.Error != nil {
+		return nil, errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil, errorx.ErrNotFound
+	}
+
+	return token, nil
+}
+
+func (p *PostgreSQLDatabase) CreateOAuthState(ctx context.Context, state *model.OAuthState) error {
+	if err := p.db.WithContext(ctx).Create(state).Error; err != nil {
+		return errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), err)
+	}
+
+	return nil
+}
+
+func (p *PostgreSQLDatabase) ConsumeOAuthState(ctx context.Context, stateHash, provider string, purpose model.OAuthPurpose) (*model.OAuthState, error) {
+	now := time.Now()
+	state := &model.OAuthState{}
+
+	result := p.db.WithContext(ctx).Model(state).Clauses(clause.Returning{}).
+		Where("state_hash = ? AND provider = ? AND purpose = ? AND used_at IS NULL AND expires_at > ?", stateHash, provider, purpose, now).
+		Update("used_at", now)
+	if result.Error != nil {
+		return nil, errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil, errorx.ErrNotFound
+	}
+
+	return state, nil
+}
+
+func (p *PostgreSQLDatabase) GetIdentity(ctx context.Context, provider, subject string) (*model.ExternalIdentity, error) {
+	identity := &model.ExternalIdentity{}
+	result := p.db.WithContext(ctx).Scopes(tenancy.Scope(ctx, "owner_id")).Where("provider = ? AND subject = ?", provider, subject).First(identity)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, errorx.ErrNotFound
+		}
+		return nil, errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+
+	return identity, nil
+}
+
+func (p *PostgreSQLDatabase) MarkIdentityUsed(ctx context.Context, identityID uint, now time.Time) error {
+	result := p.db.WithContext(ctx).Model(&model.ExternalIdentity{}).Where("id = ?", identityID).Update("last_used_at", now)
+	if result.Error != nil {
+		return errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+
+	return nil
+}
@@ -2,10 +2,12 @@ package usecase
 
 import (
 	"context"
+	"time"
 
 	"devoratio.dev/web-resume/config"
 	"devoratio.dev/web-resume/internal/errorx"
-	"devoratio.dev/web-resume/internal/generator"
+	"devoratio.dev/web-resume/internal/mailer"
+	"devoratio.dev/web-resume/internal/ratelimit"
 	"devoratio.dev/web-resume/model"
 )
 
@@ -14,31 +16,145 @@ type AuthenticationUsecase interface {
 	Authenticate(ctx context.Context, identifier, password string) (*model.Owner, error)
 }
 
+//go:generate mockgen -destination=repositorymock/postgresqlmock.go -package=repositorymock . LoginRepository
+type LoginRepository interface {
+	GetOwnerByUsernameOrEmail(ctx context.Context, identifier string) (*model.OwnerAccount, error)
+	GetOwnerByID(ctx context.Context, ownerID uint) (*model.OwnerAccount, error)
+	CreateToken(ctx context.Context, token *model.OneTimeToken) error
+	// ConsumeToken marks a token as used and returns it. It returns
+	// errorx.ErrNotFound when the token is unknown, already used or expired.
+	ConsumeToken(ctx context.Context, tokenHash string, purpose model.TokenPurpose) (*model.OneTimeToken, error)
+	CreateOAuthState(ctx context.Context, state *model.OAuthState) error
+	// ConsumeOAuthState marks a state as used and returns it. It returns
+	// errorx.ErrNotFound when the state is unknown, already used or expired.
+	ConsumeOAuthState(ctx context.Context, stateHash, provider string, purpose model.OAuthPurpose) (*model.OAuthState, error)
+	GetIdentity(ctx context.Context, provider, subject string) (*model.ExternalIdentity, error)
+	MarkIdentityUsed(ctx context.Context, identityID uint, now time.Time) error
+}
+
+//go:generate mockgen -destination=usecasemock/sessionmock.go -package=usecasemock . SessionUsecase
+type SessionUsecase interface {
+	Start(ctx context.Context, owner *model.Owner) (*model.TokenPair, error)
+}
+
+//go:generate mockgen -destination=usecasemock/auditmock.go -package=usecasemock . AuditUsecase
+type AuditUsecase interface {
+	Record(ctx context.Context, entry model.AuditLog, result error)
+}
+
+//go:generate mockgen -destination=usecasemock/identityprovidermock.go -package=usecasemock . IdentityProvider
+type IdentityProvider interface {
+	AuthCodeURL(ctx context.Context, provider, state, codeChallenge string) (string, error)
+	Exchange(ctx context.Context, provider, code, codeVerifier string) (*model.ExternalProfile, error)
+}
+
+//go:generate mockgen -destination=mailermock/mailermock.go -package=mailermock . Mailer
+type Mailer interface {
+	Send(ctx context.Context, message mailer.Message) error
+}
+
+//go:generate mockgen -destination=ratelimitermock/ratelimitermock.go -package=ratelimitermock . RateLimiter
+type RateLimiter interface {
+	Allow(key string) bool
+}
+
 type Login struct {
-	authUsecase AuthenticationUsecase
-	appConfig   *config.Application
+	authUsecase      AuthenticationUsecase
+	loginRepo        LoginRepository
+	sessionUsecase   SessionUsecase
+	auditUsecase     AuditUsecase
+	identityProvider IdentityProvider
+	mailer           Mailer
+	rateLimiter      RateLimiter
+	appConfig        *config.Application
 }
 
-func NewUsecase(authUsecase AuthenticationUsecase, appConfig *config.Application) *Login {
+func NewUsecase(authUsecase AuthenticationUsecase, loginRepo LoginRepository, sessionUsecase SessionUsecase, auditUsecase AuditUsecase, identityProvider IdentityProvider, mailer Mailer, rateLimiter RateLimiter, appConfig *config.Application) *Login {
 	return &Login{
-		authUsecase: authUsecase,
-		appConfig:   appConfig,
+		authUsecase:      authUsecase,
+		loginRepo:        loginRepo,
+		sessionUsecase:   sessionUsecase,
+		auditUsecase:     auditUsecase,
+		identityProvider: identityProvider,
+		mailer:           mailer,
+		rateLimiter:      rateLimiter,
+		appConfig:        appConfig,
 	}
 }
 
-func (l *Login) Login(ctx context.Context, identifier, password string) (string, error) {
+func (l *Login) Login(ctx context.Context, identifier, password string) (*model.TokenPair, error) {
+	entry := model.AuditLog{Method: model.AuditMethodPassword, Identifier: identifier}
+
+	key, knownOwner, err := l.rateLimitKey(ctx, identifier)
+	if err != nil {
+		return nil, err
+	}
+	if knownOwner != nil {
+		entry.OwnerID = &knownOwner.ID
+	}
+	if !l.rateLimiter.Allow(key) {
+		entry.Event = model.AuditEventRateLimited
+		l.auditUsecase.Record(ctx, entry, errorx.ErrTooManyRequests)
+		return nil, errorx.ErrTooManyRequests
+	}
+
 	ownerAccount, err := l.authUsecase.Authenticate(ctx, identifier, password)
 	if err != nil {
-		return "", err
+		entry.Event = failureEvent(err)
+		l.auditUsecase.Record(ctx, entry, err)
+		return nil, err
+	}
+
+	entry.OwnerID = &ownerAccount.ID
+	tokenPair, err := l.sessionUsecase.Start(ctx, ownerAccount)
+	if err != nil {
+		entry.Event = startFailureEvent(err)
+		l.auditUsecase.Record(ctx, entry, err)
+		return nil, err
 	}
 
-	accessToken, err := generator.GenerateAccessToken(model.Claim{
-		UserID:   ownerAccount.ID,
-		Username: ownerAccount.Username,
-	}, l.appConfig.Authentication.SigningKey)
+	entry.Event = model.AuditEventSuccess
+	l.auditUsecase.Record(ctx, entry, nil)
+	return tokenPair, nil
+}
+
+// failureEvent tells apart why Authenticate rejected an attempt, a distinction
+// that is deliberately hidden from the client
+func failureEvent(err error) model.AuditEvent {
+	switch {
+	case errorx.Is(err, errorx.ErrNotFound):
+		return model.AuditEventUnknownIdentifier
+	case errorx.Is(err, errorx.ErrNotMatch):
+		return model.AuditEventBadPassword
+	case errorx.Is(err, errorx.ErrForbidden):
+		return model.AuditEventLocked
+	default:
+		return model.AuditEventError
+	}
+}
+
+// startFailureEvent tells apart an owner who has to reset their password
+// before signing in again, whatever the method, from a failure to start the
+// session
+func startFailureEvent(err error) model.AuditEvent {
+	if errorx.Is(err, errorx.ErrForbidden) {
+		return model.AuditEventLocked
+	}
+
+	return model.AuditEventError
+}
+
+// rateLimitKey makes the username and the email of an owner, in any spelling,
+// share the same budget. An identifier matching no owner has a budget of its
+// own. The owner is returned when there is one.
+func (l *Login) rateLimitKey(ctx context.Context, loginIdentifier string) (string, *model.OwnerAccount, error) {
+	ownerAccount, err := l.loginRepo.GetOwnerByUsernameOrEmail(ctx, loginIdentifier)
 	if err != nil {
-		return "", errorx.ErrInternal
+		if errorx.Is(err, errorx.ErrNotFound) {
+			return ratelimit.IdentifierKey(loginIdentifier), nil, nil
+		}
+		return "", nil, err
 	}
 
-	return accessToken, nil
+	return ratelimit.OwnerKey(ownerAccount.ID), ownerAccount, nil
 }
@@ -2,6 +2,7 @@ package usecase_test
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/brianvoe/gofakeit/v6"
@@ -12,6 +13,9 @@ import (
 	"devoratio.dev/web-resume/config"
 	"devoratio.dev/web-resume/internal/errorx"
 	"devoratio.dev/web-resume/login/usecase"
+	"devoratio.dev/web-resume/login/usecase/mailermock"
+	"devoratio.dev/web-resume/login/usecase/ratelimitermock"
+	"devoratio.dev/web-resume/login/usecase/repositorymock"
 	"devoratio.dev/web-resume/login/usecase/usecasemock"
 	"devoratio.dev/web-resume/model"
 )
@@ -21,11 +25,18 @@ var _ = Describe("Login with user credential", func() {
 		mockController *gomock.Controller
 
 		authenticationUsecaseMock *usecasemock.MockAuthenticationUsecase
+		loginRepoMock             *repositorymock.MockLoginRepository
+		mailerMock                *mailermock.MockMailer
+		rateLimiterMock           *ratelimitermock.MockRateLimiter
+		sessionUsecaseMock        *usecasemock.MockSessionUsecase
+		auditUsecaseMock          *usecasemock.MockAuditUsecase
+		identityProviderMock      *usecasemock.MockIdentityProvider
 
 		commonCtx             context.Context
 		loginUsecase          *usecase.Login
 		identifier            = "devoratio"
 		ownerAccountStub      model.Owner
+		tokenPairStub         model.TokenPair
 		errorInvalidParameter *errorx.Error
 		appConfig             *config.Application
 	)
@@ -35,15 +46,22 @@ var _ = Describe("Login with user credential", func() {
 		mockController = gomock.NewController(GinkgoT())
 
 		authenticationUsecaseMock = usecasemock.NewMockAuthenticationUsecase(mockController)
+		loginRepoMock = repositorymock.NewMockLoginRepository(mockController)
+		mailerMock = mailermock.NewMockMailer(mockController)
+		rateLimiterMock = ratelimitermock.NewMockRateLimiter(mockController)
+		sessionUsecaseMock = usecasemock.NewMockSessionUsecase(mockController)
+		auditUsecaseMock = usecasemock.NewMockAuditUsecase(mockController)
+		identityProviderMock = usecasemock.NewMockIdentityProvider(mockController)
 		appConfig = &config.Application{
 			Authentication: config.Authentication{
 				SigningKey: []byte("veryverysecretsigningkey"),
 			},
 		}
 
-		loginUsecase = usecase.NewUsecase(authenticationUsecaseMock, appConfig)
+		loginUsecase = usecase.NewUsecase(authenticationUsecaseMock, loginRepoMock, sessionUsecaseMock, auditUsecaseMock, identityProviderMock, mailerMock, rateLimiterMock, appConfig)
 
 		gofakeit.Struct(&ownerAccountStub)
+		gofakeit.Struct(&tokenPairStub)
 
 		commonCtx = context.Background()
 		errorInvalidParameter = errorx.New(errorx.TypeInvalidParameter, "username or email or password is invalid", nil)
@@ -57,11 +75,20 @@ var _ = Describe("Login with user credential", func() {
 		It("tells the user that the username or email or password is invalid", func(ctx SpecContext) {
 			password := "veryverysecurepassword"
 
+			loginRepoMock.EXPECT().GetOwnerByUsernameOrEmail(commonCtx, identifier).Return(&model.OwnerAccount{Owner: ownerAccountStub}, nil)
+			rateLimiterMock.EXPECT().Allow(fmt.Sprintf("owner:%d", ownerAccountStub.ID)).Return(true)
 			authenticationUsecaseMock.EXPECT().Authenticate(commonCtx, identifier, password).Return(&ownerAccountStub, nil)
+			sessionUsecaseMock.EXPECT().Start(commonCtx, &ownerAccountStub).Return(&tokenPairStub, nil)
+			auditUsecaseMock.EXPECT().Record(commonCtx, gomock.Any(), nil).Do(
+				func(_ context.Context, entry model.AuditLog, _ error) {
+					Expect(entry.Method).Should(Equal(model.AuditMethodPassword))
+					Expect(entry.Event).Should(Equal(model.AuditEventSuccess))
+					Expect(*entry.OwnerID).Should(Equal(ownerAccountStub.ID))
+				})
 
 			result, err := loginUsecase.Login(commonCtx, identifier, password)
 			Expect(err).Should(BeNil())
-			Expect(result).Should(ContainSubstring("eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9"))
+			Expect(result).Should(Equal(&tokenPairStub))
 		}, SpecTimeout(time.Second*2))
 	})
 
@@ -69,13 +96,77 @@ var _ = Describe("Login with user credential", func() {
 		It("tells the user that the username or email or password is invalid", func(ctx SpecContext) {
 			password := "twinkling"
 
+			errorInvalidParameter = errorx.New(errorx.TypeInvalidParameter, errorInvalidParameter.Message, errorx.ErrNotMatch)
+
+			loginRepoMock.EXPECT().GetOwnerByUsernameOrEmail(commonCtx, identifier).Return(&model.OwnerAccount{Owner: ownerAccountStub}, nil)
+			rateLimiterMock.EXPECT().Allow(fmt.Sprintf("owner:%d", ownerAccountStub.ID)).Return(true)
 			authenticationUsecaseMock.EXPECT().Authenticate(commonCtx, identifier, password).Return(nil, errorInvalidParameter)
+			auditUsecaseMock.EXPECT().Record(commonCtx, gomock.Any(), errorInvalidParameter).Do(
+				func(_ context.Context, entry model.AuditLog, _ error) {
+					Expect(entry.Identifier).Should(Equal(identifier))
+					Expect(entry.Event).Should(Equal(model.AuditEventBadPassword))
+					Expect(*entry.OwnerID).Should(Equal(ownerAccountStub.ID))
+				})
 
 			result, err := loginUsecase.Login(commonCtx, identifier, password)
 			Expect(err.(*errorx.Error).Code).Should(Equal(errorInvalidParameter.Code))
 			Expect(err.(*errorx.Error).Message).Should(Equal(errorInvalidParameter.Message))
 			Expect(err.(*errorx.Error).Type).Should(Equal(errorInvalidParameter.Type))
-			Expect(result).Should(Equal(""))
+			Expect(result).Should(BeNil())
+		}, SpecTimeout(time.Second*2))
+	})
+
+	When("no owner matches the identifier", func() {
+		It("tells the user that the username or email or password is invalid", func(ctx SpecContext) {
+			password := "twinkling"
+			errorInvalidParameter = errorx.New(errorx.TypeInvalidParameter, errorInvalidParameter.Message, errorx.ErrNotFound)
+
+			loginRepoMock.EXPECT().GetOwnerByUsernameOrEmail(commonCtx, identifier).Return(nil, errorx.ErrNotFound)
+			rateLimiterMock.EXPECT().Allow("identifier:devoratio").Return(true)
+			authenticationUsecaseMock.EXPECT().Authenticate(commonCtx, identifier, password).Return(nil, errorInvalidParameter)
+			auditUsecaseMock.EXPECT().Record(commonCtx, gomock.Any(), errorInvalidParameter).Do(
+				func(_ context.Context, entry model.AuditLog, _ error) {
+					Expect(entry.Event).Should(Equal(model.AuditEventUnknownIdentifier))
+				})
+
+			result, err := loginUsecase.Login(commonCtx, identifier, password)
+			Expect(err.(*errorx.Error).Type).Should(Equal(errorx.TypeInvalidParameter))
+			Expect(result).Should(BeNil())
+		}, SpecTimeout(time.Second*2))
+	})
+
+	When("the owner has to reset their password first", func() {
+		It("tells the user and records the attempt as locked", func(ctx SpecContext) {
+			password := "veryverysecurepassword"
+			errorForbidden := errorx.New(errorx.TypeForbidden, "password has to be reset before signing in", nil)
+
+			loginRepoMock.EXPECT().GetOwnerByUsernameOrEmail(commonCtx, identifier).Return(&model.OwnerAccount{Owner: ownerAccountStub}, nil)
+			rateLimiterMock.EXPECT().Allow(fmt.Sprintf("owner:%d", ownerAccountStub.ID)).Return(true)
+			authenticationUsecaseMock.EXPECT().Authenticate(commonCtx, identifier, password).Return(&ownerAccountStub, nil)
+			sessionUsecaseMock.EXPECT().Start(commonCtx, &ownerAccountStub).Return(nil, errorForbidden)
+			auditUsecaseMock.EXPECT().Record(commonCtx, gomock.Any(), errorForbidden).Do(
+				func(_ context.Context, entry model.AuditLog, _ error) {
+					Expect(entry.Event).Should(Equal(model.AuditEventLocked))
+				})
+
+			result, err := loginUsecase.Login(commonCtx, identifier, password)
+			Expect(err).Should(Equal(errorForbidden))
+			Expect(result).Should(BeNil())
+		}, SpecTimeout(time.Second*2))
+	})
+
+	When("the user made too many login attempts", func() {
+		It("tells the user to slow down without checking the password", func(ctx SpecContext) {
+			loginRepoMock.EXPECT().GetOwnerByUsernameOrEmail(commonCtx, "DevoRatio ").Return(nil, errorx.ErrNotFound)
+			rateLimiterMock.EXPECT().Allow("identifier:devoratio").Return(false)
+			auditUsecaseMock.EXPECT().Record(commonCtx, gomock.Any(), errorx.ErrTooManyRequests).Do(
+				func(_ context.Context, entry model.AuditLog, _ error) {
+					Expect(entry.Event).Should(Equal(model.AuditEventRateLimited))
+				})
+
+			result, err := loginUsecase.Login(commonCtx, "DevoRatio ", "veryverysecurepassword")
+			Expect(err).Should(Equal(errorx.ErrTooManyRequests))
+			Expect(result).Should(BeNil())
 		}, SpecTimeout(time.Second*2))
 	})
 })
@@ -0,0 +1,155 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/internal/generator"
+	"devoratio.dev/web-resume/internal/hasher"
+	"devoratio.dev/web-resume/internal/mailer"
+	"devoratio.dev/web-resume/model"
+)
+
+const invalidMagicLinkMessage = "sign-in link is invalid or has expired"
+
+const magicLinkEmailBody = `Hi %s,
+
+Open the link below within %s to sign in to your web resume account:
+
+%s
+
+The link can only be used once. If you did not ask to sign in you can ignore
+this email.
+`
+
+// RequestMagicLink emails a one-time sign-in link to the verified email of the
+// owner matching identifier. Like a password reset it reports success whether
+// or not such an owner exists.
+func (l *Login) RequestMagicLink(ctx context.Context, identifier string) error {
+	entry := model.AuditLog{Method: model.AuditMethodMagicLink, Identifier: identifier}
+
+	key, ownerAccount, err := l.rateLimitKey(ctx, identifier)
+	if err != nil {
+		return err
+	}
+	if ownerAccount != nil {
+		entry.OwnerID = &ownerAccount.ID
+	}
+
+	if !l.rateLimiter.Allow(key) {
+		entry.Event = model.AuditEventRateLimited
+		l.auditUsecase.Record(ctx, entry, errorx.ErrTooManyRequests)
+		return errorx.ErrTooManyRequests
+	}
+
+	if ownerAccount == nil {
+		entry.Event = model.AuditEventUnknownIdentifier
+		l.auditUsecase.Record(ctx, entry, errorx.ErrNotFound)
+		return nil
+	}
+
+	entry.Event = model.AuditEventLinkRequested
+
+	// Sign-in links are only ever sent to an address the owner proved they own
+	if !ownerAccount.EmailVerified {
+		l.auditUsecase.Record(ctx, entry, errorx.ErrForbidden)
+		return nil
+	}
+
+	err = l.sendMagicLink(ctx, ownerAccount)
+	l.auditUsecase.Record(ctx, entry, err)
+
+	return err
+}
+
+func (l *Login) sendMagicLink(ctx context.Context, ownerAccount *model.OwnerAccount) error {
+	magicToken, err := generator.GenerateRandomToken()
+	if err != nil {
+		return err
+	}
+
+	ttl := l.appConfig.Usecase.MagicLink.TokenTTL
+	err = l.loginRepo.CreateToken(ctx, &model.OneTimeToken{
+		OwnerID:   ownerAccount.ID,
+		Purpose:   model.TokenPurposeMagicLink,
+		TokenHash: hasher.HashToken(magicToken),
+		Email:     ownerAccount.Email,
+		ExpiresAt: time.Now().Add(ttl),
+	})
+	if err != nil {
+		return err
+	}
+
+	magicURL, err := generator.GenerateTokenURL(l.appConfig.Usecase.MagicLink.URL, magicToken)
+	if err != nil {
+		return err
+	}
+
+	message := mailer.Message{
+		To:      ownerAccount.Email,
+		Subject: "Your sign-in link",
+		Body:    fmt.Sprintf(magicLinkEmailBody, ownerAccount.FullName(), ttl, magicURL),
+	}
+
+	go func(ctx context.Context) {
+		if err := l.mailer.Send(ctx, message); err != nil {
+			log.Printf("failed to send sign-in link: %s", err)
+		}
+	}(context.WithoutCancel(ctx))
+
+	return nil
+}
+
+// LoginWithMagicLink exchanges a sign-in token for a new session
+func (l *Login) LoginWithMagicLink(ctx context.Context, magicToken string) (*model.TokenPair, error) {
+	entry := model.AuditLog{Method: model.AuditMethodMagicLink, Event: model.AuditEventInvalidToken}
+
+	ownerAccount, err := l.redeemMagicLink(ctx, magicToken, &entry)
+	if err != nil {
+		l.auditUsecase.Record(ctx, entry, err)
+		return nil, err
+	}
+
+	tokenPair, err := l.sessionUsecase.Start(ctx, &ownerAccount.Owner)
+	if err != nil {
+		entry.Event = startFailureEvent(err)
+		l.auditUsecase.Record(ctx, entry, err)
+		return nil, err
+	}
+
+	entry.Event = model.AuditEventSuccess
+	l.auditUsecase.Record(ctx, entry, nil)
+	return tokenPair, nil
+}
+
+// redeemMagicLink consumes the token and returns its owner, filling in the
+// audit entry with whatever is learned about the owner on the way
+func (l *Login) redeemMagicLink(ctx context.Context, magicToken string, entry *model.AuditLog) (*model.OwnerAccount, error) {
+	token, err := l.loginRepo.ConsumeToken(ctx, hasher.HashToken(magicToken), model.TokenPurposeMagicLink)
+	if err != nil {
+		if errorx.Is(err, errorx.ErrNotFound) {
+			return nil, errorx.New(errorx.TypeInvalidParameter, invalidMagicLinkMessage, err)
+		}
+		return nil, err
+	}
+	entry.OwnerID = &token.OwnerID
+	entry.Identifier = token.Email
+
+	ownerAccount, err := l.loginRepo.GetOwnerByID(ctx, token.OwnerID)
+	if err != nil {
+		if errorx.Is(err, errorx.ErrNotFound) {
+			return nil, errorx.New(errorx.TypeInvalidParameter, invalidMagicLinkMessage, err)
+		}
+		return nil, err
+	}
+
+	// The link is void once the owner moved to another address
+	if !ownerAccount.EmailVerified || ownerAccount.Email != token.Email {
+		return nil, errorx.New(errorx.TypeInvalidParameter, invalidMagicLinkMessage, nil)
+	}
+
+	return ownerAccount, nil
+}
@@ -0,0 +1,254 @@
+package usecase_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"devoratio.dev/web-resume/config"
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/internal/hasher"
+	"devoratio.dev/web-resume/internal/mailer"
+	"devoratio.dev/web-resume/login/usecase"
+	"devoratio.dev/web-resume/login/usecase/mailermock"
+	"devoratio.dev/web-resume/login/usecase/ratelimitermock"
+	"devoratio.dev/web-resume/login/usecase/repositorymock"
+	"devoratio.dev/web-resume/login/usecase/usecasemock"
+	"devoratio.dev/web-resume/model"
+)
+
+var _ = Describe("Login with a magic link", Label("login"), func() {
+	var (
+		mockController *gomock.Controller
+
+		authenticationUsecaseMock *usecasemock.MockAuthenticationUsecase
+		loginRepoMock             *repositorymock.MockLoginRepository
+		mailerMock                *mailermock.MockMailer
+		rateLimiterMock           *ratelimitermock.MockRateLimiter
+		sessionUsecaseMock        *usecasemock.MockSessionUsecase
+		auditUsecaseMock          *usecasemock.MockAuditUsecase
+		identityProviderMock      *usecasemock.MockIdentityProvider
+
+		commonCtx        context.Context
+		loginUsecase     *usecase.Login
+		identifier       = "devoratio"
+		ownerAccountStub model.OwnerAccount
+		tokenPairStub    model.TokenPair
+		appConfig        *config.Application
+	)
+
+	BeforeEach(func() {
+		gofakeit.Seed(time.Now().UnixNano())
+		mockController = gomock.NewController(GinkgoT())
+
+		authenticationUsecaseMock = usecasemock.NewMockAuthenticationUsecase(mockController)
+		loginRepoMock = repositorymock.NewMockLoginRepository(mockController)
+		mailerMock = mailermock.NewMockMailer(mockController)
+		rateLimiterMock = ratelimitermock.NewMockRateLimiter(mockController)
+		sessionUsecaseMock = usecasemock.NewMockSessionUsecase(mockController)
+		auditUsecaseMock = usecasemock.NewMockAuditUsecase(mockController)
+		identityProviderMock = usecasemock.NewMockIdentityProvider(mockController)
+		appConfig = &config.Application{
+			Authentication: config.Authentication{
+				SigningKey: []byte("veryverysecretsigningkey"),
+			},
+			Usecase: config.Usecase{
+				MagicLink: config.MagicLink{
+					TokenTTL: 10 * time.Minute,
+					URL:      "https://resume.devoratio.dev/magic-link",
+				},
+			},
+		}
+
+		loginUsecase = usecase.NewUsecase(authenticationUsecaseMock, loginRepoMock, sessionUsecaseMock, auditUsecaseMock, identityProviderMock, mailerMock, rateLimiterMock, appConfig)
+
+		gofakeit.Struct(&ownerAccountStub)
+		gofakeit.Struct(&tokenPairStub)
+		ownerAccountStub.EmailVerified = true
+
+		commonCtx = context.Background()
+	})
+
+	AfterEach(func() {
+		mockController.Finish()
+	})
+
+	When("the user requests a sign-in link", func() {
+		Context("the user made too many requests", func() {
+			It("tells the user to slow down", func(ctx SpecContext) {
+				loginRepoMock.EXPECT().GetOwnerByUsernameOrEmail(commonCtx, identifier).Return(&ownerAccountStub, nil)
+				rateLimiterMock.EXPECT().Allow(fmt.Sprintf("owner:%d", ownerAccountStub.ID)).Return(false)
+				auditUsecaseMock.EXPECT().Record(commonCtx, gomock.Any(), errorx.ErrTooManyRequests).Do(
+					func(_ context.Context, entry model.AuditLog, _ error) {
+						Expect(entry.Method).Should(Equal(model.AuditMethodMagicLink))
+						Expect(entry.Event).Should(Equal(model.AuditEventRateLimited))
+					})
+
+				err := loginUsecase.RequestMagicLink(commonCtx, identifier)
+				Expect(err).Should(Equal(errorx.ErrTooManyRequests))
+			}, SpecTimeout(time.Second*2))
+		})
+
+		Context("the user asks with the username and then the email of the same owner", func() {
+			It("counts both requests against the owner's budget", func(ctx SpecContext) {
+				loginRepoMock.EXPECT().GetOwnerByUsernameOrEmail(commonCtx, ownerAccountStub.Username).Return(&ownerAccountStub, nil)
+				loginRepoMock.EXPECT().GetOwnerByUsernameOrEmail(commonCtx, ownerAccountStub.Email).Return(&ownerAccountStub, nil)
+				rateLimiterMock.EXPECT().Allow(fmt.Sprintf("owner:%d", ownerAccountStub.ID)).Return(false).Times(2)
+				auditUsecaseMock.EXPECT().Record(commonCtx, gomock.Any(), errorx.ErrTooManyRequests).Do(
+					func(_ context.Context, entry model.AuditLog, _ error) {
+						Expect(*entry.OwnerID).Should(Equal(ownerAccountStub.ID))
+					}).Times(2)
+
+				Expect(loginUsecase.RequestMagicLink(commonCtx, ownerAccountStub.Username)).Should(Equal(errorx.ErrTooManyRequests))
+				Expect(loginUsecase.RequestMagicLink(commonCtx, ownerAccountStub.Email)).Should(Equal(errorx.ErrTooManyRequests))
+			}, SpecTimeout(time.Second*2))
+		})
+
+		Context("no owner matches the identifier", func() {
+			It("reports success without sending an email", func(ctx SpecContext) {
+				loginRepoMock.EXPECT().GetOwnerByUsernameOrEmail(commonCtx, identifier).Return(nil, errorx.ErrNotFound)
+				rateLimiterMock.EXPECT().Allow("identifier:" + identifier).Return(true)
+				auditUsecaseMock.EXPECT().Record(commonCtx, gomock.Any(), errorx.ErrNotFound).Do(
+					func(_ context.Context, entry model.AuditLog, _ error) {
+						Expect(entry.Method).Should(Equal(model.AuditMethodMagicLink))
+						Expect(entry.Event).Should(Equal(model.AuditEventUnknownIdentifier))
+					})
+
+				err := loginUsecase.RequestMagicLink(commonCtx, identifier)
+				Expect(err).Should(BeNil())
+			}, SpecTimeout(time.Second*2))
+		})
+
+		Context("the owner email has not been verified", func() {
+			It("reports success without sending an email", func(ctx SpecContext) {
+				ownerAccountStub.EmailVerified = false
+				loginRepoMock.EXPECT().GetOwnerByUsernameOrEmail(commonCtx, identifier).Return(&ownerAccountStub, nil)
+				rateLimiterMock.EXPECT().Allow(fmt.Sprintf("owner:%d", ownerAccountStub.ID)).Return(true)
+				auditUsecaseMock.EXPECT().Record(commonCtx, gomock.Any(), errorx.ErrForbidden).Do(
+					func(_ context.Context, entry model.AuditLog, _ error) {
+						Expect(entry.Method).Should(Equal(model.AuditMethodMagicLink))
+						Expect(entry.Event).Should(Equal(model.AuditEventLinkRequested))
+					})
+
+				err := loginUsecase.RequestMagicLink(commonCtx, identifier)
+				Expect(err).Should(BeNil())
+			}, SpecTimeout(time.Second*2))
+		})
+
+		Context("the owner email has been verified", func() {
+			It("stores the token hash and emails the link to the owner", func(ctx SpecContext) {
+				sent := make(chan mailer.Message, 1)
+
+				loginRepoMock.EXPECT().GetOwnerByUsernameOrEmail(commonCtx, identifier).Return(&ownerAccountStub, nil)
+				rateLimiterMock.EXPECT().Allow(fmt.Sprintf("owner:%d", ownerAccountStub.ID)).Return(true)
+				loginRepoMock.EXPECT().CreateToken(commonCtx, gomock.Any()).DoAndReturn(
+					func(_ context.Context, token *model.OneTimeToken) error {
+						Expect(token.Purpose).Should(Equal(model.TokenPurposeMagicLink))
+						Expect(token.Email).Should(Equal(ownerAccountStub.Email))
+						Expect(token.ExpiresAt).Should(BeTemporally("~", time.Now().Add(10*time.Minute), time.Minute))
+						return nil
+					})
+				mailerMock.EXPECT().Send(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, message mailer.Message) error {
+						sent <- message
+						return nil
+					})
+				auditUsecaseMock.EXPECT().Record(commonCtx, gomock.Any(), nil).Do(
+					func(_ context.Context, entry model.AuditLog, _ error) {
+						Expect(entry.Method).Should(Equal(model.AuditMethodMagicLink))
+						Expect(entry.Event).Should(Equal(model.AuditEventLinkRequested))
+						Expect(*entry.OwnerID).Should(Equal(ownerAccountStub.ID))
+					})
+
+				err := loginUsecase.RequestMagicLink(commonCtx, identifier)
+				Expect(err).Should(BeNil())
+
+				var message mailer.Message
+				Eventually(sent).Should(Receive(&message))
+				Expect(message.To).Should(Equal(ownerAccountStub.Email))
+				Expect(message.Body).Should(ContainSubstring("https://resume.devoratio.dev/magic-link?token="))
+			}, SpecTimeout(time.Second*2))
+		})
+	})
+
+	When("the user opens a sign-in link", func() {
+		var tokenHash = hasher.HashToken("magictoken")
+
+		Context("the token is unknown, used or expired", func() {
+			It("tells the user that the link is invalid", func(ctx SpecContext) {
+				loginRepoMock.EXPECT().ConsumeToken(commonCtx, tokenHash, model.TokenPurposeMagicLink).Return(nil, errorx.ErrNotFound)
+				auditUsecaseMock.EXPECT().Record(commonCtx, gomock.Any(), gomock.Any()).Do(
+					func(_ context.Context, entry model.AuditLog, _ error) {
+						Expect(entry.Method).Should(Equal(model.AuditMethodMagicLink))
+						Expect(entry.Event).Should(Equal(model.AuditEventInvalidToken))
+					})
+
+				result, err := loginUsecase.LoginWithMagicLink(commonCtx, "magictoken")
+				Expect(err.(*errorx.Error).Type).Should(Equal(errorx.TypeInvalidParameter))
+				Expect(result).Should(BeNil())
+			}, SpecTimeout(time.Second*2))
+		})
+
+		Context("the owner changed their email after the link was sent", func() {
+			It("tells the user that the link is invalid", func(ctx SpecContext) {
+				loginRepoMock.EXPECT().ConsumeToken(commonCtx, tokenHash, model.TokenPurposeMagicLink).
+					Return(&model.OneTimeToken{OwnerID: ownerAccountStub.ID, Email: "previous@devoratio.dev"}, nil)
+				loginRepoMock.EXPECT().GetOwnerByID(commonCtx, ownerAccountStub.ID).Return(&ownerAccountStub, nil)
+				auditUsecaseMock.EXPECT().Record(commonCtx, gomock.Any(), gomock.Any()).Do(
+					func(_ context.Context, entry model.AuditLog, _ error) {
+						Expect(entry.Method).Should(Equal(model.AuditMethodMagicLink))
+						Expect(entry.Event).Should(Equal(model.AuditEventInvalidToken))
+						Expect(*entry.OwnerID).Should(Equal(ownerAccountStub.ID))
+					})
+
+				result, err := loginUsecase.LoginWithMagicLink(commonCtx, "magictoken")
+				Expect(err.(*errorx.Error).Type).Should(Equal(errorx.TypeInvalidParameter))
+				Expect(result).Should(BeNil())
+			}, SpecTimeout(time.Second*2))
+		})
+
+		Context("the owner has to reset their password first", func() {
+			It("refuses to sign the user in and records the attempt as locked", func(ctx SpecContext) {
+				errorForbidden := errorx.New(errorx.TypeForbidden, "password has to be reset before signing in", nil)
+
+				loginRepoMock.EXPECT().ConsumeToken(commonCtx, tokenHash, model.TokenPurposeMagicLink).
+					Return(&model.OneTimeToken{OwnerID: ownerAccountStub.ID, Email: ownerAccountStub.Email}, nil)
+				loginRepoMock.EXPECT().GetOwnerByID(commonCtx, ownerAccountStub.ID).Return(&ownerAccountStub, nil)
+				sessionUsecaseMock.EXPECT().Start(commonCtx, &ownerAccountStub.Owner).Return(nil, errorForbidden)
+				auditUsecaseMock.EXPECT().Record(commonCtx, gomock.Any(), errorForbidden).Do(
+					func(_ context.Context, entry model.AuditLog, _ error) {
+						Expect(entry.Method).Should(Equal(model.AuditMethodMagicLink))
+						Expect(entry.Event).Should(Equal(model.AuditEventLocked))
+					})
+
+				result, err := loginUsecase.LoginWithMagicLink(commonCtx, "magictoken")
+				Expect(err).Should(Equal(errorForbidden))
+				Expect(result).Should(BeNil())
+			}, SpecTimeout(time.Second*2))
+		})
+
+		Context("the token is valid", func() {
+			It("starts a session for the owner", func(ctx SpecContext) {
+				loginRepoMock.EXPECT().ConsumeToken(commonCtx, tokenHash, model.TokenPurposeMagicLink).
+					Return(&model.OneTimeToken{OwnerID: ownerAccountStub.ID, Email: ownerAccountStub.Email}, nil)
+				loginRepoMock.EXPECT().GetOwnerByID(commonCtx, ownerAccountStub.ID).Return(&ownerAccountStub, nil)
+				sessionUsecaseMock.EXPECT().Start(commonCtx, &ownerAccountStub.Owner).Return(&tokenPairStub, nil)
+				auditUsecaseMock.EXPECT().Record(commonCtx, gomock.Any(), nil).Do(
+					func(_ context.Context, entry model.AuditLog, _ error) {
+						Expect(entry.Method).Should(Equal(model.AuditMethodMagicLink))
+						Expect(entry.Event).Should(Equal(model.AuditEventSuccess))
+						Expect(*entry.OwnerID).Should(Equal(ownerAccountStub.ID))
+					})
+
+				result, err := loginUsecase.LoginWithMagicLink(commonCtx, "magictoken")
+				Expect(err).Should(BeNil())
+				Expect(result).Should(Equal(&tokenPairStub))
+			}, SpecTimeout(time.Second*2))
+		})
+	})
+})
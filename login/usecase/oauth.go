@@ -0,0 +1,120 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/internal/generator"
+	"devoratio.dev/web-resume/internal/hasher"
+	"devoratio.dev/web-resume/internal/oauth"
+	"devoratio.dev/web-resume/model"
+)
+
+const (
+	invalidOAuthStateMessage = "sign-in request is invalid or has expired"
+	unlinkedIdentityMessage  = "no account is linked to this identity"
+)
+
+// RequestProviderLogin starts signing in with an identity provider and returns
+// the provider page the user has to be sent to
+func (l *Login) RequestProviderLogin(ctx context.Context, provider string) (string, error) {
+	state, err := generator.GenerateRandomToken()
+	if err != nil {
+		return "", err
+	}
+	codeVerifier, err := oauth.NewCodeVerifier()
+	if err != nil {
+		return "", err
+	}
+
+	authURL, err := l.identityProvider.AuthCodeURL(ctx, provider, state, oauth.CodeChallenge(codeVerifier))
+	if err != nil {
+		return "", err
+	}
+
+	now := time.Now()
+	err = l.loginRepo.CreateOAuthState(ctx, &model.OAuthState{
+		StateHash:    hasher.HashToken(state),
+		Provider:     provider,
+		Purpose:      model.OAuthPurposeLogin,
+		CodeVerifier: codeVerifier,
+		ExpiresAt:    now.Add(l.appConfig.Authentication.OAuth.StateTTL),
+		CreatedAt:    now,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return authURL, nil
+}
+
+// LoginWithProvider completes a sign-in started by RequestProviderLogin once
+// the provider redirected the user back with an authorization code. Only
+// identities the owner linked beforehand can sign in.
+func (l *Login) LoginWithProvider(ctx context.Context, provider, code, state string) (*model.TokenPair, error) {
+	entry := model.AuditLog{Method: model.AuditMethodOAuth, Identifier: provider, Event: model.AuditEventInvalidToken}
+
+	identity, err := l.redeemAuthorizationCode(ctx, provider, code, state, &entry)
+	if err != nil {
+		l.auditUsecase.Record(ctx, entry, err)
+		return nil, err
+	}
+	entry.OwnerID = &identity.OwnerID
+	entry.Event = model.AuditEventError
+
+	ownerAccount, err := l.loginRepo.GetOwnerByID(ctx, identity.OwnerID)
+	if err != nil {
+		l.auditUsecase.Record(ctx, entry, err)
+		return nil, err
+	}
+
+	if err := l.loginRepo.MarkIdentityUsed(ctx, identity.ID, time.Now()); err != nil {
+		l.auditUsecase.Record(ctx, entry, err)
+		return nil, err
+	}
+
+	tokenPair, err := l.sessionUsecase.Start(ctx, &ownerAccount.Owner)
+	if err != nil {
+		entry.Event = startFailureEvent(err)
+		l.auditUsecase.Record(ctx, entry, err)
+		return nil, err
+	}
+
+	entry.Event = model.AuditEventSuccess
+	l.auditUsecase.Record(ctx, entry, nil)
+	return tokenPair, nil
+}
+
+// redeemAuthorizationCode checks the state, exchanges the code for the profile
+// of the user at the provider and returns the identity linked to it
+func (l *Login) redeemAuthorizationCode(ctx context.Context, provider, code, state string, entry *model.AuditLog) (*model.ExternalIdentity, error) {
+	oauthState, err := l.loginRepo.ConsumeOAuthState(ctx, hasher.HashToken(state), provider, model.OAuthPurposeLogin)
+	if err != nil {
+		if errorx.Is(err, errorx.ErrNotFound) {
+			return nil, errorx.New(errorx.TypeInvalidParameter, invalidOAuthStateMessage, err)
+		}
+		return nil, err
+	}
+
+	profile, err := l.identityProvider.Exchange(ctx, provider, code, oauthState.CodeVerifier)
+	if err != nil {
+		if !errorx.Is(err, errorx.ErrInvalidParameter) {
+			entry.Event = model.AuditEventError
+		}
+		return nil, err
+	}
+	entry.Identifier = provider + ":" + profile.Username
+
+	identity, err := l.loginRepo.GetIdentity(ctx, provider, profile.Subject)
+	if err != nil {
+		if errorx.Is(err, errorx.ErrNotFound) {
+			entry.Event = model.AuditEventUnknownIdentifier
+			return nil, errorx.New(errorx.TypeInvalidParameter, unlinkedIdentityMessage, err)
+		}
+		entry.Event = model.AuditEventError
+		return nil, err
+	}
+
+	return identity, nil
+}
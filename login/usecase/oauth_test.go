@@ -0,0 +1,216 @@
+package usecase_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"devoratio.dev/web-resume/config"
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/internal/hasher"
+	"devoratio.dev/web-resume/internal/oauth"
+	"devoratio.dev/web-resume/login/usecase"
+	"devoratio.dev/web-resume/login/usecase/mailermock"
+	"devoratio.dev/web-resume/login/usecase/ratelimitermock"
+	"devoratio.dev/web-resume/login/usecase/repositorymock"
+	"devoratio.dev/web-resume/login/usecase/usecasemock"
+	"devoratio.dev/web-resume/model"
+)
+
+var _ = Describe("Login with an identity provider", Label("login"), func() {
+	var (
+		mockController *gomock.Controller
+
+		authenticationUsecaseMock *usecasemock.MockAuthenticationUsecase
+		loginRepoMock             *repositorymock.MockLoginRepository
+		mailerMock                *mailermock.MockMailer
+		rateLimiterMock           *ratelimitermock.MockRateLimiter
+		sessionUsecaseMock        *usecasemock.MockSessionUsecase
+		auditUsecaseMock          *usecasemock.MockAuditUsecase
+		identityProviderMock      *usecasemock.MockIdentityProvider
+
+		commonCtx        context.Context
+		loginUsecase     *usecase.Login
+		ownerAccountStub model.OwnerAccount
+		tokenPairStub    model.TokenPair
+		identityStub     model.ExternalIdentity
+		profileStub      model.ExternalProfile
+		oauthStateStub   model.OAuthState
+		appConfig        *config.Application
+	)
+
+	BeforeEach(func() {
+		gofakeit.Seed(time.Now().UnixNano())
+		mockController = gomock.NewController(GinkgoT())
+
+		authenticationUsecaseMock = usecasemock.NewMockAuthenticationUsecase(mockController)
+		loginRepoMock = repositorymock.NewMockLoginRepository(mockController)
+		mailerMock = mailermock.NewMockMailer(mockController)
+		rateLimiterMock = ratelimitermock.NewMockRateLimiter(mockController)
+		sessionUsecaseMock = usecasemock.NewMockSessionUsecase(mockController)
+		auditUsecaseMock = usecasemock.NewMockAuditUsecase(mockController)
+		identityProviderMock = usecasemock.NewMockIdentityProvider(mockController)
+		appConfig = &config.Application{
+			Authentication: config.Authentication{
+				OAuth: config.OAuth{
+					StateTTL: 10 * time.Minute,
+				},
+			},
+		}
+
+		loginUsecase = usecase.NewUsecase(authenticationUsecaseMock, loginRepoMock, sessionUsecaseMock, auditUsecaseMock, identityProviderMock, mailerMock, rateLimiterMock, appConfig)
+
+		gofakeit.Struct(&ownerAccountStub)
+		gofakeit.Struct(&tokenPairStub)
+		gofakeit.Struct(&identityStub)
+		gofakeit.Struct(&profileStub)
+		identityStub.OwnerID = ownerAccountStub.ID
+		profileStub.Provider = "github"
+		oauthStateStub = model.OAuthState{Provider: "github", Purpose: model.OAuthPurposeLogin, CodeVerifier: "codeverifier"}
+
+		commonCtx = context.Background()
+	})
+
+	AfterEach(func() {
+		mockController.Finish()
+	})
+
+	When("the user starts signing in with a provider", func() {
+		Context("the provider is not configured", func() {
+			It("tells the user the provider does not exist", func(ctx SpecContext) {
+				identityProviderMock.EXPECT().AuthCodeURL(commonCtx, "gitlab", gomock.Any(), gomock.Any()).Return("", errorx.ErrNotFound)
+
+				authURL, err := loginUsecase.RequestProviderLogin(commonCtx, "gitlab")
+				Expect(err).Should(Equal(errorx.ErrNotFound))
+				Expect(authURL).Should(BeEmpty())
+			}, SpecTimeout(time.Second*2))
+		})
+
+		Context("the provider is configured", func() {
+			It("stores the state with its code verifier and returns the provider page", func(ctx SpecContext) {
+				var state, codeChallenge string
+
+				identityProviderMock.EXPECT().AuthCodeURL(commonCtx, "github", gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, _, s, challenge string) (string, error) {
+						state, codeChallenge = s, challenge
+						return "https://github.com/login/oauth/authorize?state=" + s, nil
+					})
+				loginRepoMock.EXPECT().CreateOAuthState(commonCtx, gomock.Any()).DoAndReturn(
+					func(_ context.Context, oauthState *model.OAuthState) error {
+						Expect(oauthState.StateHash).Should(Equal(hasher.HashToken(state)))
+						Expect(oauthState.Provider).Should(Equal("github"))
+						Expect(oauthState.Purpose).Should(Equal(model.OAuthPurposeLogin))
+						Expect(oauthState.OwnerID).Should(BeNil())
+						Expect(oauth.CodeChallenge(oauthState.CodeVerifier)).Should(Equal(codeChallenge))
+						Expect(oauthState.ExpiresAt).Should(BeTemporally("~", time.Now().Add(10*time.Minute), time.Minute))
+						return nil
+					})
+
+				authURL, err := loginUsecase.RequestProviderLogin(commonCtx, "github")
+				Expect(err).Should(BeNil())
+				Expect(authURL).Should(Equal("https://github.com/login/oauth/authorize?state=" + state))
+			}, SpecTimeout(time.Second*2))
+		})
+	})
+
+	When("the provider redirects the user back", func() {
+		var stateHash = hasher.HashToken("state")
+
+		Context("the state is unknown, used or expired", func() {
+			It("tells the user that the request is invalid", func(ctx SpecContext) {
+				loginRepoMock.EXPECT().ConsumeOAuthState(commonCtx, stateHash, "github", model.OAuthPurposeLogin).Return(nil, errorx.ErrNotFound)
+				auditUsecaseMock.EXPECT().Record(commonCtx, gomock.Any(), gomock.Any()).Do(
+					func(_ context.Context, entry model.AuditLog, _ error) {
+						Expect(entry.Method).Should(Equal(model.AuditMethodOAuth))
+						Expect(entry.Event).Should(Equal(model.AuditEventInvalidToken))
+					})
+
+				result, err := loginUsecase.LoginWithProvider(commonCtx, "github", "code", "state")
+				Expect(err.(*errorx.Error).Type).Should(Equal(errorx.TypeInvalidParameter))
+				Expect(result).Should(BeNil())
+			}, SpecTimeout(time.Second*2))
+		})
+
+		Context("the provider rejects the authorization code", func() {
+			It("tells the user that the code is invalid", func(ctx SpecContext) {
+				errorInvalidCode := errorx.New(errorx.TypeInvalidParameter, "authorization code is invalid or has expired", nil)
+
+				loginRepoMock.EXPECT().ConsumeOAuthState(commonCtx, stateHash, "github", model.OAuthPurposeLogin).Return(&oauthStateStub, nil)
+				identityProviderMock.EXPECT().Exchange(commonCtx, "github", "code", "codeverifier").Return(nil, errorInvalidCode)
+				auditUsecaseMock.EXPECT().Record(commonCtx, gomock.Any(), errorInvalidCode).Do(
+					func(_ context.Context, entry model.AuditLog, _ error) {
+						Expect(entry.Event).Should(Equal(model.AuditEventInvalidToken))
+					})
+
+				result, err := loginUsecase.LoginWithProvider(commonCtx, "github", "code", "state")
+				Expect(err).Should(Equal(errorInvalidCode))
+				Expect(result).Should(BeNil())
+			}, SpecTimeout(time.Second*2))
+		})
+
+		Context("the identity is not linked to the owner", func() {
+			It("tells the user that no account is linked", func(ctx SpecContext) {
+				loginRepoMock.EXPECT().ConsumeOAuthState(commonCtx, stateHash, "github", model.OAuthPurposeLogin).Return(&oauthStateStub, nil)
+				identityProviderMock.EXPECT().Exchange(commonCtx, "github", "code", "codeverifier").Return(&profileStub, nil)
+				loginRepoMock.EXPECT().GetIdentity(commonCtx, "github", profileStub.Subject).Return(nil, errorx.ErrNotFound)
+				auditUsecaseMock.EXPECT().Record(commonCtx, gomock.Any(), gomock.Any()).Do(
+					func(_ context.Context, entry model.AuditLog, _ error) {
+						Expect(entry.Identifier).Should(Equal("github:" + profileStub.Username))
+						Expect(entry.Event).Should(Equal(model.AuditEventUnknownIdentifier))
+						Expect(entry.OwnerID).Should(BeNil())
+					})
+
+				result, err := loginUsecase.LoginWithProvider(commonCtx, "github", "code", "state")
+				Expect(err.(*errorx.Error).Type).Should(Equal(errorx.TypeInvalidParameter))
+				Expect(result).Should(BeNil())
+			}, SpecTimeout(time.Second*2))
+		})
+
+		Context("the owner has to reset their password first", func() {
+			It("refuses to sign the user in and records the attempt as locked", func(ctx SpecContext) {
+				errorForbidden := errorx.New(errorx.TypeForbidden, "password has to be reset before signing in", nil)
+
+				loginRepoMock.EXPECT().ConsumeOAuthState(commonCtx, stateHash, "github", model.OAuthPurposeLogin).Return(&oauthStateStub, nil)
+				identityProviderMock.EXPECT().Exchange(commonCtx, "github", "code", "codeverifier").Return(&profileStub, nil)
+				loginRepoMock.EXPECT().GetIdentity(commonCtx, "github", profileStub.Subject).Return(&identityStub, nil)
+				loginRepoMock.EXPECT().GetOwnerByID(commonCtx, ownerAccountStub.ID).Return(&ownerAccountStub, nil)
+				loginRepoMock.EXPECT().MarkIdentityUsed(commonCtx, identityStub.ID, gomock.Any()).Return(nil)
+				sessionUsecaseMock.EXPECT().Start(commonCtx, &ownerAccountStub.Owner).Return(nil, errorForbidden)
+				auditUsecaseMock.EXPECT().Record(commonCtx, gomock.Any(), errorForbidden).Do(
+					func(_ context.Context, entry model.AuditLog, _ error) {
+						Expect(entry.Method).Should(Equal(model.AuditMethodOAuth))
+						Expect(entry.Event).Should(Equal(model.AuditEventLocked))
+					})
+
+				result, err := loginUsecase.LoginWithProvider(commonCtx, "github", "code", "state")
+				Expect(err).Should(Equal(errorForbidden))
+				Expect(result).Should(BeNil())
+			}, SpecTimeout(time.Second*2))
+		})
+
+		Context("the identity is linked to the owner", func() {
+			It("starts a session for the owner", func(ctx SpecContext) {
+				loginRepoMock.EXPECT().ConsumeOAuthState(commonCtx, stateHash, "github", model.OAuthPurposeLogin).Return(&oauthStateStub, nil)
+				identityProviderMock.EXPECT().Exchange(commonCtx, "github", "code", "codeverifier").Return(&profileStub, nil)
+				loginRepoMock.EXPECT().GetIdentity(commonCtx, "github", profileStub.Subject).Return(&identityStub, nil)
+				loginRepoMock.EXPECT().GetOwnerByID(commonCtx, ownerAccountStub.ID).Return(&ownerAccountStub, nil)
+				loginRepoMock.EXPECT().MarkIdentityUsed(commonCtx, identityStub.ID, gomock.Any()).Return(nil)
+				sessionUsecaseMock.EXPECT().Start(commonCtx, &ownerAccountStub.Owner).Return(&tokenPairStub, nil)
+				auditUsecaseMock.EXPECT().Record(commonCtx, gomock.Any(), nil).Do(
+					func(_ context.Context, entry model.AuditLog, _ error) {
+						Expect(entry.Method).Should(Equal(model.AuditMethodOAuth))
+						Expect(entry.Event).Should(Equal(model.AuditEventSuccess))
+						Expect(*entry.OwnerID).Should(Equal(ownerAccountStub.ID))
+					})
+
+				result, err := loginUsecase.LoginWithProvider(commonCtx, "github", "code", "state")
+				Expect(err).Should(BeNil())
+				Expect(result).Should(Equal(&tokenPairStub))
+			}, SpecTimeout(time.Second*2))
+		})
+	})
+})
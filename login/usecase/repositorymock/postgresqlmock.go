@@ -0,0 +1,154 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: devoratio.dev/web-resume/login/usecase (interfaces: LoginRepository)
+
+// Package repositorymock is a generated GoMock package.
+package repositorymock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	model "devoratio.dev/web-resume/model"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockLoginRepository is a mock of LoginRepository interface.
+type MockLoginRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLoginRepositoryMockRecorder
+}
+
+// MockLoginRepositoryMockRecorder is the mock recorder for MockLoginRepository.
+type MockLoginRepositoryMockRecorder struct {
+	mock *MockLoginRepository
+}
+
+// NewMockLoginRepository creates a new mock instance.
+func NewMockLoginRepository(ctrl *gomock.Controller) *MockLoginRepository {
+	mock := &MockLoginRepository{ctrl: ctrl}
+	mock.recorder = &MockLoginRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLoginRepository) EXPECT() *MockLoginRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ConsumeOAuthState mocks base method.
+func (m *MockLoginRepository) ConsumeOAuthState(arg0 context.Context, arg1, arg2 string, arg3 model.OAuthPurpose) (*model.OAuthState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsumeOAuthState", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*model.OAuthState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConsumeOAuthState indicates an expected call of ConsumeOAuthState.
+func (mr *MockLoginRepositoryMockRecorder) ConsumeOAuthState(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumeOAuthState", reflect.TypeOf((*MockLoginRepository)(nil).ConsumeOAuthState), arg0, arg1, arg2, arg3)
+}
+
+// ConsumeToken mocks base method.
+func (m *MockLoginRepository) ConsumeToken(arg0 context.Context, arg1 string, arg2 model.TokenPurpose) (*model.OneTimeToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsumeToken", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*model.OneTimeToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConsumeToken indicates an expected call of ConsumeToken.
+func (mr *MockLoginRepositoryMockRecorder) ConsumeToken(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumeToken", reflect.TypeOf((*MockLoginRepository)(nil).ConsumeToken), arg0, arg1, arg2)
+}
+
+// CreateOAuthState mocks base method.
+func (m *MockLoginRepository) CreateOAuthState(arg0 context.Context, arg1 *model.OAuthState) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOAuthState", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateOAuthState indicates an expected call of CreateOAuthState.
+func (mr *MockLoginRepositoryMockRecorder) CreateOAuthState(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOAuthState", reflect.TypeOf((*MockLoginRepository)(nil).CreateOAuthState), arg0, arg1)
+}
+
+// CreateToken mocks base method.
+func (m *MockLoginRepository) CreateToken(arg0 context.Context, arg1 *model.OneTimeToken) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateToken", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateToken indicates an expected call of CreateToken.
+func (mr *MockLoginRepositoryMockRecorder) CreateToken(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateToken", reflect.TypeOf((*MockLoginRepository)(nil).CreateToken), arg0, arg1)
+}
+
+// GetIdentity mocks base method.
+func (m *MockLoginRepository) GetIdentity(arg0 context.Context, arg1, arg2 string) (*model.ExternalIdentity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIdentity", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*model.ExternalIdentity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIdentity indicates an expected call of GetIdentity.
+func (mr *MockLoginRepositoryMockRecorder) GetIdentity(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIdentity", reflect.TypeOf((*MockLoginRepository)(nil).GetIdentity), arg0, arg1, arg2)
+}
+
+// GetOwnerByID mocks base method.
+func (m *MockLoginRepository) GetOwnerByID(arg0 context.Context, arg1 uint) (*model.OwnerAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOwnerByID", arg0, arg1)
+	ret0, _ := ret[0].(*model.OwnerAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOwnerByID indicates an expected call of GetOwnerByID.
+func (mr *MockLoginRepositoryMockRecorder) GetOwnerByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOwnerByID", reflect.TypeOf((*MockLoginRepository)(nil).GetOwnerByID), arg0, arg1)
+}
+
+// GetOwnerByUsernameOrEmail mocks base method.
+func (m *MockLoginRepository) GetOwnerByUsernameOrEmail(arg0 context.Context, arg1 string) (*model.OwnerAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOwnerByUsernameOrEmail", arg0, arg1)
+	ret0, _ := ret[0].(*model.OwnerAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOwnerByUsernameOrEmail indicates an expected call of GetOwnerByUsernameOrEmail.
+func (mr *MockLoginRepositoryMockRecorder) GetOwnerByUsernameOrEmail(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOwnerByUsernameOrEmail", reflect.TypeOf((*MockLoginRepository)(nil).GetOwnerByUsernameOrEmail), arg0, arg1)
+}
+
+// MarkIdentityUsed mocks base method.
+func (m *MockLoginRepository) MarkIdentityUsed(arg0 context.Context, arg1 uint, arg2 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkIdentityUsed", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkIdentityUsed indicates an expected call of MarkIdentityUsed.
+func (mr *MockLoginRepositoryMockRecorder) MarkIdentityUsed(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkIdentityUsed", reflect.TypeOf((*MockLoginRepository)(nil).MarkIdentityUsed), arg0, arg1, arg2)
+}
@@ -0,0 +1,48 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: devoratio.dev/web-resume/login/usecase (interfaces: AuditUsecase)
+
+// Package usecasemock is a generated GoMock package.
+package usecasemock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "devoratio.dev/web-resume/model"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockAuditUsecase is a mock of AuditUsecase interface.
+type MockAuditUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditUsecaseMockRecorder
+}
+
+// MockAuditUsecaseMockRecorder is the mock recorder for MockAuditUsecase.
+type MockAuditUsecaseMockRecorder struct {
+	mock *MockAuditUsecase
+}
+
+// NewMockAuditUsecase creates a new mock instance.
+func NewMockAuditUsecase(ctrl *gomock.Controller) *MockAuditUsecase {
+	mock := &MockAuditUsecase{ctrl: ctrl}
+	mock.recorder = &MockAuditUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditUsecase) EXPECT() *MockAuditUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Record mocks base method.
+func (m *MockAuditUsecase) Record(arg0 context.Context, arg1 model.AuditLog, arg2 error) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Record", arg0, arg1, arg2)
+}
+
+// Record indicates an expected call of Record.
+func (mr *MockAuditUsecaseMockRecorder) Record(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Record", reflect.TypeOf((*MockAuditUsecase)(nil).Record), arg0, arg1, arg2)
+}
@@ -0,0 +1,46 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+
+	"devoratio.dev/web-resume/internal/httpx"
+)
+
+type LoginAlertUsecase interface {
+	Report(ctx context.Context, reportToken string) error
+}
+
+type HTTP struct {
+	alertUsecase LoginAlertUsecase
+}
+
+func NewHTTP(alertUsecase LoginAlertUsecase) *HTTP {
+	return &HTTP{
+		alertUsecase: alertUsecase,
+	}
+}
+
+// RegisterRoutes registers the route reporting a sign-in, wrapped by restrict
+func (h *HTTP) RegisterRoutes(mux *http.ServeMux, restrict httpx.Middleware) {
+	mux.Handle("POST /v1/login/report", restrict(http.HandlerFunc(h.report)))
+}
+
+type reportRequest struct {
+	Token string `json:"token"`
+}
+
+func (h *HTTP) report(w http.ResponseWriter, r *http.Request) {
+	var request reportRequest
+	if err := httpx.DecodeJSON(w, r, &request); err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	if err := h.alertUsecase.Report(r.Context(), request.Token); err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	httpx.WriteNoContent(w)
+}
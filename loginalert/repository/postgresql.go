@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/internal/tenancy"
+	"devoratio.dev/web-resume/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type PostgreSQLDatabase struct {
+	db *gorm.DB
+}
+
+func NewPostgreSQL(db *gorm.DB) *PostgreSQLDatabase {
+	return &PostgreSQLDatabase{
+		db: db,
+	}
+}
+
+func (p *PostgreSQLDatabase) ListPreviousSessions(ctx context.Context, ownerID, exceptSessionID uint, limit int) ([]model.Session, error) {
+	var sessions []model.Session
+	result := p.db.WithContext(ctx).
+		Where("owner_id = ? AND id <> ?", ownerID, exceptSessionID).
+		Order("created_at DESC").
+		Limit(limit).
+		Find(&sessions)
+	if result.Error != nil {
+		return nil, errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+
+	return sessions, nil
+}
+
+func (p *PostgreSQLDatabase) CreateToken(ctx context.Context, token *model.OneTimeToken) error {
+	if err := p.db.WithContext(ctx).Create(token).Error; err != nil {
+		return errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), err)
+	}
+
+	return nil
+}
+
+func (p *PostgreSQLDatabase) ReportSession(ctx context.Context, tokenHash string, now time.Time) (*model.OwnerAccount, error) {
+	ownerAccount := &model.OwnerAccount{}
+
+	err := p.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		token := &model.OneTimeToken{}
+		result := tx.Model(token).Clauses(clause.Returning{}).
+			Scopes(tenancy.Scope(ctx, "owner_id")).
+			Where("token_hash = ? AND purpose = ? AND used_at IS NULL AND expires_at > ?", tokenHash, model.TokenPurposeLoginReport, now).
+			Update("used_at", now)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 || token.SessionID == nil {
+			return gorm.ErrRecordNotFound
+		}
+
+		// Whoever got in may have opened more than the reported session and
+		// created personal access tokens from it
+		err := tx.Model(&model.Session{}).
+			Where("owner_id = ? AND revoked_at IS NULL", token.OwnerID).
+			Update("revoked_at", now).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Model(&model.PersonalToken{}).
+			Where("owner_id = ? AND revoked_at IS NULL", token.OwnerID).
+			Update("revoked_at", now).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Model(&model.OwnerAccount{}).Where("id = ?", token.OwnerID).
+			Update("password_reset_required", true).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.First(ownerAccount, token.OwnerID).Error
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errorx.ErrNotFound
+		}
+		return nil, errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), err)
+	}
+
+	return ownerAccount, nil
+}
@@ -0,0 +1,267 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/netip"
+	"strings"
+	"time"
+
+	"devoratio.dev/web-resume/config"
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/internal/generator"
+	"devoratio.dev/web-resume/internal/hasher"
+	"devoratio.dev/web-resume/internal/mailer"
+	"devoratio.dev/web-resume/model"
+)
+
+const (
+	ChannelMail    = "mail"
+	ChannelWebhook = "webhook"
+
+	// webhookEvent names the event posted to the webhook
+	webhookEvent = "login.unfamiliar"
+
+	// historySize is how many previous sessions a sign-in is compared with
+	historySize = 50
+
+	// Sign-ins from the same IPv4 /24 or IPv6 /48 count as the same network
+	ipv4NetworkBits = 24
+	ipv6NetworkBits = 48
+)
+
+const invalidReportTokenMessage = "report link is invalid or has expired"
+
+const alertEmailBody = `Hi %s,
+
+Your web resume account was just signed in to from a %s.
+
+  Time:     %s
+  Device:   %s
+  IP:       %s
+  Location: %s
+
+If this was you, you can ignore this email. If it wasn't, open the link below
+within %s. It signs every device out and locks sign-in until you choose a new
+password:
+
+%s
+`
+
+//go:generate mockgen -destination=repositorymock/postgresqlmock.go -package=repositorymock . LoginAlertRepository
+type LoginAlertRepository interface {
+	// ListPreviousSessions returns up to limit of the owner's latest sessions,
+	// revoked ones included, other than the one given
+	ListPreviousSessions(ctx context.Context, ownerID, exceptSessionID uint, limit int) ([]model.Session, error)
+	CreateToken(ctx context.Context, token *model.OneTimeToken) error
+	// ReportSession consumes the report token, revokes every session and
+	// personal access token of the owner and requires them to reset their
+	// password, atomically. It returns
+	// errorx.ErrNotFound when the token is unknown, already used or expired.
+	ReportSession(ctx context.Context, tokenHash string, now time.Time) (*model.OwnerAccount, error)
+}
+
+//go:generate mockgen -destination=usecasemock/passwordresetmock.go -package=usecasemock . PasswordResetUsecase
+type PasswordResetUsecase interface {
+	RequestReset(ctx context.Context, identifier string) error
+}
+
+//go:generate mockgen -destination=notifiermock/notifiermock.go -package=notifiermock . Mailer,Webhook,Locator
+type Mailer interface {
+	Send(ctx context.Context, message mailer.Message) error
+}
+
+type Webhook interface {
+	Send(ctx context.Context, name string, data interface{}) error
+}
+
+type Locator interface {
+	Locate(ip string) string
+}
+
+type LoginAlert struct {
+	alertRepo            LoginAlertRepository
+	passwordResetUsecase PasswordResetUsecase
+	mailer               Mailer
+	webhook              Webhook
+	locator              Locator
+	appConfig            *config.Application
+}
+
+func NewUsecase(alertRepo LoginAlertRepository, passwordResetUsecase PasswordResetUsecase, mailer Mailer, webhook Webhook, locator Locator, appConfig *config.Application) *LoginAlert {
+	return &LoginAlert{
+		alertRepo:            alertRepo,
+		passwordResetUsecase: passwordResetUsecase,
+		mailer:               mailer,
+		webhook:              webhook,
+		locator:              locator,
+		appConfig:            appConfig,
+	}
+}
+
+// Inspect compares a new session with the owner's previous ones and alerts the
+// owner when it comes from a device or network none of them came from. The
+// very first session is never reported. Failing to alert never fails the
+// sign-in.
+func (l *LoginAlert) Inspect(ctx context.Context, owner *model.Owner, session *model.Session) {
+	if !l.appConfig.Usecase.LoginAlert.Enabled {
+		return
+	}
+
+	previousSessions, err := l.alertRepo.ListPreviousSessions(ctx, owner.ID, session.ID, historySize)
+	if err != nil {
+		log.Printf("failed to inspect session %d: %s", session.ID, err)
+		return
+	}
+
+	reasons := unfamiliarReasons(session, previousSessions)
+	if len(previousSessions) == 0 || len(reasons) == 0 {
+		return
+	}
+
+	if err := l.alert(ctx, owner, session, reasons); err != nil {
+		log.Printf("failed to alert owner %d about session %d: %s", owner.ID, session.ID, err)
+	}
+}
+
+// Report handles the "this wasn't me" link of an alert
+func (l *LoginAlert) Report(ctx context.Context, reportToken string) error {
+	ownerAccount, err := l.alertRepo.ReportSession(ctx, hasher.HashToken(reportToken), time.Now())
+	if err != nil {
+		if errorx.Is(err, errorx.ErrNotFound) {
+			return errorx.New(errorx.TypeInvalidParameter, invalidReportTokenMessage, err)
+		}
+		return err
+	}
+
+	// The sessions are already gone, a missing reset email is not worth failing
+	// the report over since the owner can still ask for one
+	if err := l.passwordResetUsecase.RequestReset(ctx, ownerAccount.Username); err != nil {
+		log.Printf("failed to send password reset to owner %d after a reported sign-in: %s", ownerAccount.ID, err)
+	}
+
+	return nil
+}
+
+func (l *LoginAlert) alert(ctx context.Context, owner *model.Owner, session *model.Session, reasons []model.LoginAlertReason) error {
+	alertConfig := l.appConfig.Usecase.LoginAlert
+
+	reportToken, err := generator.GenerateRandomToken()
+	if err != nil {
+		return err
+	}
+
+	err = l.alertRepo.CreateToken(ctx, &model.OneTimeToken{
+		OwnerID:   owner.ID,
+		Purpose:   model.TokenPurposeLoginReport,
+		TokenHash: hasher.HashToken(reportToken),
+		SessionID: &session.ID,
+		ExpiresAt: time.Now().Add(alertConfig.TokenTTL),
+	})
+	if err != nil {
+		return err
+	}
+
+	reportURL, err := generator.GenerateTokenURL(alertConfig.URL, reportToken)
+	if err != nil {
+		return err
+	}
+
+	alert := model.LoginAlert{
+		OwnerID:    owner.ID,
+		Username:   owner.Username,
+		SessionID:  session.ID,
+		Reasons:    reasons,
+		DeviceName: session.DeviceName,
+		ClientIP:   session.ClientIP,
+		UserAgent:  session.UserAgent,
+		Location:   l.locator.Locate(session.ClientIP),
+		SignedInAt: session.CreatedAt,
+		ReportURL:  reportURL,
+	}
+
+	go func(ctx context.Context) {
+		if err := l.send(ctx, owner, alert); err != nil {
+			log.Printf("failed to send sign-in alert: %s", err)
+		}
+	}(context.WithoutCancel(ctx))
+
+	return nil
+}
+
+func (l *LoginAlert) send(ctx context.Context, owner *model.Owner, alert model.LoginAlert) error {
+	if l.appConfig.Usecase.LoginAlert.Channel == ChannelWebhook {
+		return l.webhook.Send(ctx, webhookEvent, alert)
+	}
+
+	location := alert.Location
+	if location == "" {
+		location = "unknown"
+	}
+
+	return l.mailer.Send(ctx, mailer.Message{
+		To:      owner.Email,
+		Subject: "New sign-in to your web resume account",
+		Body: fmt.Sprintf(alertEmailBody, owner.FullName(), describeReasons(alert.Reasons),
+			alert.SignedInAt.UTC().Format(time.RFC1123), alert.DeviceName, alert.ClientIP, location,
+			l.appConfig.Usecase.LoginAlert.TokenTTL, alert.ReportURL),
+	})
+}
+
+// unfamiliarReasons tells what about session none of the previous sessions had
+// in common with it
+func unfamiliarReasons(session *model.Session, previousSessions []model.Session) []model.LoginAlertReason {
+	knownDevice, knownNetwork := false, false
+	network := networkOf(session.ClientIP)
+	for _, previous := range previousSessions {
+		knownDevice = knownDevice || previous.DeviceName == session.DeviceName
+		knownNetwork = knownNetwork || networkOf(previous.ClientIP) == network
+	}
+
+	var reasons []model.LoginAlertReason
+	if !knownDevice {
+		reasons = append(reasons, model.LoginAlertReasonNewDevice)
+	}
+	if !knownNetwork {
+		reasons = append(reasons, model.LoginAlertReasonNewNetwork)
+	}
+
+	return reasons
+}
+
+// networkOf returns the network an address belongs to, addresses that can't be
+// parsed are their own network
+func networkOf(ip string) string {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return ip
+	}
+	addr = addr.Unmap()
+
+	bits := ipv6NetworkBits
+	if addr.Is4() {
+		bits = ipv4NetworkBits
+	}
+
+	prefix, err := addr.Prefix(bits)
+	if err != nil {
+		return ip
+	}
+
+	return prefix.String()
+}
+
+func describeReasons(reasons []model.LoginAlertReason) string {
+	var parts []string
+	for _, reason := range reasons {
+		switch reason {
+		case model.LoginAlertReasonNewDevice:
+			parts = append(parts, "device")
+		case model.LoginAlertReasonNewNetwork:
+			parts = append(parts, "network")
+		}
+	}
+
+	return strings.Join(parts, " and ") + " you have not used before"
+}
@@ -0,0 +1,186 @@
+package usecase_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"devoratio.dev/web-resume/config"
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/internal/hasher"
+	"devoratio.dev/web-resume/internal/mailer"
+	"devoratio.dev/web-resume/loginalert/usecase"
+	"devoratio.dev/web-resume/loginalert/usecase/notifiermock"
+	"devoratio.dev/web-resume/loginalert/usecase/repositorymock"
+	"devoratio.dev/web-resume/loginalert/usecase/usecasemock"
+	"devoratio.dev/web-resume/model"
+)
+
+var _ = Describe("Unfamiliar sign-in alerts", Label("loginalert"), func() {
+	var (
+		mockController *gomock.Controller
+
+		alertRepoMock            *repositorymock.MockLoginAlertRepository
+		passwordResetUsecaseMock *usecasemock.MockPasswordResetUsecase
+		mailerMock               *notifiermock.MockMailer
+		webhookMock              *notifiermock.MockWebhook
+		locatorMock              *notifiermock.MockLocator
+
+		commonCtx         context.Context
+		alertUsecase      *usecase.LoginAlert
+		ownerAccountStub  model.OwnerAccount
+		sessionStub       model.Session
+		knownSessionStubs []model.Session
+		appConfig         *config.Application
+	)
+
+	BeforeEach(func() {
+		gofakeit.Seed(time.Now().UnixNano())
+		mockController = gomock.NewController(GinkgoT())
+
+		alertRepoMock = repositorymock.NewMockLoginAlertRepository(mockController)
+		passwordResetUsecaseMock = usecasemock.NewMockPasswordResetUsecase(mockController)
+		mailerMock = notifiermock.NewMockMailer(mockController)
+		webhookMock = notifiermock.NewMockWebhook(mockController)
+		locatorMock = notifiermock.NewMockLocator(mockController)
+		appConfig = &config.Application{
+			Usecase: config.Usecase{
+				LoginAlert: config.LoginAlert{
+					Enabled:  true,
+					Channel:  usecase.ChannelMail,
+					TokenTTL: 72 * time.Hour,
+					URL:      "https://resume.devoratio.dev/report-login",
+				},
+			},
+		}
+
+		alertUsecase = usecase.NewUsecase(alertRepoMock, passwordResetUsecaseMock, mailerMock, webhookMock, locatorMock, appConfig)
+
+		gofakeit.Struct(&ownerAccountStub)
+		sessionStub = model.Session{
+			ID:         9,
+			OwnerID:    ownerAccountStub.ID,
+			DeviceName: "Firefox on Linux",
+			ClientIP:   "36.80.12.7",
+			CreatedAt:  time.Now(),
+		}
+		knownSessionStubs = []model.Session{
+			{ID: 8, OwnerID: ownerAccountStub.ID, DeviceName: "Firefox on Linux", ClientIP: "36.80.12.200"},
+		}
+
+		commonCtx = context.Background()
+	})
+
+	AfterEach(func() {
+		mockController.Finish()
+	})
+
+	When("a session is inspected", func() {
+		Context("it is the owner's first session", func() {
+			It("does not alert the owner", func(ctx SpecContext) {
+				alertRepoMock.EXPECT().ListPreviousSessions(commonCtx, ownerAccountStub.ID, sessionStub.ID, gomock.Any()).Return(nil, nil)
+
+				alertUsecase.Inspect(commonCtx, &ownerAccountStub.Owner, &sessionStub)
+			}, SpecTimeout(time.Second*2))
+		})
+
+		Context("it comes from a known device on a known network", func() {
+			It("does not alert the owner", func(ctx SpecContext) {
+				alertRepoMock.EXPECT().ListPreviousSessions(commonCtx, ownerAccountStub.ID, sessionStub.ID, gomock.Any()).Return(knownSessionStubs, nil)
+
+				alertUsecase.Inspect(commonCtx, &ownerAccountStub.Owner, &sessionStub)
+			}, SpecTimeout(time.Second*2))
+		})
+
+		Context("alerts are disabled", func() {
+			It("does not look at the session", func(ctx SpecContext) {
+				appConfig.Usecase.LoginAlert.Enabled = false
+
+				alertUsecase.Inspect(commonCtx, &ownerAccountStub.Owner, &sessionStub)
+			}, SpecTimeout(time.Second*2))
+		})
+
+		Context("it comes from a new device on a new network", func() {
+			It("emails the owner a link to report the session", func(ctx SpecContext) {
+				sent := make(chan mailer.Message, 1)
+				sessionStub.DeviceName = "Chrome on Android"
+				sessionStub.ClientIP = "1.0.0.7"
+
+				alertRepoMock.EXPECT().ListPreviousSessions(commonCtx, ownerAccountStub.ID, sessionStub.ID, gomock.Any()).Return(knownSessionStubs, nil)
+				alertRepoMock.EXPECT().CreateToken(commonCtx, gomock.Any()).DoAndReturn(
+					func(_ context.Context, token *model.OneTimeToken) error {
+						Expect(token.Purpose).Should(Equal(model.TokenPurposeLoginReport))
+						Expect(*token.SessionID).Should(Equal(sessionStub.ID))
+						Expect(token.ExpiresAt).Should(BeTemporally("~", time.Now().Add(72*time.Hour), time.Minute))
+						return nil
+					})
+				locatorMock.EXPECT().Locate("1.0.0.7").Return("South Brisbane, Queensland, AU")
+				mailerMock.EXPECT().Send(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, message mailer.Message) error {
+						sent <- message
+						return nil
+					})
+
+				alertUsecase.Inspect(commonCtx, &ownerAccountStub.Owner, &sessionStub)
+
+				var message mailer.Message
+				Eventually(sent).Should(Receive(&message))
+				Expect(message.To).Should(Equal(ownerAccountStub.Email))
+				Expect(message.Body).Should(ContainSubstring("device and network you have not used before"))
+				Expect(message.Body).Should(ContainSubstring("South Brisbane, Queensland, AU"))
+				Expect(message.Body).Should(ContainSubstring("https://resume.devoratio.dev/report-login?token="))
+			}, SpecTimeout(time.Second*2))
+		})
+
+		Context("it comes from a new network and the webhook channel is configured", func() {
+			It("posts the alert to the webhook", func(ctx SpecContext) {
+				sent := make(chan model.LoginAlert, 1)
+				appConfig.Usecase.LoginAlert.Channel = usecase.ChannelWebhook
+				sessionStub.ClientIP = "2001:df0::1"
+
+				alertRepoMock.EXPECT().ListPreviousSessions(commonCtx, ownerAccountStub.ID, sessionStub.ID, gomock.Any()).Return(knownSessionStubs, nil)
+				alertRepoMock.EXPECT().CreateToken(commonCtx, gomock.Any()).Return(nil)
+				locatorMock.EXPECT().Locate("2001:df0::1").Return("")
+				webhookMock.EXPECT().Send(gomock.Any(), "login.unfamiliar", gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ string, data interface{}) error {
+						sent <- data.(model.LoginAlert)
+						return nil
+					})
+
+				alertUsecase.Inspect(commonCtx, &ownerAccountStub.Owner, &sessionStub)
+
+				var alert model.LoginAlert
+				Eventually(sent).Should(Receive(&alert))
+				Expect(alert.Reasons).Should(Equal([]model.LoginAlertReason{model.LoginAlertReasonNewNetwork}))
+				Expect(alert.SessionID).Should(Equal(sessionStub.ID))
+			}, SpecTimeout(time.Second*2))
+		})
+	})
+
+	When("the owner reports a sign-in that wasn't them", func() {
+		var tokenHash = hasher.HashToken("reporttoken")
+
+		Context("the token is unknown, used or expired", func() {
+			It("tells the user that the link is invalid", func(ctx SpecContext) {
+				alertRepoMock.EXPECT().ReportSession(commonCtx, tokenHash, gomock.Any()).Return(nil, errorx.ErrNotFound)
+
+				err := alertUsecase.Report(commonCtx, "reporttoken")
+				Expect(err.(*errorx.Error).Type).Should(Equal(errorx.TypeInvalidParameter))
+			}, SpecTimeout(time.Second*2))
+		})
+
+		Context("the token is valid", func() {
+			It("revokes the session and sends the owner a password reset link", func(ctx SpecContext) {
+				alertRepoMock.EXPECT().ReportSession(commonCtx, tokenHash, gomock.Any()).Return(&ownerAccountStub, nil)
+				passwordResetUsecaseMock.EXPECT().RequestReset(commonCtx, ownerAccountStub.Username).Return(nil)
+
+				err := alertUsecase.Report(commonCtx, "reporttoken")
+				Expect(err).Should(BeNil())
+			}, SpecTimeout(time.Second*2))
+		})
+	})
+})
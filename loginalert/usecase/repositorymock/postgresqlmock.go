@@ -0,0 +1,81 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: devoratio.dev/web-resume/loginalert/usecase (interfaces: LoginAlertRepository)
+
+// Package repositorymock is a generated GoMock package.
+package repositorymock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	model "devoratio.dev/web-resume/model"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockLoginAlertRepository is a mock of LoginAlertRepository interface.
+type MockLoginAlertRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLoginAlertRepositoryMockRecorder
+}
+
+// MockLoginAlertRepositoryMockRecorder is the mock recorder for MockLoginAlertRepository.
+type MockLoginAlertRepositoryMockRecorder struct {
+	mock *MockLoginAlertRepository
+}
+
+// NewMockLoginAlertRepository creates a new mock instance.
+func NewMockLoginAlertRepository(ctrl *gomock.Controller) *MockLoginAlertRepository {
+	mock := &MockLoginAlertRepository{ctrl: ctrl}
+	mock.recorder = &MockLoginAlertRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLoginAlertRepository) EXPECT() *MockLoginAlertRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateToken mocks base method.
+func (m *MockLoginAlertRepository) CreateToken(arg0 context.Context, arg1 *model.OneTimeToken) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateToken", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateToken indicates an expected call of CreateToken.
+func (mr *MockLoginAlertRepositoryMockRecorder) CreateToken(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateToken", reflect.TypeOf((*MockLoginAlertRepository)(nil).CreateToken), arg0, arg1)
+}
+
+// ListPreviousSessions mocks base method.
+func (m *MockLoginAlertRepository) ListPreviousSessions(arg0 context.Context, arg1, arg2 uint, arg3 int) ([]model.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPreviousSessions", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]model.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPreviousSessions indicates an expected call of ListPreviousSessions.
+func (mr *MockLoginAlertRepositoryMockRecorder) ListPreviousSessions(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPreviousSessions", reflect.TypeOf((*MockLoginAlertRepository)(nil).ListPreviousSessions), arg0, arg1, arg2, arg3)
+}
+
+// ReportSession mocks base method.
+func (m *MockLoginAlertRepository) ReportSession(arg0 context.Context, arg1 string, arg2 time.Time) (*model.OwnerAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReportSession", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*model.OwnerAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReportSession indicates an expected call of ReportSession.
+func (mr *MockLoginAlertRepositoryMockRecorder) ReportSession(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReportSession", reflect.TypeOf((*MockLoginAlertRepository)(nil).ReportSession), arg0, arg1, arg2)
+}
@@ -0,0 +1,49 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: devoratio.dev/web-resume/loginalert/usecase (interfaces: PasswordResetUsecase)
+
+// Package usecasemock is a generated GoMock package.
+package usecasemock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockPasswordResetUsecase is a mock of PasswordResetUsecase interface.
+type MockPasswordResetUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockPasswordResetUsecaseMockRecorder
+}
+
+// MockPasswordResetUsecaseMockRecorder is the mock recorder for MockPasswordResetUsecase.
+type MockPasswordResetUsecaseMockRecorder struct {
+	mock *MockPasswordResetUsecase
+}
+
+// NewMockPasswordResetUsecase creates a new mock instance.
+func NewMockPasswordResetUsecase(ctrl *gomock.Controller) *MockPasswordResetUsecase {
+	mock := &MockPasswordResetUsecase{ctrl: ctrl}
+	mock.recorder = &MockPasswordResetUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPasswordResetUsecase) EXPECT() *MockPasswordResetUsecaseMockRecorder {
+	return m.recorder
+}
+
+// RequestReset mocks base method.
+func (m *MockPasswordResetUsecase) RequestReset(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestReset", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RequestReset indicates an expected call of RequestReset.
+func (mr *MockPasswordResetUsecaseMockRecorder) RequestReset(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestReset", reflect.TypeOf((*MockPasswordResetUsecase)(nil).RequestReset), arg0, arg1)
+}
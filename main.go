@@ -0,0 +1,68 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"devoratio.dev/web-resume/config"
+	"github.com/spf13/viper"
+)
+
+const usage = `usage: engine [-config file] <command> [arguments]
+
+commands:
+  serve     start the HTTP server (default)
+  migrate   bring the database schema up to date
+  setup     create the first owner account
+`
+
+func main() {
+	configFile := flag.String("config", "config.yaml", "path to the configuration file")
+	flag.Usage = func() { fmt.Fprint(flag.CommandLine.Output(), usage) }
+	flag.Parse()
+
+	ctx := context.Background()
+	appConfig, err := loadConfig(ctx, *configFile)
+	if err != nil {
+		log.Fatalf("failed to start: %s", err)
+	}
+
+	command, args := "serve", flag.Args()
+	if len(args) > 0 {
+		command, args = args[0], args[1:]
+	}
+
+	switch command {
+	case "serve":
+		err = serve(ctx, appConfig)
+	case "migrate":
+		err = migrate(appConfig)
+	case "setup":
+		err = setup(ctx, appConfig, args)
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if err != nil {
+		log.Fatalf("%s failed: %s", command, err)
+	}
+}
+
+func loadConfig(ctx context.Context, configFile string) (*config.Application, error) {
+	viper.SetConfigFile(configFile)
+	viper.SetEnvPrefix("webresume")
+	viper.SetEnvKeyReplacer(strings.NewReplacer(".", "_", "-", "_"))
+	viper.AutomaticEnv()
+
+	if err := viper.ReadInConfig(); err != nil {
+		log.Printf("failed to read config file: %s", err)
+		return nil, err
+	}
+
+	return config.Load(ctx)
+}
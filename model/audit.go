@@ -0,0 +1,70 @@
+package model
+
+import "time"
+
+type AuditMethod string
+
+const (
+	AuditMethodPassword  AuditMethod = "password"
+	AuditMethodMagicLink AuditMethod = "magic_link"
+	AuditMethodOAuth     AuditMethod = "oauth"
+	// AuditMethodPasswordReset records the requests for a password reset link
+	AuditMethodPasswordReset AuditMethod = "password_reset"
+	// AuditMethodRequest records a request turned away before reaching any
+	// sign-in method, its identifier is the route requested
+	AuditMethodRequest AuditMethod = "request"
+)
+
+type AuditEvent string
+
+const (
+	AuditEventSuccess           AuditEvent = "success"
+	AuditEventBadPassword       AuditEvent = "bad_password"
+	AuditEventUnknownIdentifier AuditEvent = "unknown_identifier"
+	AuditEventLocked            AuditEvent = "locked"
+	AuditEventMFAChallenge      AuditEvent = "mfa_challenge"
+	AuditEventRateLimited       AuditEvent = "rate_limited"
+	AuditEventLinkRequested     AuditEvent = "link_requested"
+	AuditEventInvalidToken      AuditEvent = "invalid_token"
+	AuditEventError             AuditEvent = "error"
+	AuditEventIPDenied          AuditEvent = "ip_denied"
+)
+
+// AuditOutcomeSuccess is the outcome of attempts that did not fail, failed
+// attempts store the errorx.Type they failed with
+const AuditOutcomeSuccess = "SUCCESS"
+
+// AuditLog is an append-only record of a sign-in attempt. OwnerID is only set
+// when the attempt could be tied to an owner, TenantID is the tenant it was
+// made on and zero for the base domain.
+type AuditLog struct {
+	ID         uint        `gorm:"primaryKey" json:"id"`
+	OwnerID    *uint       `gorm:"index" json:"owner_id,omitempty"`
+	TenantID   uint        `gorm:"not null;default:0;index" json:"-"`
+	Method     AuditMethod `gorm:"not null" json:"method"`
+	Event      AuditEvent  `gorm:"not null" json:"event"`
+	Outcome    string      `gorm:"not null" json:"outcome"`
+	Identifier string      `gorm:"not null" json:"identifier"`
+	ClientIP   string      `gorm:"not null" json:"client_ip"`
+	UserAgent  string      `gorm:"not null" json:"user_agent"`
+	RequestID  string      `gorm:"not null" json:"request_id"`
+	CreatedAt  time.Time   `gorm:"not null;index" json:"created_at"`
+}
+
+type AuditFilter struct {
+	Method   AuditMethod
+	Event    AuditEvent
+	Outcome  string
+	ClientIP string
+	Since    *time.Time
+	Until    *time.Time
+	Page     int
+	PerPage  int
+}
+
+type AuditPage struct {
+	Entries []AuditLog `json:"entries"`
+	Page    int        `json:"page"`
+	PerPage int        `json:"per_page"`
+	Total   int64      `json:"total"`
+}
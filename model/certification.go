@@ -0,0 +1,32 @@
+package model
+
+import "time"
+
+// Certification is a certification or license the owner holds
+type Certification struct {
+	ID              uint   `gorm:"primaryKey" json:"id"`
+	OwnerID         uint   `gorm:"not null;index" json:"-"`
+	Name            string `gorm:"not null" json:"name"`
+	Issuer          string `gorm:"not null" json:"issuer"`
+	CredentialID    string `gorm:"not null" json:"credential_id"`
+	VerificationURL string `gorm:"not null" json:"verification_url"`
+	IssuedOn        Date   `gorm:"type:date;not null" json:"issued_on"`
+	// ExpiresOn is left empty for credentials that never expire
+	ExpiresOn *Date `gorm:"type:date;index" json:"expires_on"`
+	// ReminderSentAt is when the owner was reminded of the expiry, it is
+	// cleared whenever the expiry changes
+	ReminderSentAt *time.Time `json:"-"`
+	// Expired is derived from ExpiresOn when the certification is read
+	Expired   bool      `gorm:"-" json:"expired"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type CertificationRequest struct {
+	Name            string `json:"name"`
+	Issuer          string `json:"issuer"`
+	CredentialID    string `json:"credential_id"`
+	VerificationURL string `json:"verification_url"`
+	IssuedOn        Date   `json:"issued_on"`
+	ExpiresOn       *Date  `json:"expires_on"`
+}
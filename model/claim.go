@@ -1,6 +1,56 @@
 package model
 
+import (
+	"slices"
+	"time"
+)
+
+const (
+	// RoleOwner is held by every owner, over their own resume and account
+	RoleOwner = "owner"
+	// RoleAdmin is held by the owner administering the deployment itself
+	RoleAdmin = "admin"
+)
+
+const (
+	ScopeResumeRead    = "resume:read"
+	ScopeResumeWrite   = "resume:write"
+	ScopeAnalyticsRead = "analytics:read"
+	// ScopeAccountManage covers the owner's credentials, sessions and tokens. It
+	// is only ever granted to the access tokens of a session.
+	ScopeAccountManage = "account:manage"
+)
+
+// SessionScopes are granted to the access tokens of a session
+var SessionScopes = []string{ScopeResumeRead, ScopeResumeWrite, ScopeAnalyticsRead, ScopeAccountManage}
+
 type Claim struct {
 	UserID   uint   `json:"userid"`
 	Username string `json:"username"`
+	// SessionID is the refresh token family of the session the token was
+	// issued to
+	SessionID string `json:"sessionid"`
+	// TenantID is the tenant whose host the token was issued on, the token is
+	// only accepted there. It is zero for the base domain.
+	TenantID uint `json:"tenantid,omitempty"`
+	// Roles and Scopes bound what the token can be used for, see HasRole and
+	// HasScope
+	Roles  []string `json:"roles,omitempty"`
+	Scopes []string `json:"scopes,omitempty"`
+	// PersonalTokenID is set when the request was authenticated with a personal
+	// access token instead of an access token
+	PersonalTokenID uint `json:"-"`
+
+	// TokenID is carried as the jti registered claim, a random one is used when
+	// it is empty. IssuedAt is populated when a token is verified.
+	TokenID  string    `json:"-"`
+	IssuedAt time.Time `json:"-"`
+}
+
+func (c Claim) HasRole(role string) bool {
+	return slices.Contains(c.Roles, role)
+}
+
+func (c Claim) HasScope(scope string) bool {
+	return slices.Contains(c.Scopes, scope)
 }
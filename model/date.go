@@ -0,0 +1,111 @@
+package model
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+const dateLayout = "2006-01-02"
+
+// Date is a calendar day, for the resume dates that need more precision than
+// a month. It is written as 2006-01-02 in JSON.
+type Date struct {
+	year  int
+	month time.Month
+	day   int
+}
+
+func NewDate(year int, month time.Month, day int) Date {
+	return DateOf(time.Date(year, month, day, 0, 0, 0, 0, time.UTC))
+}
+
+// DateOf returns the day t falls on in its own location
+func DateOf(t time.Time) Date {
+	return Date{year: t.Year(), month: t.Month(), day: t.Day()}
+}
+
+func ParseDate(value string) (Date, error) {
+	t, err := time.Parse(dateLayout, value)
+	if err != nil {
+		return Date{}, fmt.Errorf("invalid date %q, expected YYYY-MM-DD", value)
+	}
+
+	return DateOf(t), nil
+}
+
+func (d Date) IsZero() bool {
+	return d.year == 0
+}
+
+// Time returns the first instant of the day in UTC
+func (d Date) Time() time.Time {
+	return time.Date(d.year, d.month, d.day, 0, 0, 0, 0, time.UTC)
+}
+
+// AddDays returns the day days after d, or before it when days is negative
+func (d Date) AddDays(days int) Date {
+	return DateOf(d.Time().AddDate(0, 0, days))
+}
+
+func (d Date) Before(other Date) bool {
+	return d.Time().Before(other.Time())
+}
+
+func (d Date) After(other Date) bool {
+	return other.Before(d)
+}
+
+func (d Date) String() string {
+	if d.IsZero() {
+		return ""
+	}
+
+	return d.Time().Format(dateLayout)
+}
+
+func (d Date) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+func (d *Date) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	if value == "" {
+		*d = Date{}
+		return nil
+	}
+
+	date, err := ParseDate(value)
+	if err != nil {
+		return err
+	}
+
+	*d = date
+	return nil
+}
+
+func (d Date) Value() (driver.Value, error) {
+	if d.IsZero() {
+		return nil, nil
+	}
+
+	return d.Time(), nil
+}
+
+func (d *Date) Scan(src interface{}) error {
+	switch value := src.(type) {
+	case nil:
+		*d = Date{}
+	case time.Time:
+		*d = DateOf(value)
+	default:
+		return fmt.Errorf("cannot scan %T into a date", src)
+	}
+
+	return nil
+}
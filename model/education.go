@@ -0,0 +1,37 @@
+package model
+
+import "time"
+
+// Education is a degree or program the owner studied for
+type Education struct {
+	ID           uint   `gorm:"primaryKey" json:"id"`
+	OwnerID      uint   `gorm:"not null;index" json:"-"`
+	Institution  string `gorm:"not null" json:"institution"`
+	Degree       string `gorm:"not null" json:"degree"`
+	FieldOfStudy string `gorm:"not null" json:"field_of_study"`
+	Start        Month  `gorm:"type:date;not null" json:"start"`
+	// End is the expected graduation while still studying
+	End *Month `gorm:"type:date" json:"end"`
+	// Grade is given on GradeScale, such as a GPA of 3.8 on a scale of 4
+	Grade      *float64 `json:"grade"`
+	GradeScale *float64 `json:"grade_scale"`
+	Honors     string   `gorm:"not null" json:"honors"`
+	Coursework []string `gorm:"serializer:json;not null" json:"coursework"`
+	// Position is set once the owner reorders their education, entries
+	// without one follow in reverse chronological order
+	Position  *int      `json:"position"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type EducationRequest struct {
+	Institution  string   `json:"institution"`
+	Degree       string   `json:"degree"`
+	FieldOfStudy string   `json:"field_of_study"`
+	Start        Month    `json:"start"`
+	End          *Month   `json:"end"`
+	Grade        *float64 `json:"grade"`
+	GradeScale   *float64 `json:"grade_scale"`
+	Honors       string   `json:"honors"`
+	Coursework   []string `json:"coursework"`
+}
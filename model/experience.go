@@ -0,0 +1,60 @@
+package model
+
+import "time"
+
+type EmploymentType string
+
+const (
+	EmploymentFullTime   EmploymentType = "full_time"
+	EmploymentPartTime   EmploymentType = "part_time"
+	EmploymentContract   EmploymentType = "contract"
+	EmploymentFreelance  EmploymentType = "freelance"
+	EmploymentInternship EmploymentType = "internship"
+	EmploymentVolunteer  EmploymentType = "volunteer"
+)
+
+var EmploymentTypes = []EmploymentType{
+	EmploymentFullTime, EmploymentPartTime, EmploymentContract,
+	EmploymentFreelance, EmploymentInternship, EmploymentVolunteer,
+}
+
+// Experience is a role the owner held. Roles are listed most recent first
+// unless the owner put them in an order of their own, see Position.
+type Experience struct {
+	ID             uint           `gorm:"primaryKey" json:"id"`
+	OwnerID        uint           `gorm:"not null;index" json:"-"`
+	Company        string         `gorm:"not null" json:"company"`
+	Title          string         `gorm:"not null" json:"title"`
+	EmploymentType EmploymentType `gorm:"not null" json:"employment_type"`
+	Location       string         `gorm:"not null" json:"location"`
+	Start          Month          `gorm:"type:date;not null" json:"start"`
+	// End is left empty for the current role
+	End        *Month   `gorm:"type:date" json:"end"`
+	Current    bool     `gorm:"not null;default:false" json:"current"`
+	Highlights []string `gorm:"serializer:json;not null" json:"highlights"`
+	// Tags link the role to the skills with the same name or alias
+	Tags []string `gorm:"serializer:json;not null" json:"tags"`
+	// Position is set once the owner reorders their roles, roles without one
+	// follow in reverse chronological order
+	Position  *int      `json:"position"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type ExperienceRequest struct {
+	Company        string         `json:"company"`
+	Title          string         `json:"title"`
+	EmploymentType EmploymentType `json:"employment_type"`
+	Location       string         `json:"location"`
+	Start          Month          `json:"start"`
+	End            *Month         `json:"end"`
+	Current        bool           `json:"current"`
+	Highlights     []string       `json:"highlights"`
+	Tags           []string       `json:"tags"`
+}
+
+// OrderRequest lists the IDs of every entry of a section in the order they
+// are to be shown
+type OrderRequest struct {
+	IDs []uint `json:"ids"`
+}
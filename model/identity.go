@@ -0,0 +1,51 @@
+package model
+
+import "time"
+
+// ExternalIdentity links an account at an identity provider, such as GitHub,
+// to the owner so they can sign in with it instead of their password.
+type ExternalIdentity struct {
+	ID      uint `gorm:"primaryKey" json:"id"`
+	OwnerID uint `gorm:"not null;index" json:"-"`
+	// Provider is the name the provider is configured under
+	Provider string `gorm:"not null;uniqueIndex:idx_external_identity_subject" json:"provider"`
+	// Subject is the stable ID of the account at the provider
+	Subject    string     `gorm:"not null;uniqueIndex:idx_external_identity_subject" json:"subject"`
+	Username   string     `json:"username"`
+	Email      string     `json:"email"`
+	CreatedAt  time.Time  `json:"created_at"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+}
+
+// ExternalProfile is what an identity provider tells about the account that
+// went through its authorization flow
+type ExternalProfile struct {
+	Provider      string
+	Subject       string
+	Username      string
+	Email         string
+	EmailVerified bool
+}
+
+type OAuthPurpose string
+
+const (
+	OAuthPurposeLogin OAuthPurpose = "login"
+	OAuthPurposeLink  OAuthPurpose = "link"
+)
+
+// OAuthState is an authorization request sent to an identity provider and
+// waiting for its callback. Only the hash of the state is stored, the PKCE
+// code verifier never leaves the server.
+type OAuthState struct {
+	ID        uint         `gorm:"primaryKey"`
+	StateHash string       `gorm:"not null;uniqueIndex"`
+	Provider  string       `gorm:"not null"`
+	Purpose   OAuthPurpose `gorm:"not null"`
+	// OwnerID is the owner linking an identity, it is empty for sign-ins
+	OwnerID      *uint
+	CodeVerifier string    `gorm:"not null"`
+	ExpiresAt    time.Time `gorm:"not null"`
+	UsedAt       *time.Time
+	CreatedAt    time.Time `gorm:"not null"`
+}
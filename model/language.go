@@ -0,0 +1,37 @@
+package model
+
+import "time"
+
+// LanguageLevel is a level of the Common European Framework of Reference for
+// Languages, or native
+type LanguageLevel string
+
+const (
+	LanguageA1     LanguageLevel = "A1"
+	LanguageA2     LanguageLevel = "A2"
+	LanguageB1     LanguageLevel = "B1"
+	LanguageB2     LanguageLevel = "B2"
+	LanguageC1     LanguageLevel = "C1"
+	LanguageC2     LanguageLevel = "C2"
+	LanguageNative LanguageLevel = "native"
+)
+
+// LanguageLevels lists the levels from the lowest to the highest
+var LanguageLevels = []LanguageLevel{
+	LanguageA1, LanguageA2, LanguageB1, LanguageB2, LanguageC1, LanguageC2, LanguageNative,
+}
+
+// Language is a language the owner speaks
+type Language struct {
+	ID        uint          `gorm:"primaryKey" json:"id"`
+	OwnerID   uint          `gorm:"not null;index" json:"-"`
+	Name      string        `gorm:"not null" json:"name"`
+	Level     LanguageLevel `gorm:"not null" json:"level"`
+	CreatedAt time.Time     `json:"created_at"`
+	UpdatedAt time.Time     `json:"updated_at"`
+}
+
+type LanguageRequest struct {
+	Name  string        `json:"name"`
+	Level LanguageLevel `json:"level"`
+}
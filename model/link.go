@@ -0,0 +1,38 @@
+package model
+
+import "time"
+
+type LinkType string
+
+const (
+	LinkGitHub   LinkType = "github"
+	LinkLinkedIn LinkType = "linkedin"
+	LinkMastodon LinkType = "mastodon"
+	LinkWebsite  LinkType = "website"
+	LinkEmail    LinkType = "email"
+)
+
+// LinkTypes lists the link types in the order links are shown
+var LinkTypes = []LinkType{LinkEmail, LinkWebsite, LinkGitHub, LinkLinkedIn, LinkMastodon}
+
+// Link is a way to contact the owner or find them elsewhere
+type Link struct {
+	ID      uint     `gorm:"primaryKey" json:"id"`
+	OwnerID uint     `gorm:"not null;index" json:"-"`
+	Type    LinkType `gorm:"not null" json:"type"`
+	// Value is the normalized URL of the link, or the address of an email link
+	Value string `gorm:"not null" json:"value"`
+	Label string `gorm:"not null" json:"label"`
+	// Public links are shown on the public resume, the others only to the
+	// owner
+	Public    bool      `gorm:"not null;default:false" json:"public"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type LinkRequest struct {
+	Type   LinkType `json:"type"`
+	Value  string   `json:"value"`
+	Label  string   `json:"label"`
+	Public bool     `json:"public"`
+}
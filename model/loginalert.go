@@ -0,0 +1,27 @@
+package model
+
+import "time"
+
+type LoginAlertReason string
+
+const (
+	LoginAlertReasonNewDevice  LoginAlertReason = "new_device"
+	LoginAlertReasonNewNetwork LoginAlertReason = "new_network"
+)
+
+// LoginAlert tells the owner about a sign-in from a device or network that
+// none of their previous sessions came from.
+type LoginAlert struct {
+	OwnerID    uint               `json:"owner_id"`
+	Username   string             `json:"username"`
+	SessionID  uint               `json:"session_id"`
+	Reasons    []LoginAlertReason `json:"reasons"`
+	DeviceName string             `json:"device_name"`
+	ClientIP   string             `json:"client_ip"`
+	UserAgent  string             `json:"user_agent"`
+	// Location is approximated from the client IP, it is empty when unknown
+	Location   string    `json:"location"`
+	SignedInAt time.Time `json:"signed_in_at"`
+	// ReportURL signs the session out and requires a password reset
+	ReportURL string `json:"report_url"`
+}
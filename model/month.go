@@ -0,0 +1,116 @@
+package model
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+const monthLayout = "2006-01"
+
+// Month is a calendar month, the precision resume dates are given in. It is
+// written as 2006-01 in JSON and stored as the first day of the month.
+type Month struct {
+	year  int
+	month time.Month
+}
+
+func NewMonth(year int, month time.Month) Month {
+	return MonthOf(time.Date(year, month, 1, 0, 0, 0, 0, time.UTC))
+}
+
+// MonthOf returns the month t falls in
+func MonthOf(t time.Time) Month {
+	return Month{year: t.Year(), month: t.Month()}
+}
+
+func ParseMonth(value string) (Month, error) {
+	t, err := time.Parse(monthLayout, value)
+	if err != nil {
+		return Month{}, fmt.Errorf("invalid month %q, expected YYYY-MM", value)
+	}
+
+	return MonthOf(t), nil
+}
+
+func (m Month) IsZero() bool {
+	return m.year == 0
+}
+
+// Time returns the first instant of the month in UTC
+func (m Month) Time() time.Time {
+	return time.Date(m.year, m.month, 1, 0, 0, 0, 0, time.UTC)
+}
+
+// Next returns the month following m
+func (m Month) Next() Month {
+	return MonthOf(m.Time().AddDate(0, 1, 0))
+}
+
+// MonthsUntil returns the number of months from m to other, negative when
+// other comes first
+func (m Month) MonthsUntil(other Month) int {
+	return (other.year-m.year)*12 + int(other.month) - int(m.month)
+}
+
+func (m Month) Before(other Month) bool {
+	return m.year < other.year || (m.year == other.year && m.month < other.month)
+}
+
+func (m Month) After(other Month) bool {
+	return other.Before(m)
+}
+
+func (m Month) String() string {
+	if m.IsZero() {
+		return ""
+	}
+
+	return m.Time().Format(monthLayout)
+}
+
+func (m Month) MarshalJSON() ([]byte, error) {
+	return json.Marshal(m.String())
+}
+
+func (m *Month) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	if value == "" {
+		*m = Month{}
+		return nil
+	}
+
+	month, err := ParseMonth(value)
+	if err != nil {
+		return err
+	}
+
+	*m = month
+	return nil
+}
+
+func (m Month) Value() (driver.Value, error) {
+	if m.IsZero() {
+		return nil, nil
+	}
+
+	return m.Time(), nil
+}
+
+func (m *Month) Scan(src interface{}) error {
+	switch value := src.(type) {
+	case nil:
+		*m = Month{}
+	case time.Time:
+		*m = MonthOf(value)
+	default:
+		return fmt.Errorf("cannot scan %T into a month", src)
+	}
+
+	return nil
+}
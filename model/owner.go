@@ -3,20 +3,40 @@ package model
 import "time"
 
 type Owner struct {
-	ID       uint   `gorm:"primaryKey"`
-	Username string `gorm:"unique"`
-	FistName string `gorm:"not null"`
-	LastName string `gorm:"not null"`
-	Email    string `gorm:"not null"`
+	ID       uint   `gorm:"primaryKey" json:"id"`
+	Username string `gorm:"unique" json:"username"`
+	FistName string `gorm:"not null" json:"first_name"`
+	LastName string `gorm:"not null" json:"last_name"`
+	Email    string `gorm:"not null" json:"email"`
+
+	EmailVerified   bool       `gorm:"not null;default:false" json:"email_verified"`
+	EmailVerifiedAt *time.Time `json:"email_verified_at,omitempty"`
+	// SiteAdmin is set for the owner administering the deployment, the first
+	// owner created by the setup
+	SiteAdmin bool `gorm:"not null;default:false" json:"site_admin"`
 }
 
 func (o *Owner) FullName() string {
 	return o.FistName + " " + o.LastName
 }
 
+// Roles returns the roles carried by the access tokens issued to the owner
+func (o *Owner) Roles() []string {
+	if o.SiteAdmin {
+		return []string{RoleOwner, RoleAdmin}
+	}
+
+	return []string{RoleOwner}
+}
+
 type OwnerAccount struct {
 	Owner
-	Password  string    `gorm:"not null"`
-	CreatedAt time.Time `gorm:"not null"`
-	UpdatedAt time.Time `gorm:"not null"`
+	Password string `gorm:"not null" json:"-"`
+	// PasswordChangedAt invalidates every token issued before it
+	PasswordChangedAt *time.Time
+	// PasswordResetRequired blocks sign-in with the password until the owner
+	// resets it, after they reported a sign-in that wasn't them
+	PasswordResetRequired bool      `gorm:"not null;default:false"`
+	CreatedAt             time.Time `gorm:"not null"`
+	UpdatedAt             time.Time `gorm:"not null"`
 }
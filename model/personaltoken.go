@@ -0,0 +1,35 @@
+package model
+
+import "time"
+
+// PersonalTokenPrefix starts every personal access token, so they can be told
+// apart from access tokens and spotted by secret scanners
+const PersonalTokenPrefix = "wrpat_"
+
+// PersonalTokenScopes lists every scope a personal access token can be granted
+var PersonalTokenScopes = []string{ScopeResumeRead, ScopeResumeWrite, ScopeAnalyticsRead}
+
+// PersonalToken is a long-lived token the owner creates for scripts, such as a
+// CI job pushing resume updates. It can only do what its scopes allow and only
+// the hash of the token is stored.
+type PersonalToken struct {
+	ID         uint       `gorm:"primaryKey" json:"id"`
+	OwnerID    uint       `gorm:"not null;index" json:"-"`
+	Name       string     `gorm:"not null" json:"name"`
+	TokenHash  string     `gorm:"not null;uniqueIndex" json:"-"`
+	Scopes     []string   `gorm:"not null;serializer:json" json:"scopes"`
+	CreatedAt  time.Time  `json:"created_at"`
+	ExpiresAt  *time.Time `json:"expires_at"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+	RevokedAt  *time.Time `json:"-"`
+
+	// Token is only set in the response to creating the token
+	Token string `gorm:"-" json:"token,omitempty"`
+}
+
+type PersonalTokenRequest struct {
+	Name   string   `json:"name"`
+	Scopes []string `json:"scopes"`
+	// ExpiresAt is optional, a token without it lasts until it is revoked
+	ExpiresAt *time.Time `json:"expires_at"`
+}
@@ -0,0 +1,43 @@
+package model
+
+import "time"
+
+// Availability tells visitors whether the owner is open to new opportunities
+type Availability string
+
+const (
+	AvailabilityNotLooking   Availability = "not_looking"
+	AvailabilityOpenToOffers Availability = "open_to_offers"
+	AvailabilityLooking      Availability = "looking"
+)
+
+var Availabilities = []Availability{AvailabilityNotLooking, AvailabilityOpenToOffers, AvailabilityLooking}
+
+// Profile is the introduction at the top of the owner's resume
+type Profile struct {
+	ID       uint   `gorm:"primaryKey" json:"-"`
+	OwnerID  uint   `gorm:"not null;uniqueIndex" json:"-"`
+	Headline string `gorm:"not null" json:"headline"`
+	Summary  string `gorm:"not null" json:"summary"`
+	Location string `gorm:"not null" json:"location"`
+	Phone    string `gorm:"not null" json:"phone"`
+	// PhonePublic shows the phone number on the public resume, it is otherwise
+	// only shown to the owner
+	PhonePublic bool   `gorm:"not null;default:false" json:"phone_public"`
+	Website     string `gorm:"not null" json:"website"`
+	// Avatar is the URL of the owner's picture, which is hosted elsewhere
+	Avatar       string       `gorm:"not null" json:"avatar"`
+	Availability Availability `gorm:"not null" json:"availability"`
+	UpdatedAt    time.Time    `json:"updated_at"`
+}
+
+type ProfileRequest struct {
+	Headline     string       `json:"headline"`
+	Summary      string       `json:"summary"`
+	Location     string       `json:"location"`
+	Phone        string       `json:"phone"`
+	PhonePublic  bool         `json:"phone_public"`
+	Website      string       `json:"website"`
+	Avatar       string       `json:"avatar"`
+	Availability Availability `json:"availability"`
+}
@@ -0,0 +1,47 @@
+package model
+
+import "time"
+
+// ProjectImage is a picture in the gallery of a project, which is hosted
+// elsewhere
+type ProjectImage struct {
+	URL     string `json:"url"`
+	Caption string `json:"caption"`
+}
+
+// Project is a piece of work the owner shows in their portfolio. Featured
+// projects come first unless the owner put them in an order of their own, see
+// Position.
+type Project struct {
+	ID            uint   `gorm:"primaryKey" json:"id"`
+	OwnerID       uint   `gorm:"not null;index" json:"-"`
+	Title         string `gorm:"not null" json:"title"`
+	Role          string `gorm:"not null" json:"role"`
+	Description   string `gorm:"not null" json:"description"`
+	RepositoryURL string `gorm:"not null" json:"repository_url"`
+	DemoURL       string `gorm:"not null" json:"demo_url"`
+	// Tags link the project to the skills with the same name or alias
+	Tags  []string `gorm:"serializer:json;not null" json:"tags"`
+	Start Month    `gorm:"type:date;not null" json:"start"`
+	// End is left empty while the project is ongoing
+	End      *Month `gorm:"type:date" json:"end"`
+	Featured bool   `gorm:"not null;default:false" json:"featured"`
+	// Images are shown in the order they are listed
+	Images    []ProjectImage `gorm:"serializer:json;not null" json:"images"`
+	Position  *int           `json:"position"`
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at"`
+}
+
+type ProjectRequest struct {
+	Title         string         `json:"title"`
+	Role          string         `json:"role"`
+	Description   string         `json:"description"`
+	RepositoryURL string         `json:"repository_url"`
+	DemoURL       string         `json:"demo_url"`
+	Tags          []string       `json:"tags"`
+	Start         Month          `json:"start"`
+	End           *Month         `json:"end"`
+	Featured      bool           `json:"featured"`
+	Images        []ProjectImage `json:"images"`
+}
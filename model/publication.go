@@ -0,0 +1,50 @@
+package model
+
+import "time"
+
+type PublicationType string
+
+const (
+	PublicationArticle      PublicationType = "article"
+	PublicationTalk         PublicationType = "talk"
+	PublicationContribution PublicationType = "contribution"
+)
+
+var PublicationTypes = []PublicationType{PublicationArticle, PublicationTalk, PublicationContribution}
+
+// Publication is an article the owner wrote, a talk they gave or a notable
+// open source contribution they made. Some fields only apply to one type.
+type Publication struct {
+	ID          uint            `gorm:"primaryKey" json:"id"`
+	OwnerID     uint            `gorm:"not null;index" json:"-"`
+	Type        PublicationType `gorm:"not null" json:"type"`
+	Title       string          `gorm:"not null" json:"title"`
+	Description string          `gorm:"not null" json:"description"`
+	URL         string          `gorm:"not null" json:"url"`
+	Date        Date            `gorm:"type:date;not null" json:"date"`
+	// Venue is the journal or blog an article appeared in, or the event a
+	// talk was given at
+	Venue string `gorm:"not null" json:"venue"`
+	// DOI of an article, without the https://doi.org/ prefix
+	DOI string `gorm:"not null" json:"doi"`
+	// SlidesURL and VideoURL are those of a talk
+	SlidesURL string `gorm:"not null" json:"slides_url"`
+	VideoURL  string `gorm:"not null" json:"video_url"`
+	// PullRequests are the links to the pull requests of a contribution
+	PullRequests []string  `gorm:"serializer:json;not null" json:"pull_requests"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+type PublicationRequest struct {
+	Type         PublicationType `json:"type"`
+	Title        string          `json:"title"`
+	Description  string          `json:"description"`
+	URL          string          `json:"url"`
+	Date         Date            `json:"date"`
+	Venue        string          `json:"venue"`
+	DOI          string          `json:"doi"`
+	SlidesURL    string          `json:"slides_url"`
+	VideoURL     string          `json:"video_url"`
+	PullRequests []string        `json:"pull_requests"`
+}
@@ -0,0 +1,17 @@
+package model
+
+// Resume is the public view of everything the owner put on their resume
+type Resume struct {
+	// Variant is the slug of the variant the resume is tailored by, if any
+	Variant         string          `json:"variant,omitempty"`
+	Profile         Profile         `json:"profile"`
+	Experiences     []Experience    `json:"experiences"`
+	Education       []Education     `json:"education"`
+	Projects        []Project       `json:"projects"`
+	SkillCategories []SkillCategory `json:"skill_categories"`
+	Skills          []Skill         `json:"skills"`
+	Certifications  []Certification `json:"certifications"`
+	Publications    []Publication   `json:"publications"`
+	Languages       []Language      `json:"languages"`
+	Links           []Link          `json:"links"`
+}
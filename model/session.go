@@ -0,0 +1,45 @@
+package model
+
+import "time"
+
+// Session is a device the owner signed in from. It lives from the login until
+// it is revoked or its refresh token expires, across every rotation of the
+// refresh token family it is tied to.
+type Session struct {
+	ID      uint `gorm:"primaryKey" json:"id"`
+	OwnerID uint `gorm:"not null;index" json:"-"`
+	// TenantID is the tenant the owner signed in on, zero for the base domain
+	TenantID uint `gorm:"not null;default:0" json:"-"`
+	// FamilyID is shared by every refresh token issued to the session and is
+	// carried by its access tokens as the session ID
+	FamilyID string `gorm:"not null;uniqueIndex" json:"-"`
+	// TokenID is the jti of the latest access token issued to the session
+	TokenID    string     `gorm:"not null" json:"-"`
+	DeviceName string     `gorm:"not null" json:"device_name"`
+	ClientIP   string     `json:"client_ip"`
+	UserAgent  string     `json:"user_agent"`
+	CreatedAt  time.Time  `json:"created_at"`
+	LastSeenAt time.Time  `gorm:"not null" json:"last_seen_at"`
+	ExpiresAt  time.Time  `gorm:"not null" json:"expires_at"`
+	RevokedAt  *time.Time `json:"-"`
+
+	// Current marks the session the listing was requested from
+	Current bool `gorm:"-" json:"current"`
+}
+
+// RefreshToken is a single-use token of a session. Using it yields a new
+// access token and the next refresh token of the family.
+type RefreshToken struct {
+	ID        uint      `gorm:"primaryKey"`
+	FamilyID  string    `gorm:"not null;index"`
+	TokenHash string    `gorm:"not null;uniqueIndex"`
+	ExpiresAt time.Time `gorm:"not null"`
+	UsedAt    *time.Time
+	CreatedAt time.Time
+}
+
+type TokenPair struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+	TokenType    string `json:"token_type"`
+}
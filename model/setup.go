@@ -0,0 +1,20 @@
+package model
+
+import "time"
+
+// SetupState holds a single row once the first owner has been created. The row
+// is never removed, which keeps the setup flow locked even if the owner is
+// deleted afterwards.
+type SetupState struct {
+	ID          uint      `gorm:"primaryKey;autoIncrement:false"`
+	OwnerID     uint      `gorm:"not null"`
+	CompletedAt time.Time `gorm:"not null"`
+}
+
+type OwnerRegistration struct {
+	Username  string `json:"username"`
+	FirstName string `json:"first_name"`
+	LastName  string `json:"last_name"`
+	Email     string `json:"email"`
+	Password  string `json:"password"`
+}
@@ -0,0 +1,74 @@
+package model
+
+import "time"
+
+type Proficiency string
+
+const (
+	ProficiencyBeginner     Proficiency = "beginner"
+	ProficiencyIntermediate Proficiency = "intermediate"
+	ProficiencyAdvanced     Proficiency = "advanced"
+	ProficiencyExpert       Proficiency = "expert"
+)
+
+var Proficiencies = []Proficiency{
+	ProficiencyBeginner, ProficiencyIntermediate, ProficiencyAdvanced, ProficiencyExpert,
+}
+
+// SkillCategory groups the skills of the owner, such as languages or tools
+type SkillCategory struct {
+	ID        uint      `gorm:"primaryKey" json:"id"`
+	OwnerID   uint      `gorm:"not null;index" json:"-"`
+	Name      string    `gorm:"not null" json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type SkillCategoryRequest struct {
+	Name string `json:"name"`
+}
+
+// Skill is known by its canonical Name and any of its Aliases, entries are
+// linked to a skill by tagging them with either
+type Skill struct {
+	ID          uint        `gorm:"primaryKey" json:"id"`
+	OwnerID     uint        `gorm:"not null;index" json:"-"`
+	CategoryID  *uint       `gorm:"index" json:"category_id"`
+	Name        string      `gorm:"not null" json:"name"`
+	Aliases     []string    `gorm:"-" json:"aliases"`
+	Proficiency Proficiency `gorm:"not null" json:"proficiency"`
+	YearsOfUse  *int        `json:"years_of_use"`
+	// ExperienceMonths is derived from the date ranges of the entries tagged
+	// with the skill, months they overlap in are counted once.
+	// ExperienceYears is the same rounded to a tenth of a year.
+	ExperienceMonths int       `gorm:"-" json:"experience_months"`
+	ExperienceYears  float64   `gorm:"-" json:"experience_years"`
+	CreatedAt        time.Time `json:"created_at"`
+	UpdatedAt        time.Time `json:"updated_at"`
+}
+
+type SkillRequest struct {
+	CategoryID  *uint       `json:"category_id"`
+	Name        string      `json:"name"`
+	Aliases     []string    `json:"aliases"`
+	Proficiency Proficiency `json:"proficiency"`
+	YearsOfUse  *int        `json:"years_of_use"`
+}
+
+// SkillAlias maps a name of a skill to it. The canonical name of every skill
+// is stored as an alias too, so a name only ever belongs to one skill of the
+// owner.
+type SkillAlias struct {
+	ID      uint   `gorm:"primaryKey"`
+	OwnerID uint   `gorm:"not null;uniqueIndex:idx_skill_aliases_owner_key"`
+	SkillID uint   `gorm:"not null;index"`
+	Name    string `gorm:"not null"`
+	// Key is the normalized name, see identifier.Normalize
+	Key string `gorm:"not null;uniqueIndex:idx_skill_aliases_owner_key"`
+}
+
+// SkillReferences is a skill with the entries tagged with it
+type SkillReferences struct {
+	Skill       Skill        `json:"skill"`
+	Experiences []Experience `json:"experiences"`
+	Projects    []Project    `json:"projects"`
+}
@@ -0,0 +1,19 @@
+package model
+
+import "time"
+
+// Tenant hosts the resume of a single owner on a subdomain of the base domain,
+// or on a custom host pointed at the deployment
+type Tenant struct {
+	ID        uint      `gorm:"primaryKey" json:"id"`
+	OwnerID   uint      `gorm:"not null;uniqueIndex" json:"owner_id"`
+	Slug      string    `gorm:"not null;uniqueIndex" json:"slug"`
+	Host      *string   `gorm:"uniqueIndex" json:"host,omitempty"`
+	CreatedAt time.Time `gorm:"not null" json:"created_at"`
+}
+
+type TenantRequest struct {
+	Slug  string            `json:"slug"`
+	Host  *string           `json:"host"`
+	Owner OwnerRegistration `json:"owner"`
+}
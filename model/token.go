@@ -0,0 +1,29 @@
+package model
+
+import "time"
+
+type TokenPurpose string
+
+const (
+	TokenPurposePasswordReset     TokenPurpose = "password_reset"
+	TokenPurposeEmailVerification TokenPurpose = "email_verification"
+	TokenPurposeMagicLink         TokenPurpose = "magic_link"
+	TokenPurposeLoginReport       TokenPurpose = "login_report"
+)
+
+// OneTimeToken is a single-use token delivered to the owner out of band. Only
+// the hash of the token is stored.
+type OneTimeToken struct {
+	ID        uint         `gorm:"primaryKey"`
+	OwnerID   uint         `gorm:"not null;index"`
+	Purpose   TokenPurpose `gorm:"not null"`
+	TokenHash string       `gorm:"not null;uniqueIndex"`
+	// Email is the address the token was sent to, when it matters for its purpose
+	Email string
+	// SessionID is the session the token is about, when it matters for its
+	// purpose
+	SessionID *uint
+	ExpiresAt time.Time `gorm:"not null"`
+	UsedAt    *time.Time
+	CreatedAt time.Time `gorm:"not null"`
+}
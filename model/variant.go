@@ -0,0 +1,38 @@
+package model
+
+import "time"
+
+// Variant is a version of the resume tailored to a kind of role. It shows a
+// subset of the experiences, projects and skills in its own order and may
+// replace the headline and summary of the profile.
+type Variant struct {
+	ID      uint   `gorm:"primaryKey" json:"id"`
+	OwnerID uint   `gorm:"not null;uniqueIndex:idx_variants_owner_slug" json:"-"`
+	Name    string `gorm:"not null" json:"name"`
+	// Slug addresses the variant on the public resume
+	Slug string `gorm:"not null;uniqueIndex:idx_variants_owner_slug" json:"slug"`
+	// Default is the variant shown as the public resume, an owner has at most
+	// one
+	Default bool `gorm:"column:is_default;not null;default:false" json:"default"`
+	// Headline and Summary replace those of the profile unless they are empty
+	Headline string `gorm:"not null" json:"headline"`
+	Summary  string `gorm:"not null" json:"summary"`
+	// ExperienceIDs, ProjectIDs and SkillIDs are the entries the variant
+	// shows, in the order they are shown
+	ExperienceIDs []uint    `gorm:"serializer:json;not null" json:"experience_ids"`
+	ProjectIDs    []uint    `gorm:"serializer:json;not null" json:"project_ids"`
+	SkillIDs      []uint    `gorm:"serializer:json;not null" json:"skill_ids"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+type VariantRequest struct {
+	Name          string `json:"name"`
+	Slug          string `json:"slug"`
+	Default       bool   `json:"default"`
+	Headline      string `json:"headline"`
+	Summary       string `json:"summary"`
+	ExperienceIDs []uint `json:"experience_ids"`
+	ProjectIDs    []uint `json:"project_ids"`
+	SkillIDs      []uint `json:"skill_ids"`
+}
@@ -0,0 +1,55 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/internal/httpx"
+	"devoratio.dev/web-resume/model"
+)
+
+type OwnerUsecase interface {
+	ChangePassword(ctx context.Context, claim model.Claim, currentPassword, newPassword string) error
+}
+
+type HTTP struct {
+	ownerUsecase OwnerUsecase
+}
+
+func NewHTTP(ownerUsecase OwnerUsecase) *HTTP {
+	return &HTTP{
+		ownerUsecase: ownerUsecase,
+	}
+}
+
+func (h *HTTP) RegisterRoutes(mux *http.ServeMux, authenticate httpx.Middleware) {
+	mux.Handle("PUT /v1/owner/password", authenticate(http.HandlerFunc(h.changePassword)))
+}
+
+type changePasswordRequest struct {
+	CurrentPassword string `json:"current_password"`
+	NewPassword     string `json:"new_password"`
+}
+
+func (h *HTTP) changePassword(w http.ResponseWriter, r *http.Request) {
+	claim, ok := httpx.ClaimFromContext(r.Context())
+	if !ok {
+		httpx.WriteError(w, errorx.ErrUnauthorized)
+		return
+	}
+
+	var request changePasswordRequest
+	if err := httpx.DecodeJSON(w, r, &request); err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	err := h.ownerUsecase.ChangePassword(r.Context(), *claim, request.CurrentPassword, request.NewPassword)
+	if err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	httpx.WriteNoContent(w)
+}
@@ -0,0 +1,56 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/model"
+	"gorm.io/gorm"
+)
+
+type PostgreSQLDatabase struct {
+	db *gorm.DB
+}
+
+func NewPostgreSQL(db *gorm.DB) *PostgreSQLDatabase {
+	return &PostgreSQLDatabase{
+		db: db,
+	}
+}
+
+func (p *PostgreSQLDatabase) UpdatePassword(ctx context.Context, ownerID uint, hashedPassword string, changedAt time.Time) error {
+	err := p.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&model.OwnerAccount{}).Where("id = ?", ownerID).Updates(map[string]interface{}{
+			"password":            hashedPassword,
+			"password_changed_at": changedAt,
+		})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		// Refresh tokens outlive access tokens, so their sessions are ended too
+		err := tx.Model(&model.Session{}).
+			Where("owner_id = ? AND revoked_at IS NULL", ownerID).
+			Update("revoked_at", changedAt).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Model(&model.PersonalToken{}).
+			Where("owner_id = ? AND revoked_at IS NULL", ownerID).
+			Update("revoked_at", changedAt).Error
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errorx.ErrNotFound
+		}
+		return errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), err)
+	}
+
+	return nil
+}
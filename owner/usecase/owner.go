@@ -0,0 +1,67 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/internal/hasher"
+	"devoratio.dev/web-resume/internal/policy"
+	"devoratio.dev/web-resume/model"
+)
+
+const (
+	invalidCurrentPasswordMessage = "current password is invalid"
+	samePasswordMessage           = "new password must be different from the current password"
+)
+
+//go:generate mockgen -destination=usecasemock/authenticationmock.go -package=usecasemock . AuthenticationUsecase
+type AuthenticationUsecase interface {
+	Authenticate(ctx context.Context, identifier, password string) (*model.Owner, error)
+}
+
+//go:generate mockgen -destination=repositorymock/postgresqlmock.go -package=repositorymock . OwnerRepository
+type OwnerRepository interface {
+	UpdatePassword(ctx context.Context, ownerID uint, hashedPassword string, changedAt time.Time) error
+}
+
+type Owner struct {
+	authUsecase AuthenticationUsecase
+	ownerRepo   OwnerRepository
+}
+
+func NewUsecase(authUsecase AuthenticationUsecase, ownerRepo OwnerRepository) *Owner {
+	return &Owner{
+		authUsecase: authUsecase,
+		ownerRepo:   ownerRepo,
+	}
+}
+
+// ChangePassword replaces the password of the authenticated owner. Every token
+// issued before the change stops being accepted.
+func (o *Owner) ChangePassword(ctx context.Context, claim model.Claim, currentPassword, newPassword string) error {
+	owner, err := o.authUsecase.Authenticate(ctx, claim.Username, currentPassword)
+	if err != nil {
+		if errorx.Is(err, errorx.ErrInvalidParameter) {
+			return errorx.New(errorx.TypeInvalidParameter, invalidCurrentPasswordMessage, err)
+		}
+		return err
+	}
+	if owner.ID != claim.UserID {
+		return errorx.ErrForbidden
+	}
+
+	if err := policy.ValidatePassword(newPassword); err != nil {
+		return err
+	}
+	if newPassword == currentPassword {
+		return errorx.New(errorx.TypeInvalidParameter, samePasswordMessage, nil)
+	}
+
+	hashedPassword, err := hasher.GenerateFromPassword(newPassword)
+	if err != nil {
+		return err
+	}
+
+	return o.ownerRepo.UpdatePassword(ctx, owner.ID, hashedPassword, time.Now())
+}
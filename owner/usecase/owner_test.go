@@ -0,0 +1,100 @@
+package usecase_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/internal/hasher"
+	"devoratio.dev/web-resume/model"
+	"devoratio.dev/web-resume/owner/usecase"
+	"devoratio.dev/web-resume/owner/usecase/repositorymock"
+	"devoratio.dev/web-resume/owner/usecase/usecasemock"
+)
+
+var _ = Describe("Change owner password", Label("owner"), func() {
+	var (
+		mockController *gomock.Controller
+
+		authenticationUsecaseMock *usecasemock.MockAuthenticationUsecase
+		ownerRepoMock             *repositorymock.MockOwnerRepository
+
+		ownerUsecase    *usecase.Owner
+		commonCtx       context.Context
+		ownerStub       model.Owner
+		claim           model.Claim
+		currentPassword = "veryverysecurepassword"
+		newPassword     = "evenmoresecurepassword"
+	)
+
+	BeforeEach(func() {
+		gofakeit.Seed(time.Now().UnixNano())
+		mockController = gomock.NewController(GinkgoT())
+
+		authenticationUsecaseMock = usecasemock.NewMockAuthenticationUsecase(mockController)
+		ownerRepoMock = repositorymock.NewMockOwnerRepository(mockController)
+
+		ownerUsecase = usecase.NewUsecase(authenticationUsecaseMock, ownerRepoMock)
+
+		gofakeit.Struct(&ownerStub)
+		claim = model.Claim{UserID: ownerStub.ID, Username: ownerStub.Username}
+
+		commonCtx = context.Background()
+	})
+
+	AfterEach(func() {
+		mockController.Finish()
+	})
+
+	When("the owner sends the wrong current password", func() {
+		It("tells the owner that the current password is invalid", func(ctx SpecContext) {
+			authenticationUsecaseMock.EXPECT().Authenticate(commonCtx, claim.Username, "twinkling").
+				Return(nil, errorx.New(errorx.TypeInvalidParameter, "username or email or password is invalid", errorx.ErrNotMatch))
+
+			err := ownerUsecase.ChangePassword(commonCtx, claim, "twinkling", newPassword)
+			Expect(err.(*errorx.Error).Type).Should(Equal(errorx.TypeInvalidParameter))
+			Expect(err.(*errorx.Error).Message).Should(Equal("current password is invalid"))
+		}, SpecTimeout(time.Second*2))
+	})
+
+	When("the owner sends the correct current password", func() {
+		BeforeEach(func() {
+			authenticationUsecaseMock.EXPECT().Authenticate(commonCtx, claim.Username, currentPassword).Return(&ownerStub, nil)
+		})
+
+		Context("the new password does not meet the password policy", func() {
+			It("tells the owner why the password was rejected", func(ctx SpecContext) {
+				err := ownerUsecase.ChangePassword(commonCtx, claim, currentPassword, "short")
+				Expect(err.(*errorx.Error).Type).Should(Equal(errorx.TypeInvalidParameter))
+				Expect(err.(*errorx.Error).Details).Should(HaveKey("password"))
+			}, SpecTimeout(time.Second*2))
+		})
+
+		Context("the new password equals the current password", func() {
+			It("tells the owner to pick a different password", func(ctx SpecContext) {
+				err := ownerUsecase.ChangePassword(commonCtx, claim, currentPassword, currentPassword)
+				Expect(err.(*errorx.Error).Type).Should(Equal(errorx.TypeInvalidParameter))
+			}, SpecTimeout(time.Second*2))
+		})
+
+		Context("the new password is valid", func() {
+			It("stores the new hash and records when the password changed", func(ctx SpecContext) {
+				before := time.Now()
+				ownerRepoMock.EXPECT().UpdatePassword(commonCtx, ownerStub.ID, gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ uint, hashedPassword string, changedAt time.Time) error {
+						Expect(hasher.VerifyPassword(hashedPassword, newPassword)).Should(Succeed())
+						Expect(changedAt).Should(BeTemporally(">=", before))
+						return nil
+					})
+
+				err := ownerUsecase.ChangePassword(commonCtx, claim, currentPassword, newPassword)
+				Expect(err).Should(BeNil())
+			}, SpecTimeout(time.Second*5))
+		})
+	})
+})
@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: devoratio.dev/web-resume/owner/usecase (interfaces: OwnerRepository)
+
+// Package repositorymock is a generated GoMock package.
+package repositorymock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockOwnerRepository is a mock of OwnerRepository interface.
+type MockOwnerRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockOwnerRepositoryMockRecorder
+}
+
+// MockOwnerRepositoryMockRecorder is the mock recorder for MockOwnerRepository.
+type MockOwnerRepositoryMockRecorder struct {
+	mock *MockOwnerRepository
+}
+
+// NewMockOwnerRepository creates a new mock instance.
+func NewMockOwnerRepository(ctrl *gomock.Controller) *MockOwnerRepository {
+	mock := &MockOwnerRepository{ctrl: ctrl}
+	mock.recorder = &MockOwnerRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOwnerRepository) EXPECT() *MockOwnerRepositoryMockRecorder {
+	return m.recorder
+}
+
+// UpdatePassword mocks base method.
+func (m *MockOwnerRepository) UpdatePassword(arg0 context.Context, arg1 uint, arg2 string, arg3 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePassword", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePassword indicates an expected call of UpdatePassword.
+func (mr *MockOwnerRepositoryMockRecorder) UpdatePassword(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePassword", reflect.TypeOf((*MockOwnerRepository)(nil).UpdatePassword), arg0, arg1, arg2, arg3)
+}
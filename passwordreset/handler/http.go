@@ -0,0 +1,68 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+
+	"devoratio.dev/web-resume/internal/httpx"
+)
+
+type PasswordResetUsecase interface {
+	RequestReset(ctx context.Context, identifier string) error
+	ConfirmReset(ctx context.Context, resetToken, newPassword string) error
+}
+
+type HTTP struct {
+	resetUsecase PasswordResetUsecase
+}
+
+func NewHTTP(resetUsecase PasswordResetUsecase) *HTTP {
+	return &HTTP{
+		resetUsecase: resetUsecase,
+	}
+}
+
+// RegisterRoutes registers the password reset routes, wrapped by restrict
+func (h *HTTP) RegisterRoutes(mux *http.ServeMux, restrict httpx.Middleware) {
+	mux.Handle("POST /v1/password/forgot", restrict(http.HandlerFunc(h.requestReset)))
+	mux.Handle("POST /v1/password/reset", restrict(http.HandlerFunc(h.confirmReset)))
+}
+
+type requestResetRequest struct {
+	Identifier string `json:"identifier"`
+}
+
+func (h *HTTP) requestReset(w http.ResponseWriter, r *http.Request) {
+	var request requestResetRequest
+	if err := httpx.DecodeJSON(w, r, &request); err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	if err := h.resetUsecase.RequestReset(r.Context(), request.Identifier); err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+type confirmResetRequest struct {
+	Token       string `json:"token"`
+	NewPassword string `json:"new_password"`
+}
+
+func (h *HTTP) confirmReset(w http.ResponseWriter, r *http.Request) {
+	var request confirmResetRequest
+	if err := httpx.DecodeJSON(w, r, &request); err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	if err := h.resetUsecase.ConfirmReset(r.Context(), request.Token, request.NewPassword); err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	httpx.WriteNoContent(w)
+}
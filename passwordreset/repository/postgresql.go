@@ -0,0 +1,102 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"devoratio.dev/web-resume/config"
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/internal/identifier"
+	"devoratio.dev/web-resume/internal/tenancy"
+	"devoratio.dev/web-resume/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type PostgreSQLDatabase struct {
+	db                *gorm.DB
+	verifiedEmailOnly bool
+}
+
+func NewPostgreSQL(db *gorm.DB, authConfig config.Authentication) *PostgreSQLDatabase {
+	return &PostgreSQLDatabase{
+		db:                db,
+		verifiedEmailOnly: authConfig.VerifiedEmailOnly,
+	}
+}
+
+func (p *PostgreSQLDatabase) GetOwnerByUsernameOrEmail(ctx context.Context, ownerIdentifier string) (*model.OwnerAccount, error) {
+	owner := &model.OwnerAccount{}
+	result := p.db.WithContext(ctx).
+		Scopes(tenancy.Scope(ctx, "id"), identifier.Scope(ownerIdentifier, p.verifiedEmailOnly)).
+		First(owner)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, errorx.ErrNotFound
+		}
+		return nil, errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+
+	return owner, nil
+}
+
+func (p *PostgreSQLDatabase) CreateToken(ctx context.Context, token *model.OneTimeToken) error {
+	if err := p.db.WithContext(ctx).Create(token).Error; err != nil {
+		return errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), err)
+	}
+
+	return nil
+}
+
+func (p *PostgreSQLDatabase) ResetPassword(ctx context.Context, tokenHash, hashedPassword string, now time.Time) error {
+	err := p.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		token := &model.OneTimeToken{}
+		result := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Scopes(tenancy.Scope(ctx, "owner_id")).
+			Where("token_hash = ? AND purpose = ? AND used_at IS NULL AND expires_at > ?", tokenHash, model.TokenPurposePasswordReset, now).
+			First(token)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		// Consume every outstanding reset token of the owner, not only this one
+		err := tx.Model(&model.OneTimeToken{}).
+			Where("owner_id = ? AND purpose = ? AND used_at IS NULL", token.OwnerID, model.TokenPurposePasswordReset).
+			Update("used_at", now).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Model(&model.OwnerAccount{}).Where("id = ?", token.OwnerID).Updates(map[string]interface{}{
+			"password":                hashedPassword,
+			"password_changed_at":     now,
+			"password_reset_required": false,
+		}).Error
+		if err != nil {
+			return err
+		}
+
+		// Whoever knew the old password may still hold a refresh token or a
+		// personal access token they created
+		err = tx.Model(&model.Session{}).
+			Where("owner_id = ? AND revoked_at IS NULL", token.OwnerID).
+			Update("revoked_at", now).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Model(&model.PersonalToken{}).
+			Where("owner_id = ? AND revoked_at IS NULL", token.OwnerID).
+			Update("revoked_at", now).Error
+	})
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errorx.ErrNotFound
+		}
+		return errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,181 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"devoratio.dev/web-resume/config"
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/internal/generator"
+	"devoratio.dev/web-resume/internal/hasher"
+	"devoratio.dev/web-resume/internal/mailer"
+	"devoratio.dev/web-resume/internal/policy"
+	"devoratio.dev/web-resume/internal/ratelimit"
+	"devoratio.dev/web-resume/model"
+)
+
+const invalidResetTokenMessage = "reset token is invalid or has expired"
+
+const resetEmailBody = `Hi %s,
+
+Someone asked to reset the password of your web resume account. Open the link
+below within %s to choose a new password:
+
+%s
+
+If you did not ask for this you can ignore this email, your password stays the
+same.
+`
+
+//go:generate mockgen -destination=repositorymock/postgresqlmock.go -package=repositorymock . PasswordResetRepository
+type PasswordResetRepository interface {
+	GetOwnerByUsernameOrEmail(ctx context.Context, identifier string) (*model.OwnerAccount, error)
+	CreateToken(ctx context.Context, token *model.OneTimeToken) error
+	// ResetPassword consumes the reset token and stores the new password
+	// atomically. It returns errorx.ErrNotFound when the token is unknown,
+	// already used or expired.
+	ResetPassword(ctx context.Context, tokenHash, hashedPassword string, now time.Time) error
+}
+
+//go:generate mockgen -destination=mailermock/mailermock.go -package=mailermock . Mailer
+type Mailer interface {
+	Send(ctx context.Context, message mailer.Message) error
+}
+
+//go:generate mockgen -destination=usecasemock/auditmock.go -package=usecasemock . AuditUsecase
+type AuditUsecase interface {
+	Record(ctx context.Context, entry model.AuditLog, result error)
+}
+
+//go:generate mockgen -destination=ratelimitermock/ratelimitermock.go -package=ratelimitermock . RateLimiter
+type RateLimiter interface {
+	Allow(key string) bool
+}
+
+type PasswordReset struct {
+	resetRepo    PasswordResetRepository
+	mailer       Mailer
+	auditUsecase AuditUsecase
+	rateLimiter  RateLimiter
+	appConfig    *config.Application
+}
+
+func NewUsecase(resetRepo PasswordResetRepository, mailer Mailer, auditUsecase AuditUsecase, rateLimiter RateLimiter, appConfig *config.Application) *PasswordReset {
+	return &PasswordReset{
+		resetRepo:    resetRepo,
+		mailer:       mailer,
+		auditUsecase: auditUsecase,
+		rateLimiter:  rateLimiter,
+		appConfig:    appConfig,
+	}
+}
+
+// RequestReset emails a reset link to the verified email of the owner matching
+// identifier. It reports success whether or not the owner exists so the
+// endpoint can't be used to find out which usernames or emails are registered.
+// Requests share the sign-in budget of the owner.
+func (p *PasswordReset) RequestReset(ctx context.Context, identifier string) error {
+	entry := model.AuditLog{Method: model.AuditMethodPasswordReset, Identifier: identifier}
+
+	ownerAccount, err := p.resetRepo.GetOwnerByUsernameOrEmail(ctx, identifier)
+	if err != nil && !errorx.Is(err, errorx.ErrNotFound) {
+		return err
+	}
+
+	key := ratelimit.IdentifierKey(identifier)
+	if ownerAccount != nil {
+		key = ratelimit.OwnerKey(ownerAccount.ID)
+		entry.OwnerID = &ownerAccount.ID
+	}
+	if !p.rateLimiter.Allow(key) {
+		entry.Event = model.AuditEventRateLimited
+		p.auditUsecase.Record(ctx, entry, errorx.ErrTooManyRequests)
+		return errorx.ErrTooManyRequests
+	}
+
+	if ownerAccount == nil {
+		entry.Event = model.AuditEventUnknownIdentifier
+		p.auditUsecase.Record(ctx, entry, errorx.ErrNotFound)
+		return nil
+	}
+
+	entry.Event = model.AuditEventLinkRequested
+
+	// Whoever owns an address that was never verified, a mistyped one for
+	// instance, must not be able to take over the account with it
+	if !ownerAccount.EmailVerified {
+		p.auditUsecase.Record(ctx, entry, errorx.ErrForbidden)
+		return nil
+	}
+
+	err = p.sendResetLink(ctx, ownerAccount)
+	p.auditUsecase.Record(ctx, entry, err)
+
+	return err
+}
+
+func (p *PasswordReset) sendResetLink(ctx context.Context, ownerAccount *model.OwnerAccount) error {
+	resetToken, err := generator.GenerateRandomToken()
+	if err != nil {
+		return err
+	}
+
+	ttl := p.appConfig.Usecase.PasswordReset.TokenTTL
+	err = p.resetRepo.CreateToken(ctx, &model.OneTimeToken{
+		OwnerID:   ownerAccount.ID,
+		Purpose:   model.TokenPurposePasswordReset,
+		TokenHash: hasher.HashToken(resetToken),
+		ExpiresAt: time.Now().Add(ttl),
+	})
+	if err != nil {
+		return err
+	}
+
+	resetURL, err := generator.GenerateTokenURL(p.appConfig.Usecase.PasswordReset.URL, resetToken)
+	if err != nil {
+		return err
+	}
+
+	message := mailer.Message{
+		To:      ownerAccount.Email,
+		Subject: "Reset your password",
+		Body:    fmt.Sprintf(resetEmailBody, ownerAccount.FullName(), ttl, resetURL),
+	}
+
+	// Deliver in the background, waiting for the mail server would make known
+	// identifiers noticeably slower to answer than unknown ones
+	go p.send(context.WithoutCancel(ctx), message)
+
+	return nil
+}
+
+// ConfirmReset sets a new password using a token from RequestReset. Every
+// token issued before the reset stops being accepted.
+func (p *PasswordReset) ConfirmReset(ctx context.Context, resetToken, newPassword string) error {
+	if err := policy.ValidatePassword(newPassword); err != nil {
+		return err
+	}
+
+	hashedPassword, err := hasher.GenerateFromPassword(newPassword)
+	if err != nil {
+		return err
+	}
+
+	err = p.resetRepo.ResetPassword(ctx, hasher.HashToken(resetToken), hashedPassword, time.Now())
+	if err != nil {
+		if errorx.Is(err, errorx.ErrNotFound) {
+			return errorx.New(errorx.TypeInvalidParameter, invalidResetTokenMessage, err)
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (p *PasswordReset) send(ctx context.Context, message mailer.Message) {
+	if err := p.mailer.Send(ctx, message); err != nil {
+		log.Printf("failed to send password reset email: %s", err)
+	}
+}
@@ -0,0 +1,182 @@
+package usecase_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"devoratio.dev/web-resume/config"
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/internal/hasher"
+	"devoratio.dev/web-resume/internal/mailer"
+	"devoratio.dev/web-resume/model"
+	"devoratio.dev/web-resume/passwordreset/usecase"
+	"devoratio.dev/web-resume/passwordreset/usecase/mailermock"
+	"devoratio.dev/web-resume/passwordreset/usecase/ratelimitermock"
+	"devoratio.dev/web-resume/passwordreset/usecase/repositorymock"
+	"devoratio.dev/web-resume/passwordreset/usecase/usecasemock"
+)
+
+var _ = Describe("Reset a forgotten password", Label("passwordreset"), func() {
+	var (
+		mockController *gomock.Controller
+
+		resetRepoMock    *repositorymock.MockPasswordResetRepository
+		mailerMock       *mailermock.MockMailer
+		auditUsecaseMock *usecasemock.MockAuditUsecase
+		rateLimiterMock  *ratelimitermock.MockRateLimiter
+
+		resetUsecase     *usecase.PasswordReset
+		commonCtx        context.Context
+		identifier       = "devoratio"
+		ownerAccountStub model.OwnerAccount
+		appConfig        *config.Application
+	)
+
+	BeforeEach(func() {
+		gofakeit.Seed(time.Now().UnixNano())
+		mockController = gomock.NewController(GinkgoT())
+
+		resetRepoMock = repositorymock.NewMockPasswordResetRepository(mockController)
+		mailerMock = mailermock.NewMockMailer(mockController)
+		auditUsecaseMock = usecasemock.NewMockAuditUsecase(mockController)
+		rateLimiterMock = ratelimitermock.NewMockRateLimiter(mockController)
+
+		appConfig = &config.Application{
+			Usecase: config.Usecase{
+				PasswordReset: config.PasswordReset{
+					TokenTTL: 30 * time.Minute,
+					URL:      "https://resume.devoratio.dev/reset-password",
+				},
+			},
+		}
+
+		resetUsecase = usecase.NewUsecase(resetRepoMock, mailerMock, auditUsecaseMock, rateLimiterMock, appConfig)
+
+		gofakeit.Struct(&ownerAccountStub)
+		ownerAccountStub.EmailVerified = true
+
+		commonCtx = context.Background()
+	})
+
+	AfterEach(func() {
+		mockController.Finish()
+	})
+
+	When("the user requests a reset for an unknown username or email", func() {
+		It("reports success without sending an email", func(ctx SpecContext) {
+			resetRepoMock.EXPECT().GetOwnerByUsernameOrEmail(commonCtx, identifier).Return(nil, errorx.ErrNotFound)
+			rateLimiterMock.EXPECT().Allow("identifier:devoratio").Return(true)
+			auditUsecaseMock.EXPECT().Record(commonCtx, gomock.Any(), errorx.ErrNotFound).Do(
+				func(_ context.Context, entry model.AuditLog, _ error) {
+					Expect(entry.Method).Should(Equal(model.AuditMethodPasswordReset))
+					Expect(entry.Event).Should(Equal(model.AuditEventUnknownIdentifier))
+				})
+
+			err := resetUsecase.RequestReset(commonCtx, identifier)
+			Expect(err).Should(BeNil())
+		}, SpecTimeout(time.Second*2))
+	})
+
+	When("the user requests too many resets for the same owner", func() {
+		It("tells the user to try again later without sending an email", func(ctx SpecContext) {
+			resetRepoMock.EXPECT().GetOwnerByUsernameOrEmail(commonCtx, identifier).Return(&ownerAccountStub, nil)
+			rateLimiterMock.EXPECT().Allow(fmt.Sprintf("owner:%d", ownerAccountStub.ID)).Return(false)
+			auditUsecaseMock.EXPECT().Record(commonCtx, gomock.Any(), errorx.ErrTooManyRequests).Do(
+				func(_ context.Context, entry model.AuditLog, _ error) {
+					Expect(entry.Event).Should(Equal(model.AuditEventRateLimited))
+					Expect(*entry.OwnerID).Should(Equal(ownerAccountStub.ID))
+				})
+
+			err := resetUsecase.RequestReset(commonCtx, identifier)
+			Expect(err).Should(Equal(errorx.ErrTooManyRequests))
+		}, SpecTimeout(time.Second*2))
+	})
+
+	When("the user requests a reset for an owner whose email is not verified", func() {
+		It("reports success without sending an email", func(ctx SpecContext) {
+			ownerAccountStub.EmailVerified = false
+
+			resetRepoMock.EXPECT().GetOwnerByUsernameOrEmail(commonCtx, identifier).Return(&ownerAccountStub, nil)
+			rateLimiterMock.EXPECT().Allow(fmt.Sprintf("owner:%d", ownerAccountStub.ID)).Return(true)
+			auditUsecaseMock.EXPECT().Record(commonCtx, gomock.Any(), errorx.ErrForbidden).Do(
+				func(_ context.Context, entry model.AuditLog, _ error) {
+					Expect(entry.Event).Should(Equal(model.AuditEventLinkRequested))
+				})
+
+			err := resetUsecase.RequestReset(commonCtx, identifier)
+			Expect(err).Should(BeNil())
+		}, SpecTimeout(time.Second*2))
+	})
+
+	When("the user requests a reset for a known username or email", func() {
+		It("stores the token hash and emails the token to the owner", func(ctx SpecContext) {
+			var storedToken *model.OneTimeToken
+			sent := make(chan mailer.Message, 1)
+
+			resetRepoMock.EXPECT().GetOwnerByUsernameOrEmail(commonCtx, identifier).Return(&ownerAccountStub, nil)
+			rateLimiterMock.EXPECT().Allow(fmt.Sprintf("owner:%d", ownerAccountStub.ID)).Return(true)
+			auditUsecaseMock.EXPECT().Record(commonCtx, gomock.Any(), nil)
+			resetRepoMock.EXPECT().CreateToken(commonCtx, gomock.Any()).DoAndReturn(
+				func(_ context.Context, token *model.OneTimeToken) error {
+					storedToken = token
+					return nil
+				})
+			mailerMock.EXPECT().Send(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, message mailer.Message) error {
+					sent <- message
+					return nil
+				})
+
+			err := resetUsecase.RequestReset(commonCtx, identifier)
+			Expect(err).Should(BeNil())
+
+			var message mailer.Message
+			Eventually(sent).Should(Receive(&message))
+			Expect(message.To).Should(Equal(ownerAccountStub.Email))
+			Expect(message.Body).Should(ContainSubstring("https://resume.devoratio.dev/reset-password?token="))
+
+			Expect(storedToken.OwnerID).Should(Equal(ownerAccountStub.ID))
+			Expect(storedToken.Purpose).Should(Equal(model.TokenPurposePasswordReset))
+			Expect(storedToken.ExpiresAt).Should(BeTemporally("~", time.Now().Add(30*time.Minute), time.Minute))
+			Expect(message.Body).ShouldNot(ContainSubstring(storedToken.TokenHash))
+		}, SpecTimeout(time.Second*2))
+	})
+
+	When("the user confirms the reset", func() {
+		Context("the new password does not meet the password policy", func() {
+			It("tells the user why the password was rejected", func(ctx SpecContext) {
+				err := resetUsecase.ConfirmReset(commonCtx, "resettoken", "short")
+				Expect(err.(*errorx.Error).Type).Should(Equal(errorx.TypeInvalidParameter))
+			}, SpecTimeout(time.Second*2))
+		})
+
+		Context("the token is unknown, used or expired", func() {
+			It("tells the user that the token is invalid", func(ctx SpecContext) {
+				resetRepoMock.EXPECT().ResetPassword(commonCtx, hasher.HashToken("resettoken"), gomock.Any(), gomock.Any()).Return(errorx.ErrNotFound)
+
+				err := resetUsecase.ConfirmReset(commonCtx, "resettoken", "veryverysecurepassword")
+				Expect(err.(*errorx.Error).Type).Should(Equal(errorx.TypeInvalidParameter))
+				Expect(err.(*errorx.Error).Message).Should(Equal("reset token is invalid or has expired"))
+			}, SpecTimeout(time.Second*5))
+		})
+
+		Context("the token is valid", func() {
+			It("stores the new password hash", func(ctx SpecContext) {
+				resetRepoMock.EXPECT().ResetPassword(commonCtx, hasher.HashToken("resettoken"), gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ string, hashedPassword string, _ time.Time) error {
+						Expect(hasher.VerifyPassword(hashedPassword, "veryverysecurepassword")).Should(Succeed())
+						return nil
+					})
+
+				err := resetUsecase.ConfirmReset(commonCtx, "resettoken", "veryverysecurepassword")
+				Expect(err).Should(BeNil())
+			}, SpecTimeout(time.Second*5))
+		})
+	})
+})
@@ -0,0 +1,94 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/internal/httpx"
+	"devoratio.dev/web-resume/model"
+)
+
+type PersonalTokenUsecase interface {
+	Create(ctx context.Context, claim model.Claim, request model.PersonalTokenRequest) (*model.PersonalToken, error)
+	List(ctx context.Context, claim model.Claim) ([]model.PersonalToken, error)
+	Revoke(ctx context.Context, claim model.Claim, tokenID uint) error
+}
+
+type HTTP struct {
+	personalTokenUsecase PersonalTokenUsecase
+}
+
+func NewHTTP(personalTokenUsecase PersonalTokenUsecase) *HTTP {
+	return &HTTP{
+		personalTokenUsecase: personalTokenUsecase,
+	}
+}
+
+// RegisterRoutes registers the token management routes. They are wrapped by
+// authenticate, so a personal access token can't be used to create another.
+func (h *HTTP) RegisterRoutes(mux *http.ServeMux, authenticate httpx.Middleware) {
+	mux.Handle("POST /v1/owner/tokens", authenticate(http.HandlerFunc(h.create)))
+	mux.Handle("GET /v1/owner/tokens", authenticate(http.HandlerFunc(h.list)))
+	mux.Handle("DELETE /v1/owner/tokens/{id}", authenticate(http.HandlerFunc(h.revoke)))
+}
+
+func (h *HTTP) create(w http.ResponseWriter, r *http.Request) {
+	claim, ok := httpx.ClaimFromContext(r.Context())
+	if !ok {
+		httpx.WriteError(w, errorx.ErrUnauthorized)
+		return
+	}
+
+	var request model.PersonalTokenRequest
+	if err := httpx.DecodeJSON(w, r, &request); err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	token, err := h.personalTokenUsecase.Create(r.Context(), *claim, request)
+	if err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, http.StatusCreated, token)
+}
+
+func (h *HTTP) list(w http.ResponseWriter, r *http.Request) {
+	claim, ok := httpx.ClaimFromContext(r.Context())
+	if !ok {
+		httpx.WriteError(w, errorx.ErrUnauthorized)
+		return
+	}
+
+	tokens, err := h.personalTokenUsecase.List(r.Context(), *claim)
+	if err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, http.StatusOK, tokens)
+}
+
+func (h *HTTP) revoke(w http.ResponseWriter, r *http.Request) {
+	claim, ok := httpx.ClaimFromContext(r.Context())
+	if !ok {
+		httpx.WriteError(w, errorx.ErrUnauthorized)
+		return
+	}
+
+	tokenID, err := strconv.ParseUint(r.PathValue("id"), 10, 0)
+	if err != nil {
+		httpx.WriteError(w, errorx.ErrNotFound)
+		return
+	}
+
+	if err := h.personalTokenUsecase.Revoke(r.Context(), *claim, uint(tokenID)); err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	httpx.WriteNoContent(w)
+}
@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/model"
+	"gorm.io/gorm"
+)
+
+type PostgreSQLDatabase struct {
+	db *gorm.DB
+}
+
+func NewPostgreSQL(db *gorm.DB) *PostgreSQLDatabase {
+	return &PostgreSQLDatabase{
+		db: db,
+	}
+}
+
+func (p *PostgreSQLDatabase) CreatePersonalToken(ctx context.Context, token *model.PersonalToken) error {
+	if err := p.db.WithContext(ctx).Create(token).Error; err != nil {
+		return errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), err)
+	}
+
+	return nil
+}
+
+func (p *PostgreSQLDatabase) ListPersonalTokens(ctx context.Context, ownerID uint) ([]model.PersonalToken, error) {
+	var tokens []model.PersonalToken
+	result := p.db.WithContext(ctx).
+		Where("owner_id = ? AND revoked_at IS NULL", ownerID).
+		Order("id DESC").
+		Find(&tokens)
+	if result.Error != nil {
+		return nil, errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+
+	return tokens, nil
+}
+
+func (p *PostgreSQLDatabase) RevokePersonalToken(ctx context.Context, ownerID, tokenID uint, now time.Time) error {
+	result := p.db.WithContext(ctx).Model(&model.PersonalToken{}).
+		Where("id = ? AND owner_id = ? AND revoked_at IS NULL", tokenID, ownerID).
+		Update("revoked_at", now)
+	if result.Error != nil {
+		return errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return errorx.ErrNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,125 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"devoratio.dev/web-resume/internal/authz"
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/internal/generator"
+	"devoratio.dev/web-resume/internal/hasher"
+	"devoratio.dev/web-resume/model"
+)
+
+const (
+	invalidPersonalTokenMessage = "personal access token is invalid"
+	maxNameLength               = 100
+)
+
+//go:generate mockgen -destination=repositorymock/postgresqlmock.go -package=repositorymock . PersonalTokenRepository
+type PersonalTokenRepository interface {
+	CreatePersonalToken(ctx context.Context, token *model.PersonalToken) error
+	// ListPersonalTokens returns the tokens of the owner that have not been
+	// revoked, newest first
+	ListPersonalTokens(ctx context.Context, ownerID uint) ([]model.PersonalToken, error)
+	RevokePersonalToken(ctx context.Context, ownerID, tokenID uint, now time.Time) error
+}
+
+type PersonalToken struct {
+	personalTokenRepo PersonalTokenRepository
+}
+
+func NewUsecase(personalTokenRepo PersonalTokenRepository) *PersonalToken {
+	return &PersonalToken{
+		personalTokenRepo: personalTokenRepo,
+	}
+}
+
+// Create issues a personal access token to the owner. The token itself is only
+// ever returned here, afterwards only its hash is known.
+func (p *PersonalToken) Create(ctx context.Context, claim model.Claim, request model.PersonalTokenRequest) (*model.PersonalToken, error) {
+	// A token can never be used to mint another one
+	if err := authz.RequireScope(claim, model.ScopeAccountManage); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if err := validateRequest(request, now); err != nil {
+		return nil, err
+	}
+
+	secret, err := generator.GenerateRandomToken()
+	if err != nil {
+		return nil, err
+	}
+	personalToken := model.PersonalTokenPrefix + secret
+
+	// Scopes are stored in the order they are listed in, whatever order they
+	// were requested in
+	var scopes []string
+	for _, scope := range model.PersonalTokenScopes {
+		if slices.Contains(request.Scopes, scope) {
+			scopes = append(scopes, scope)
+		}
+	}
+
+	token := &model.PersonalToken{
+		OwnerID:   claim.UserID,
+		Name:      strings.TrimSpace(request.Name),
+		TokenHash: hasher.HashToken(personalToken),
+		Scopes:    scopes,
+		CreatedAt: now,
+		ExpiresAt: request.ExpiresAt,
+	}
+	if err := p.personalTokenRepo.CreatePersonalToken(ctx, token); err != nil {
+		return nil, err
+	}
+
+	token.Token = personalToken
+	return token, nil
+}
+
+func (p *PersonalToken) List(ctx context.Context, claim model.Claim) ([]model.PersonalToken, error) {
+	return p.personalTokenRepo.ListPersonalTokens(ctx, claim.UserID)
+}
+
+// Revoke stops a personal access token from working right away
+func (p *PersonalToken) Revoke(ctx context.Context, claim model.Claim, tokenID uint) error {
+	return p.personalTokenRepo.RevokePersonalToken(ctx, claim.UserID, tokenID, time.Now())
+}
+
+func validateRequest(request model.PersonalTokenRequest, now time.Time) error {
+	details := map[string]interface{}{}
+
+	name := strings.TrimSpace(request.Name)
+	if name == "" {
+		details["name"] = "must not be empty"
+	} else if utf8.RuneCountInString(name) > maxNameLength {
+		details["name"] = "must not be longer than 100 characters"
+	}
+
+	if len(request.Scopes) == 0 {
+		details["scopes"] = "must not be empty"
+	}
+	for _, scope := range request.Scopes {
+		if !slices.Contains(model.PersonalTokenScopes, scope) {
+			details["scopes"] = "must only contain " + strings.Join(model.PersonalTokenScopes, ", ")
+			break
+		}
+	}
+
+	if request.ExpiresAt != nil && !request.ExpiresAt.After(now) {
+		details["expires_at"] = "must be in the future"
+	}
+
+	if len(details) > 0 {
+		err := errorx.New(errorx.TypeInvalidParameter, invalidPersonalTokenMessage, nil)
+		err.Details = details
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,124 @@
+package usecase_test
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/internal/hasher"
+	"devoratio.dev/web-resume/model"
+	"devoratio.dev/web-resume/personaltoken/usecase"
+	"devoratio.dev/web-resume/personaltoken/usecase/repositorymock"
+)
+
+var _ = Describe("Personal access tokens", Label("personaltoken"), func() {
+	var (
+		mockController *gomock.Controller
+
+		personalTokenRepoMock *repositorymock.MockPersonalTokenRepository
+
+		commonCtx            context.Context
+		personalTokenUsecase *usecase.PersonalToken
+		claimStub            model.Claim
+	)
+
+	BeforeEach(func() {
+		gofakeit.Seed(time.Now().UnixNano())
+		mockController = gomock.NewController(GinkgoT())
+
+		personalTokenRepoMock = repositorymock.NewMockPersonalTokenRepository(mockController)
+
+		personalTokenUsecase = usecase.NewUsecase(personalTokenRepoMock)
+
+		gofakeit.Struct(&claimStub)
+		claimStub.Scopes = slices.Clone(model.SessionScopes)
+
+		commonCtx = context.Background()
+	})
+
+	AfterEach(func() {
+		mockController.Finish()
+	})
+
+	When("a personal access token is used to create a token", func() {
+		It("forbids it", func(ctx SpecContext) {
+			claimStub.PersonalTokenID = 7
+			claimStub.Scopes = slices.Clone(model.PersonalTokenScopes)
+
+			result, err := personalTokenUsecase.Create(commonCtx, claimStub, model.PersonalTokenRequest{
+				Name:   "CI",
+				Scopes: []string{model.ScopeResumeWrite},
+			})
+			Expect(err).Should(Equal(errorx.ErrForbidden))
+			Expect(result).Should(BeNil())
+		}, SpecTimeout(time.Second*2))
+	})
+
+	When("the owner creates a token", func() {
+		Context("the request is invalid", func() {
+			It("tells the owner what is wrong with every field", func(ctx SpecContext) {
+				expiresAt := time.Now().Add(-time.Hour)
+
+				result, err := personalTokenUsecase.Create(commonCtx, claimStub, model.PersonalTokenRequest{
+					Name:      " ",
+					Scopes:    []string{model.ScopeResumeRead, "owner:admin"},
+					ExpiresAt: &expiresAt,
+				})
+				Expect(err.(*errorx.Error).Type).Should(Equal(errorx.TypeInvalidParameter))
+				Expect(err.(*errorx.Error).Details).Should(HaveKey("name"))
+				Expect(err.(*errorx.Error).Details).Should(HaveKey("scopes"))
+				Expect(err.(*errorx.Error).Details).Should(HaveKey("expires_at"))
+				Expect(result).Should(BeNil())
+			}, SpecTimeout(time.Second*2))
+		})
+
+		Context("the request has no scopes", func() {
+			It("tells the owner the scopes are missing", func(ctx SpecContext) {
+				result, err := personalTokenUsecase.Create(commonCtx, claimStub, model.PersonalTokenRequest{Name: "CI"})
+				Expect(err.(*errorx.Error).Details).Should(Equal(map[string]interface{}{"scopes": "must not be empty"}))
+				Expect(result).Should(BeNil())
+			}, SpecTimeout(time.Second*2))
+		})
+
+		Context("the request is valid", func() {
+			It("stores the token hash and returns the token once", func(ctx SpecContext) {
+				var stored *model.PersonalToken
+				personalTokenRepoMock.EXPECT().CreatePersonalToken(commonCtx, gomock.Any()).DoAndReturn(
+					func(_ context.Context, token *model.PersonalToken) error {
+						Expect(token.Token).Should(BeEmpty())
+						stored = token
+						return nil
+					})
+
+				result, err := personalTokenUsecase.Create(commonCtx, claimStub, model.PersonalTokenRequest{
+					Name:   " CI ",
+					Scopes: []string{model.ScopeResumeWrite, model.ScopeResumeRead},
+				})
+				Expect(err).Should(BeNil())
+				Expect(result).Should(Equal(stored))
+				Expect(result.OwnerID).Should(Equal(claimStub.UserID))
+				Expect(result.Name).Should(Equal("CI"))
+				Expect(result.Scopes).Should(Equal([]string{model.ScopeResumeRead, model.ScopeResumeWrite}))
+				Expect(result.ExpiresAt).Should(BeNil())
+				Expect(strings.HasPrefix(result.Token, model.PersonalTokenPrefix)).Should(BeTrue())
+				Expect(result.TokenHash).Should(Equal(hasher.HashToken(result.Token)))
+			}, SpecTimeout(time.Second*2))
+		})
+	})
+
+	When("the owner revokes a token they do not have", func() {
+		It("tells the owner the token does not exist", func(ctx SpecContext) {
+			personalTokenRepoMock.EXPECT().RevokePersonalToken(commonCtx, claimStub.UserID, uint(7), gomock.Any()).Return(errorx.ErrNotFound)
+
+			err := personalTokenUsecase.Revoke(commonCtx, claimStub, 7)
+			Expect(err).Should(Equal(errorx.ErrNotFound))
+		}, SpecTimeout(time.Second*2))
+	})
+})
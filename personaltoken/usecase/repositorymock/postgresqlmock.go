@@ -0,0 +1,80 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: devoratio.dev/web-resume/personaltoken/usecase (interfaces: PersonalTokenRepository)
+
+// Package repositorymock is a generated GoMock package.
+package repositorymock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	model "devoratio.dev/web-resume/model"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockPersonalTokenRepository is a mock of PersonalTokenRepository interface.
+type MockPersonalTokenRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPersonalTokenRepositoryMockRecorder
+}
+
+// MockPersonalTokenRepositoryMockRecorder is the mock recorder for MockPersonalTokenRepository.
+type MockPersonalTokenRepositoryMockRecorder struct {
+	mock *MockPersonalTokenRepository
+}
+
+// NewMockPersonalTokenRepository creates a new mock instance.
+func NewMockPersonalTokenRepository(ctrl *gomock.Controller) *MockPersonalTokenRepository {
+	mock := &MockPersonalTokenRepository{ctrl: ctrl}
+	mock.recorder = &MockPersonalTokenRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPersonalTokenRepository) EXPECT() *MockPersonalTokenRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreatePersonalToken mocks base method.
+func (m *MockPersonalTokenRepository) CreatePersonalToken(arg0 context.Context, arg1 *model.PersonalToken) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePersonalToken", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreatePersonalToken indicates an expected call of CreatePersonalToken.
+func (mr *MockPersonalTokenRepositoryMockRecorder) CreatePersonalToken(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePersonalToken", reflect.TypeOf((*MockPersonalTokenRepository)(nil).CreatePersonalToken), arg0, arg1)
+}
+
+// ListPersonalTokens mocks base method.
+func (m *MockPersonalTokenRepository) ListPersonalTokens(arg0 context.Context, arg1 uint) ([]model.PersonalToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPersonalTokens", arg0, arg1)
+	ret0, _ := ret[0].([]model.PersonalToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPersonalTokens indicates an expected call of ListPersonalTokens.
+func (mr *MockPersonalTokenRepositoryMockRecorder) ListPersonalTokens(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPersonalTokens", reflect.TypeOf((*MockPersonalTokenRepository)(nil).ListPersonalTokens), arg0, arg1)
+}
+
+// RevokePersonalToken mocks base method.
+func (m *MockPersonalTokenRepository) RevokePersonalToken(arg0 context.Context, arg1, arg2 uint, arg3 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokePersonalToken", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokePersonalToken indicates an expected call of RevokePersonalToken.
+func (mr *MockPersonalTokenRepositoryMockRecorder) RevokePersonalToken(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokePersonalToken", reflect.TypeOf((*MockPersonalTokenRepository)(nil).RevokePersonalToken), arg0, arg1, arg2, arg3)
+}
@@ -0,0 +1,70 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/internal/httpx"
+	"devoratio.dev/web-resume/model"
+)
+
+type ProfileUsecase interface {
+	Get(ctx context.Context, claim model.Claim) (*model.Profile, error)
+	Update(ctx context.Context, claim model.Claim, request model.ProfileRequest) (*model.Profile, error)
+}
+
+type HTTP struct {
+	profileUsecase ProfileUsecase
+}
+
+func NewHTTP(profileUsecase ProfileUsecase) *HTTP {
+	return &HTTP{
+		profileUsecase: profileUsecase,
+	}
+}
+
+// RegisterRoutes registers the profile routes, read is required to get the
+// profile and write to update it
+func (h *HTTP) RegisterRoutes(mux *http.ServeMux, read, write httpx.Middleware) {
+	mux.Handle("GET /v1/profile", read(http.HandlerFunc(h.get)))
+	mux.Handle("PUT /v1/profile", write(http.HandlerFunc(h.update)))
+}
+
+func (h *HTTP) get(w http.ResponseWriter, r *http.Request) {
+	claim, ok := httpx.ClaimFromContext(r.Context())
+	if !ok {
+		httpx.WriteError(w, errorx.ErrUnauthorized)
+		return
+	}
+
+	profile, err := h.profileUsecase.Get(r.Context(), *claim)
+	if err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, http.StatusOK, profile)
+}
+
+func (h *HTTP) update(w http.ResponseWriter, r *http.Request) {
+	claim, ok := httpx.ClaimFromContext(r.Context())
+	if !ok {
+		httpx.WriteError(w, errorx.ErrUnauthorized)
+		return
+	}
+
+	var request model.ProfileRequest
+	if err := httpx.DecodeJSON(w, r, &request); err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	profile, err := h.profileUsecase.Update(r.Context(), *claim, request)
+	if err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, http.StatusOK, profile)
+}
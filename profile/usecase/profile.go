@@ -0,0 +1,122 @@
+package usecase
+
+import (
+	"context"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"devoratio.dev/web-resume/internal/authz"
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/internal/policy"
+	"devoratio.dev/web-resume/model"
+)
+
+const (
+	invalidProfileMessage = "profile is invalid"
+
+	maxHeadlineLength = 120
+	maxSummaryLength  = 2000
+	maxLocationLength = 100
+)
+
+// validPhone accepts the usual ways of writing an international number
+var validPhone = regexp.MustCompile(`^\+?[0-9][0-9 ().-]{4,30}$`)
+
+//go:generate mockgen -destination=repositorymock/postgresqlmock.go -package=repositorymock . ProfileRepository
+type ProfileRepository interface {
+	GetProfile(ctx context.Context, ownerID uint) (*model.Profile, error)
+	// SaveProfile creates the profile of the owner or replaces it
+	SaveProfile(ctx context.Context, profile *model.Profile) error
+}
+
+type Profile struct {
+	profileRepo ProfileRepository
+}
+
+func NewUsecase(profileRepo ProfileRepository) *Profile {
+	return &Profile{
+		profileRepo: profileRepo,
+	}
+}
+
+// Get returns the profile of the owner, an empty one until it is first saved
+func (p *Profile) Get(ctx context.Context, claim model.Claim) (*model.Profile, error) {
+	profile, err := p.profileRepo.GetProfile(ctx, claim.UserID)
+	if err != nil {
+		if errorx.Is(err, errorx.ErrNotFound) {
+			return &model.Profile{OwnerID: claim.UserID, Availability: model.AvailabilityNotLooking}, nil
+		}
+		return nil, err
+	}
+
+	return profile, nil
+}
+
+func (p *Profile) Update(ctx context.Context, claim model.Claim, request model.ProfileRequest) (*model.Profile, error) {
+	if err := authz.RequireScope(claim, model.ScopeResumeWrite); err != nil {
+		return nil, err
+	}
+
+	profile := &model.Profile{
+		OwnerID:      claim.UserID,
+		Headline:     strings.TrimSpace(request.Headline),
+		Summary:      strings.TrimSpace(request.Summary),
+		Location:     strings.TrimSpace(request.Location),
+		Phone:        strings.TrimSpace(request.Phone),
+		PhonePublic:  request.PhonePublic,
+		Website:      strings.TrimSpace(request.Website),
+		Avatar:       strings.TrimSpace(request.Avatar),
+		Availability: request.Availability,
+		UpdatedAt:    time.Now(),
+	}
+	if profile.Availability == "" {
+		profile.Availability = model.AvailabilityNotLooking
+	}
+
+	if err := validateProfile(profile); err != nil {
+		return nil, err
+	}
+
+	if err := p.profileRepo.SaveProfile(ctx, profile); err != nil {
+		return nil, err
+	}
+
+	return profile, nil
+}
+
+func validateProfile(profile *model.Profile) error {
+	details := map[string]interface{}{}
+
+	if utf8.RuneCountInString(profile.Headline) > maxHeadlineLength {
+		details["headline"] = "must not be longer than 120 characters"
+	}
+	if utf8.RuneCountInString(profile.Summary) > maxSummaryLength {
+		details["summary"] = "must not be longer than 2000 characters"
+	}
+	if utf8.RuneCountInString(profile.Location) > maxLocationLength {
+		details["location"] = "must not be longer than 100 characters"
+	}
+	if profile.Phone != "" && !validPhone.MatchString(profile.Phone) {
+		details["phone"] = "must be a phone number"
+	}
+	if profile.Website != "" && !policy.IsWebURL(profile.Website) {
+		details["website"] = "must be an http or https URL"
+	}
+	if profile.Avatar != "" && !policy.IsWebURL(profile.Avatar) {
+		details["avatar"] = "must be an http or https URL"
+	}
+	if !slices.Contains(model.Availabilities, profile.Availability) {
+		details["availability"] = "must be one of not_looking, open_to_offers, looking"
+	}
+
+	if len(details) > 0 {
+		err := errorx.New(errorx.TypeInvalidParameter, invalidProfileMessage, nil)
+		err.Details = details
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,124 @@
+package usecase_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/model"
+	"devoratio.dev/web-resume/profile/usecase"
+	"devoratio.dev/web-resume/profile/usecase/repositorymock"
+)
+
+var _ = Describe("Profile", Label("profile"), func() {
+	var (
+		mockController *gomock.Controller
+
+		profileRepoMock *repositorymock.MockProfileRepository
+
+		profileUsecase *usecase.Profile
+		commonCtx      context.Context
+		claim          model.Claim
+		request        model.ProfileRequest
+	)
+
+	BeforeEach(func() {
+		mockController = gomock.NewController(GinkgoT())
+
+		profileRepoMock = repositorymock.NewMockProfileRepository(mockController)
+
+		profileUsecase = usecase.NewUsecase(profileRepoMock)
+
+		claim = model.Claim{UserID: 1, Username: "devoratio", Scopes: []string{model.ScopeResumeWrite}}
+		request = model.ProfileRequest{
+			Headline:     "Backend engineer",
+			Summary:      "Building APIs in Go.",
+			Location:     "Jakarta, Indonesia",
+			Phone:        "+62 812-3456-7890",
+			Website:      "https://devoratio.dev",
+			Avatar:       "https://devoratio.dev/avatar.png",
+			Availability: model.AvailabilityOpenToOffers,
+		}
+
+		commonCtx = context.Background()
+	})
+
+	AfterEach(func() {
+		mockController.Finish()
+	})
+
+	Describe("Get the profile", func() {
+		When("the owner has not saved a profile yet", func() {
+			It("returns an empty profile", func(ctx SpecContext) {
+				profileRepoMock.EXPECT().GetProfile(commonCtx, claim.UserID).Return(nil, errorx.ErrNotFound).Times(1)
+
+				result, err := profileUsecase.Get(commonCtx, claim)
+				Expect(err).Should(BeNil())
+				Expect(result.Headline).Should(BeEmpty())
+				Expect(result.Availability).Should(Equal(model.AvailabilityNotLooking))
+			}, SpecTimeout(time.Second*2))
+		})
+
+		When("the owner has saved a profile", func() {
+			It("returns the profile", func(ctx SpecContext) {
+				profile := &model.Profile{OwnerID: claim.UserID, Headline: "Backend engineer"}
+				profileRepoMock.EXPECT().GetProfile(commonCtx, claim.UserID).Return(profile, nil).Times(1)
+
+				result, err := profileUsecase.Get(commonCtx, claim)
+				Expect(err).Should(BeNil())
+				Expect(result).Should(Equal(profile))
+			}, SpecTimeout(time.Second*2))
+		})
+	})
+
+	Describe("Update the profile", func() {
+		When("the token cannot write the resume", func() {
+			It("forbids it", func(ctx SpecContext) {
+				claim.Scopes = []string{model.ScopeResumeRead}
+
+				result, err := profileUsecase.Update(commonCtx, claim, request)
+				Expect(err).Should(Equal(errorx.ErrForbidden))
+				Expect(result).Should(BeNil())
+			}, SpecTimeout(time.Second*2))
+		})
+
+		When("the profile is invalid", func() {
+			It("tells the owner which fields are invalid", func(ctx SpecContext) {
+				request.Website = "javascript:alert(1)"
+				request.Phone = "call me"
+				request.Availability = "maybe"
+
+				result, err := profileUsecase.Update(commonCtx, claim, request)
+				Expect(err.(*errorx.Error).Type).Should(Equal(errorx.TypeInvalidParameter))
+				Expect(err.(*errorx.Error).Details).Should(HaveKey("website"))
+				Expect(err.(*errorx.Error).Details).Should(HaveKey("phone"))
+				Expect(err.(*errorx.Error).Details).Should(HaveKey("availability"))
+				Expect(result).Should(BeNil())
+			}, SpecTimeout(time.Second*2))
+		})
+
+		When("the profile is valid", func() {
+			It("saves the profile of the owner", func(ctx SpecContext) {
+				request.Headline = "  Backend engineer  "
+				request.Availability = ""
+				request.PhonePublic = true
+
+				profileRepoMock.EXPECT().SaveProfile(commonCtx, gomock.Any()).DoAndReturn(
+					func(_ context.Context, profile *model.Profile) error {
+						Expect(profile.OwnerID).Should(Equal(claim.UserID))
+						return nil
+					}).Times(1)
+
+				result, err := profileUsecase.Update(commonCtx, claim, request)
+				Expect(err).Should(BeNil())
+				Expect(result.Headline).Should(Equal("Backend engineer"))
+				Expect(result.Availability).Should(Equal(model.AvailabilityNotLooking))
+				Expect(result.PhonePublic).Should(BeTrue())
+			}, SpecTimeout(time.Second*2))
+		})
+	})
+})
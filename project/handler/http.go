@@ -0,0 +1,190 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/internal/httpx"
+	"devoratio.dev/web-resume/model"
+)
+
+type ProjectUsecase interface {
+	Create(ctx context.Context, claim model.Claim, request model.ProjectRequest) (*model.Project, error)
+	Get(ctx context.Context, claim model.Claim, projectID uint) (*model.Project, error)
+	List(ctx context.Context, claim model.Claim) ([]model.Project, error)
+	Update(ctx context.Context, claim model.Claim, projectID uint, request model.ProjectRequest) (*model.Project, error)
+	Delete(ctx context.Context, claim model.Claim, projectID uint) error
+	Reorder(ctx context.Context, claim model.Claim, request model.OrderRequest) ([]model.Project, error)
+	ResetOrder(ctx context.Context, claim model.Claim) ([]model.Project, error)
+}
+
+type HTTP struct {
+	projectUsecase ProjectUsecase
+}
+
+func NewHTTP(projectUsecase ProjectUsecase) *HTTP {
+	return &HTTP{
+		projectUsecase: projectUsecase,
+	}
+}
+
+// RegisterRoutes registers the project routes, read is required to get the
+// projects and write to change them
+func (h *HTTP) RegisterRoutes(mux *http.ServeMux, read, write httpx.Middleware) {
+	mux.Handle("GET /v1/projects", read(http.HandlerFunc(h.list)))
+	mux.Handle("POST /v1/projects", write(http.HandlerFunc(h.create)))
+	mux.Handle("PUT /v1/projects/order", write(http.HandlerFunc(h.reorder)))
+	mux.Handle("DELETE /v1/projects/order", write(http.HandlerFunc(h.resetOrder)))
+	mux.Handle("GET /v1/projects/{id}", read(http.HandlerFunc(h.get)))
+	mux.Handle("PUT /v1/projects/{id}", write(http.HandlerFunc(h.update)))
+	mux.Handle("DELETE /v1/projects/{id}", write(http.HandlerFunc(h.delete)))
+}
+
+func (h *HTTP) list(w http.ResponseWriter, r *http.Request) {
+	claim, ok := httpx.ClaimFromContext(r.Context())
+	if !ok {
+		httpx.WriteError(w, errorx.ErrUnauthorized)
+		return
+	}
+
+	projects, err := h.projectUsecase.List(r.Context(), *claim)
+	if err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, http.StatusOK, projects)
+}
+
+func (h *HTTP) create(w http.ResponseWriter, r *http.Request) {
+	claim, ok := httpx.ClaimFromContext(r.Context())
+	if !ok {
+		httpx.WriteError(w, errorx.ErrUnauthorized)
+		return
+	}
+
+	var request model.ProjectRequest
+	if err := httpx.DecodeJSON(w, r, &request); err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	project, err := h.projectUsecase.Create(r.Context(), *claim, request)
+	if err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, http.StatusCreated, project)
+}
+
+func (h *HTTP) get(w http.ResponseWriter, r *http.Request) {
+	claim, ok := httpx.ClaimFromContext(r.Context())
+	if !ok {
+		httpx.WriteError(w, errorx.ErrUnauthorized)
+		return
+	}
+
+	projectID, err := strconv.ParseUint(r.PathValue("id"), 10, 0)
+	if err != nil {
+		httpx.WriteError(w, errorx.ErrNotFound)
+		return
+	}
+
+	project, err := h.projectUsecase.Get(r.Context(), *claim, uint(projectID))
+	if err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, http.StatusOK, project)
+}
+
+func (h *HTTP) update(w http.ResponseWriter, r *http.Request) {
+	claim, ok := httpx.ClaimFromContext(r.Context())
+	if !ok {
+		httpx.WriteError(w, errorx.ErrUnauthorized)
+		return
+	}
+
+	projectID, err := strconv.ParseUint(r.PathValue("id"), 10, 0)
+	if err != nil {
+		httpx.WriteError(w, errorx.ErrNotFound)
+		return
+	}
+
+	var request model.ProjectRequest
+	if err := httpx.DecodeJSON(w, r, &request); err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	project, err := h.projectUsecase.Update(r.Context(), *claim, uint(projectID), request)
+	if err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, http.StatusOK, project)
+}
+
+func (h *HTTP) delete(w http.ResponseWriter, r *http.Request) {
+	claim, ok := httpx.ClaimFromContext(r.Context())
+	if !ok {
+		httpx.WriteError(w, errorx.ErrUnauthorized)
+		return
+	}
+
+	projectID, err := strconv.ParseUint(r.PathValue("id"), 10, 0)
+	if err != nil {
+		httpx.WriteError(w, errorx.ErrNotFound)
+		return
+	}
+
+	if err := h.projectUsecase.Delete(r.Context(), *claim, uint(projectID)); err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	httpx.WriteNoContent(w)
+}
+
+func (h *HTTP) reorder(w http.ResponseWriter, r *http.Request) {
+	claim, ok := httpx.ClaimFromContext(r.Context())
+	if !ok {
+		httpx.WriteError(w, errorx.ErrUnauthorized)
+		return
+	}
+
+	var request model.OrderRequest
+	if err := httpx.DecodeJSON(w, r, &request); err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	projects, err := h.projectUsecase.Reorder(r.Context(), *claim, request)
+	if err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, http.StatusOK, projects)
+}
+
+func (h *HTTP) resetOrder(w http.ResponseWriter, r *http.Request) {
+	claim, ok := httpx.ClaimFromContext(r.Context())
+	if !ok {
+		httpx.WriteError(w, errorx.ErrUnauthorized)
+		return
+	}
+
+	projects, err := h.projectUsecase.ResetOrder(r.Context(), *claim)
+	if err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, http.StatusOK, projects)
+}
@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/model"
+	"gorm.io/gorm"
+)
+
+type PostgreSQLDatabase struct {
+	db *gorm.DB
+}
+
+func NewPostgreSQL(db *gorm.DB) *PostgreSQLDatabase {
+	return &PostgreSQLDatabase{
+		db: db,
+	}
+}
+
+func (p *PostgreSQLDatabase) CreateProject(ctx context.Context, project *model.Project) error {
+	if err := p.db.WithContext(ctx).Create(project).Error; err != nil {
+		return errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), err)
+	}
+
+	return nil
+}
+
+func (p *PostgreSQLDatabase) GetProject(ctx context.Context, ownerID, projectID uint) (*model.Project, error) {
+	project := &model.Project{}
+	result := p.db.WithContext(ctx).Where("id = ? AND owner_id = ?", projectID, ownerID).First(project)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, errorx.ErrNotFound
+		}
+		return nil, errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+
+	return project, nil
+}
+
+func (p *PostgreSQLDatabase) ListProjects(ctx context.Context, ownerID uint) ([]model.Project, error) {
+	var projects []model.Project
+	result := p.db.WithContext(ctx).Where("owner_id = ?", ownerID).Order("id").Find(&projects)
+	if result.Error != nil {
+		return nil, errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+
+	return projects, nil
+}
+
+func (p *PostgreSQLDatabase) UpdateProject(ctx context.Context, project *model.Project) error {
+	result := p.db.WithContext(ctx).Model(project).
+		Where("owner_id = ?", project.OwnerID).
+		Select("*").Omit("id", "owner_id", "position", "created_at").
+		Updates(project)
+	if result.Error != nil {
+		return errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return errorx.ErrNotFound
+	}
+
+	return nil
+}
+
+func (p *PostgreSQLDatabase) DeleteProject(ctx context.Context, ownerID, projectID uint) error {
+	result := p.db.WithContext(ctx).Where("id = ? AND owner_id = ?", projectID, ownerID).Delete(&model.Project{})
+	if result.Error != nil {
+		return errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return errorx.ErrNotFound
+	}
+
+	return nil
+}
+
+func (p *PostgreSQLDatabase) SetProjectPositions(ctx context.Context, ownerID uint, ids []uint) error {
+	err := p.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&model.Project{}).Where("owner_id = ?", ownerID).Update("position", nil).Error
+		if err != nil {
+			return err
+		}
+
+		for position, id := range ids {
+			err := tx.Model(&model.Project{}).Where("id = ? AND owner_id = ?", id, ownerID).Update("position", position).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,256 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"devoratio.dev/web-resume/internal/authz"
+	"devoratio.dev/web-resume/internal/entry"
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/internal/policy"
+	"devoratio.dev/web-resume/model"
+)
+
+const (
+	invalidProjectMessage = "project is invalid"
+	invalidOrderMessage   = "order must list every project exactly once"
+
+	maxNameLength        = 100
+	maxDescriptionLength = 2000
+	maxTags              = 30
+	maxTagLength         = 50
+	maxImages            = 20
+	maxCaptionLength     = 200
+)
+
+//go:generate mockgen -destination=repositorymock/postgresqlmock.go -package=repositorymock . ProjectRepository
+type ProjectRepository interface {
+	CreateProject(ctx context.Context, project *model.Project) error
+	GetProject(ctx context.Context, ownerID, projectID uint) (*model.Project, error)
+	ListProjects(ctx context.Context, ownerID uint) ([]model.Project, error)
+	UpdateProject(ctx context.Context, project *model.Project) error
+	DeleteProject(ctx context.Context, ownerID, projectID uint) error
+	// SetProjectPositions stores the positions of the projects of the owner,
+	// clearing them all when ids is empty
+	SetProjectPositions(ctx context.Context, ownerID uint, ids []uint) error
+}
+
+type Project struct {
+	projectRepo ProjectRepository
+}
+
+func NewUsecase(projectRepo ProjectRepository) *Project {
+	return &Project{
+		projectRepo: projectRepo,
+	}
+}
+
+func (p *Project) Create(ctx context.Context, claim model.Claim, request model.ProjectRequest) (*model.Project, error) {
+	if err := authz.RequireScope(claim, model.ScopeResumeWrite); err != nil {
+		return nil, err
+	}
+
+	project := &model.Project{OwnerID: claim.UserID}
+	if err := apply(project, request); err != nil {
+		return nil, err
+	}
+
+	if err := p.projectRepo.CreateProject(ctx, project); err != nil {
+		return nil, err
+	}
+
+	return project, nil
+}
+
+func (p *Project) Get(ctx context.Context, claim model.Claim, projectID uint) (*model.Project, error) {
+	return p.projectRepo.GetProject(ctx, claim.UserID, projectID)
+}
+
+// List returns the projects of the owner in the order they are shown
+func (p *Project) List(ctx context.Context, claim model.Claim) ([]model.Project, error) {
+	projects, err := p.projectRepo.ListProjects(ctx, claim.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	Sort(projects)
+	return projects, nil
+}
+
+func (p *Project) Update(ctx context.Context, claim model.Claim, projectID uint, request model.ProjectRequest) (*model.Project, error) {
+	if err := authz.RequireScope(claim, model.ScopeResumeWrite); err != nil {
+		return nil, err
+	}
+
+	project, err := p.projectRepo.GetProject(ctx, claim.UserID, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := apply(project, request); err != nil {
+		return nil, err
+	}
+
+	if err := p.projectRepo.UpdateProject(ctx, project); err != nil {
+		return nil, err
+	}
+
+	return project, nil
+}
+
+func (p *Project) Delete(ctx context.Context, claim model.Claim, projectID uint) error {
+	if err := authz.RequireScope(claim, model.ScopeResumeWrite); err != nil {
+		return err
+	}
+
+	return p.projectRepo.DeleteProject(ctx, claim.UserID, projectID)
+}
+
+// Reorder puts the projects of the owner in the order of ids, which has to
+// list every one of them
+func (p *Project) Reorder(ctx context.Context, claim model.Claim, request model.OrderRequest) ([]model.Project, error) {
+	if err := authz.RequireScope(claim, model.ScopeResumeWrite); err != nil {
+		return nil, err
+	}
+
+	projects, err := p.projectRepo.ListProjects(ctx, claim.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	existing := make([]uint, 0, len(projects))
+	for _, project := range projects {
+		existing = append(existing, project.ID)
+	}
+	if !entry.IsPermutation(request.IDs, existing) {
+		return nil, errorx.New(errorx.TypeInvalidParameter, invalidOrderMessage, nil)
+	}
+
+	if err := p.projectRepo.SetProjectPositions(ctx, claim.UserID, request.IDs); err != nil {
+		return nil, err
+	}
+
+	return p.List(ctx, claim)
+}
+
+// ResetOrder goes back to listing the featured projects first
+func (p *Project) ResetOrder(ctx context.Context, claim model.Claim) ([]model.Project, error) {
+	if err := authz.RequireScope(claim, model.ScopeResumeWrite); err != nil {
+		return nil, err
+	}
+
+	if err := p.projectRepo.SetProjectPositions(ctx, claim.UserID, nil); err != nil {
+		return nil, err
+	}
+
+	return p.List(ctx, claim)
+}
+
+// Sort orders projects by the position the owner gave them, then featured
+// projects first, then ongoing ones, then the others by when they ended, most
+// recent first
+func Sort(projects []model.Project) {
+	entry.Sort(projects, func(project model.Project) *int {
+		return project.Position
+	}, func(a, b model.Project) int {
+		switch {
+		case a.Featured && !b.Featured:
+			return -1
+		case !a.Featured && b.Featured:
+			return 1
+		case a.End == nil && b.End != nil:
+			return -1
+		case a.End != nil && b.End == nil:
+			return 1
+		case a.End != nil && *a.End != *b.End:
+			return b.End.Time().Compare(a.End.Time())
+		}
+
+		return b.Start.Time().Compare(a.Start.Time())
+	})
+}
+
+// apply validates request and copies it onto project
+func apply(project *model.Project, request model.ProjectRequest) error {
+	project.Title = strings.TrimSpace(request.Title)
+	project.Role = strings.TrimSpace(request.Role)
+	project.Description = strings.TrimSpace(request.Description)
+	project.RepositoryURL = strings.TrimSpace(request.RepositoryURL)
+	project.DemoURL = strings.TrimSpace(request.DemoURL)
+	project.Tags = entry.Dedupe(entry.TrimAll(request.Tags))
+	project.Start = request.Start
+	project.End = request.End
+	project.Featured = request.Featured
+	project.Images = make([]model.ProjectImage, 0, len(request.Images))
+	for _, image := range request.Images {
+		project.Images = append(project.Images, model.ProjectImage{
+			URL:     strings.TrimSpace(image.URL),
+			Caption: strings.TrimSpace(image.Caption),
+		})
+	}
+	project.UpdatedAt = time.Now()
+
+	if details := validate(project); len(details) > 0 {
+		err := errorx.New(errorx.TypeInvalidParameter, invalidProjectMessage, nil)
+		err.Details = details
+		return err
+	}
+
+	return nil
+}
+
+func validate(project *model.Project) map[string]interface{} {
+	details := map[string]interface{}{}
+
+	if project.Title == "" {
+		details["title"] = "must not be empty"
+	} else if utf8.RuneCountInString(project.Title) > maxNameLength {
+		details["title"] = fmt.Sprintf("must not be longer than %d characters", maxNameLength)
+	}
+	if utf8.RuneCountInString(project.Role) > maxNameLength {
+		details["role"] = fmt.Sprintf("must not be longer than %d characters", maxNameLength)
+	}
+	if utf8.RuneCountInString(project.Description) > maxDescriptionLength {
+		details["description"] = fmt.Sprintf("must not be longer than %d characters", maxDescriptionLength)
+	}
+	if project.RepositoryURL != "" && !policy.IsWebURL(project.RepositoryURL) {
+		details["repository_url"] = "must be an http or https URL"
+	}
+	if project.DemoURL != "" && !policy.IsWebURL(project.DemoURL) {
+		details["demo_url"] = "must be an http or https URL"
+	}
+
+	if len(project.Tags) > maxTags {
+		details["tags"] = fmt.Sprintf("must not have more than %d items", maxTags)
+	}
+	for _, tag := range project.Tags {
+		if utf8.RuneCountInString(tag) > maxTagLength {
+			details["tags"] = fmt.Sprintf("must not have items longer than %d characters", maxTagLength)
+		}
+	}
+
+	if project.Start.IsZero() {
+		details["start"] = "must not be empty"
+	} else if project.Start.After(model.MonthOf(time.Now())) {
+		details["start"] = "must not be in the future"
+	}
+	if project.End != nil && project.End.Before(project.Start) {
+		details["end"] = "must not be before start"
+	}
+
+	if len(project.Images) > maxImages {
+		details["images"] = fmt.Sprintf("must not have more than %d items", maxImages)
+	}
+	for _, image := range project.Images {
+		if !policy.IsWebURL(image.URL) {
+			details["images"] = "must only have http or https URLs"
+		} else if utf8.RuneCountInString(image.Caption) > maxCaptionLength {
+			details["images"] = fmt.Sprintf("must not have captions longer than %d characters", maxCaptionLength)
+		}
+	}
+
+	return details
+}
@@ -0,0 +1,149 @@
+package usecase_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/model"
+	"devoratio.dev/web-resume/project/usecase"
+	"devoratio.dev/web-resume/project/usecase/repositorymock"
+)
+
+var _ = Describe("Project", Label("project"), func() {
+	var (
+		mockController *gomock.Controller
+
+		projectRepoMock *repositorymock.MockProjectRepository
+
+		projectUsecase *usecase.Project
+		commonCtx      context.Context
+		claim          model.Claim
+		request        model.ProjectRequest
+	)
+
+	BeforeEach(func() {
+		mockController = gomock.NewController(GinkgoT())
+
+		projectRepoMock = repositorymock.NewMockProjectRepository(mockController)
+
+		projectUsecase = usecase.NewUsecase(projectRepoMock)
+
+		claim = model.Claim{UserID: 1, Username: "devoratio", Scopes: []string{model.ScopeResumeWrite}}
+		request = model.ProjectRequest{
+			Title:         "web-resume",
+			Role:          "Maintainer",
+			Description:   "A self-hosted resume service",
+			RepositoryURL: "https://github.com/devoratio/web-resume",
+			Tags:          []string{" Go ", "go", "PostgreSQL"},
+			Start:         model.NewMonth(2023, time.January),
+			Featured:      true,
+			Images: []model.ProjectImage{
+				{URL: "https://images.devoratio.dev/resume.png", Caption: " The public resume "},
+				{URL: "https://images.devoratio.dev/admin.png"},
+			},
+		}
+
+		commonCtx = context.Background()
+	})
+
+	AfterEach(func() {
+		mockController.Finish()
+	})
+
+	Describe("Create a project", func() {
+		When("the project is invalid", func() {
+			It("tells the owner which fields are invalid", func(ctx SpecContext) {
+				end := model.NewMonth(2022, time.January)
+				request.Title = ""
+				request.DemoURL = "javascript:alert(1)"
+				request.End = &end
+				request.Images = append(request.Images, model.ProjectImage{URL: "ftp://images.devoratio.dev/old.png"})
+
+				result, err := projectUsecase.Create(commonCtx, claim, request)
+				Expect(err.(*errorx.Error).Type).Should(Equal(errorx.TypeInvalidParameter))
+				Expect(err.(*errorx.Error).Details).Should(HaveKey("title"))
+				Expect(err.(*errorx.Error).Details).Should(HaveKey("demo_url"))
+				Expect(err.(*errorx.Error).Details).Should(HaveKey("images"))
+				Expect(err.(*errorx.Error).Details).Should(HaveKeyWithValue("end", "must not be before start"))
+				Expect(result).Should(BeNil())
+			}, SpecTimeout(time.Second*2))
+		})
+
+		When("the project is valid", func() {
+			It("stores the cleaned up project keeping the order of its images", func(ctx SpecContext) {
+				projectRepoMock.EXPECT().CreateProject(commonCtx, gomock.Any()).DoAndReturn(
+					func(_ context.Context, project *model.Project) error {
+						project.ID = 3
+						return nil
+					}).Times(1)
+
+				result, err := projectUsecase.Create(commonCtx, claim, request)
+				Expect(err).Should(BeNil())
+				Expect(result.ID).Should(Equal(uint(3)))
+				Expect(result.OwnerID).Should(Equal(claim.UserID))
+				Expect(result.Tags).Should(Equal([]string{"Go", "PostgreSQL"}))
+				Expect(result.Images).Should(Equal([]model.ProjectImage{
+					{URL: "https://images.devoratio.dev/resume.png", Caption: "The public resume"},
+					{URL: "https://images.devoratio.dev/admin.png"},
+				}))
+			}, SpecTimeout(time.Second*2))
+		})
+	})
+
+	Describe("Update a project", func() {
+		When("the project belongs to someone else", func() {
+			It("tells the owner it does not exist", func(ctx SpecContext) {
+				projectRepoMock.EXPECT().GetProject(commonCtx, claim.UserID, uint(9)).Return(nil, errorx.ErrNotFound).Times(1)
+
+				result, err := projectUsecase.Update(commonCtx, claim, 9, request)
+				Expect(err).Should(Equal(errorx.ErrNotFound))
+				Expect(result).Should(BeNil())
+			}, SpecTimeout(time.Second*2))
+		})
+	})
+
+	Describe("List the projects", func() {
+		It("lists featured projects first, then ongoing ones, then the others most recent first", func(ctx SpecContext) {
+			older, newer := model.NewMonth(2020, time.May), model.NewMonth(2022, time.February)
+			projectRepoMock.EXPECT().ListProjects(commonCtx, claim.UserID).Return([]model.Project{
+				{ID: 1, Start: model.NewMonth(2019, time.January), End: &older},
+				{ID: 2, Start: model.NewMonth(2023, time.March)},
+				{ID: 3, Start: model.NewMonth(2021, time.June), End: &newer},
+				{ID: 4, Start: model.NewMonth(2018, time.June), End: &older, Featured: true},
+			}, nil).Times(1)
+
+			result, err := projectUsecase.List(commonCtx, claim)
+			Expect(err).Should(BeNil())
+			Expect([]uint{result[0].ID, result[1].ID, result[2].ID, result[3].ID}).Should(Equal([]uint{4, 2, 3, 1}))
+		}, SpecTimeout(time.Second*2))
+	})
+
+	Describe("Reorder the projects", func() {
+		BeforeEach(func() {
+			projectRepoMock.EXPECT().ListProjects(commonCtx, claim.UserID).
+				Return([]model.Project{{ID: 1}, {ID: 2}}, nil).AnyTimes()
+		})
+
+		When("the order leaves out a project", func() {
+			It("refuses the order", func(ctx SpecContext) {
+				result, err := projectUsecase.Reorder(commonCtx, claim, model.OrderRequest{IDs: []uint{2}})
+				Expect(err.(*errorx.Error).Type).Should(Equal(errorx.TypeInvalidParameter))
+				Expect(result).Should(BeNil())
+			}, SpecTimeout(time.Second*2))
+		})
+
+		When("the order lists every project", func() {
+			It("stores the positions", func(ctx SpecContext) {
+				projectRepoMock.EXPECT().SetProjectPositions(commonCtx, claim.UserID, []uint{2, 1}).Return(nil).Times(1)
+
+				_, err := projectUsecase.Reorder(commonCtx, claim, model.OrderRequest{IDs: []uint{2, 1}})
+				Expect(err).Should(BeNil())
+			}, SpecTimeout(time.Second*2))
+		})
+	})
+})
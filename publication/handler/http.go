@@ -0,0 +1,148 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/internal/httpx"
+	"devoratio.dev/web-resume/model"
+)
+
+type PublicationUsecase interface {
+	Create(ctx context.Context, claim model.Claim, request model.PublicationRequest) (*model.Publication, error)
+	Get(ctx context.Context, claim model.Claim, publicationID uint) (*model.Publication, error)
+	List(ctx context.Context, claim model.Claim) ([]model.Publication, error)
+	Update(ctx context.Context, claim model.Claim, publicationID uint, request model.PublicationRequest) (*model.Publication, error)
+	Delete(ctx context.Context, claim model.Claim, publicationID uint) error
+}
+
+type HTTP struct {
+	publicationUsecase PublicationUsecase
+}
+
+func NewHTTP(publicationUsecase PublicationUsecase) *HTTP {
+	return &HTTP{
+		publicationUsecase: publicationUsecase,
+	}
+}
+
+// RegisterRoutes registers the publication routes, read is required to get the
+// publications and write to change them
+func (h *HTTP) RegisterRoutes(mux *http.ServeMux, read, write httpx.Middleware) {
+	mux.Handle("GET /v1/publications", read(http.HandlerFunc(h.list)))
+	mux.Handle("POST /v1/publications", write(http.HandlerFunc(h.create)))
+	mux.Handle("GET /v1/publications/{id}", read(http.HandlerFunc(h.get)))
+	mux.Handle("PUT /v1/publications/{id}", write(http.HandlerFunc(h.update)))
+	mux.Handle("DELETE /v1/publications/{id}", write(http.HandlerFunc(h.delete)))
+}
+
+func (h *HTTP) list(w http.ResponseWriter, r *http.Request) {
+	claim, ok := httpx.ClaimFromContext(r.Context())
+	if !ok {
+		httpx.WriteError(w, errorx.ErrUnauthorized)
+		return
+	}
+
+	publications, err := h.publicationUsecase.List(r.Context(), *claim)
+	if err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, http.StatusOK, publications)
+}
+
+func (h *HTTP) create(w http.ResponseWriter, r *http.Request) {
+	claim, ok := httpx.ClaimFromContext(r.Context())
+	if !ok {
+		httpx.WriteError(w, errorx.ErrUnauthorized)
+		return
+	}
+
+	var request model.PublicationRequest
+	if err := httpx.DecodeJSON(w, r, &request); err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	publication, err := h.publicationUsecase.Create(r.Context(), *claim, request)
+	if err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, http.StatusCreated, publication)
+}
+
+func (h *HTTP) get(w http.ResponseWriter, r *http.Request) {
+	claim, ok := httpx.ClaimFromContext(r.Context())
+	if !ok {
+		httpx.WriteError(w, errorx.ErrUnauthorized)
+		return
+	}
+
+	publicationID, err := strconv.ParseUint(r.PathValue("id"), 10, 0)
+	if err != nil {
+		httpx.WriteError(w, errorx.ErrNotFound)
+		return
+	}
+
+	publication, err := h.publicationUsecase.Get(r.Context(), *claim, uint(publicationID))
+	if err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, http.StatusOK, publication)
+}
+
+func (h *HTTP) update(w http.ResponseWriter, r *http.Request) {
+	claim, ok := httpx.ClaimFromContext(r.Context())
+	if !ok {
+		httpx.WriteError(w, errorx.ErrUnauthorized)
+		return
+	}
+
+	publicationID, err := strconv.ParseUint(r.PathValue("id"), 10, 0)
+	if err != nil {
+		httpx.WriteError(w, errorx.ErrNotFound)
+		return
+	}
+
+	var request model.PublicationRequest
+	if err := httpx.DecodeJSON(w, r, &request); err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	publication, err := h.publicationUsecase.Update(r.Context(), *claim, uint(publicationID), request)
+	if err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, http.StatusOK, publication)
+}
+
+func (h *HTTP) delete(w http.ResponseWriter, r *http.Request) {
+	claim, ok := httpx.ClaimFromContext(r.Context())
+	if !ok {
+		httpx.WriteError(w, errorx.ErrUnauthorized)
+		return
+	}
+
+	publicationID, err := strconv.ParseUint(r.PathValue("id"), 10, 0)
+	if err != nil {
+		httpx.WriteError(w, errorx.ErrNotFound)
+		return
+	}
+
+	if err := h.publicationUsecase.Delete(r.Context(), *claim, uint(publicationID)); err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	httpx.WriteNoContent(w)
+}
@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/model"
+	"gorm.io/gorm"
+)
+
+type PostgreSQLDatabase struct {
+	db *gorm.DB
+}
+
+func NewPostgreSQL(db *gorm.DB) *PostgreSQLDatabase {
+	return &PostgreSQLDatabase{
+		db: db,
+	}
+}
+
+func (p *PostgreSQLDatabase) CreatePublication(ctx context.Context, publication *model.Publication) error {
+	if err := p.db.WithContext(ctx).Create(publication).Error; err != nil {
+		return errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), err)
+	}
+
+	return nil
+}
+
+func (p *PostgreSQLDatabase) GetPublication(ctx context.Context, ownerID, publicationID uint) (*model.Publication, error) {
+	publication := &model.Publication{}
+	result := p.db.WithContext(ctx).Where("id = ? AND owner_id = ?", publicationID, ownerID).First(publication)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, errorx.ErrNotFound
+		}
+		return nil, errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+
+	return publication, nil
+}
+
+func (p *PostgreSQLDatabase) ListPublications(ctx context.Context, ownerID uint) ([]model.Publication, error) {
+	var publications []model.Publication
+	result := p.db.WithContext(ctx).Where("owner_id = ?", ownerID).Order("date DESC, id").Find(&publications)
+	if result.Error != nil {
+		return nil, errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+
+	return publications, nil
+}
+
+func (p *PostgreSQLDatabase) UpdatePublication(ctx context.Context, publication *model.Publication) error {
+	result := p.db.WithContext(ctx).Model(publication).
+		Where("owner_id = ?", publication.OwnerID).
+		Select("*").Omit("id", "owner_id", "created_at").
+		Updates(publication)
+	if result.Error != nil {
+		return errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return errorx.ErrNotFound
+	}
+
+	return nil
+}
+
+func (p *PostgreSQLDatabase) DeletePublication(ctx context.Context, ownerID, publicationID uint) error {
+	result := p.db.WithContext(ctx).Where("id = ? AND owner_id = ?", publicationID, ownerID).Delete(&model.Publication{})
+	if result.Error != nil {
+		return errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return errorx.ErrNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,237 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"devoratio.dev/web-resume/internal/authz"
+	"devoratio.dev/web-resume/internal/entry"
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/internal/policy"
+	"devoratio.dev/web-resume/model"
+)
+
+const (
+	invalidPublicationMessage = "publication is invalid"
+
+	maxNameLength        = 200
+	maxDescriptionLength = 2000
+	maxPullRequests      = 20
+)
+
+// doiPattern matches a DOI once its resolver prefix is removed, see
+// https://www.doi.org/doi-handbook/HTML/doi-syntax.html
+var doiPattern = regexp.MustCompile(`^10\.\d{4,9}/\S+$`)
+
+// doiPrefixes are the ways a DOI is commonly written in front of the DOI itself
+var doiPrefixes = []string{"https://doi.org/", "http://doi.org/", "https://dx.doi.org/", "http://dx.doi.org/", "doi:"}
+
+//go:generate mockgen -destination=repositorymock/postgresqlmock.go -package=repositorymock . PublicationRepository
+type PublicationRepository interface {
+	CreatePublication(ctx context.Context, publication *model.Publication) error
+	GetPublication(ctx context.Context, ownerID, publicationID uint) (*model.Publication, error)
+	ListPublications(ctx context.Context, ownerID uint) ([]model.Publication, error)
+	UpdatePublication(ctx context.Context, publication *model.Publication) error
+	DeletePublication(ctx context.Context, ownerID, publicationID uint) error
+}
+
+type Publication struct {
+	publicationRepo PublicationRepository
+}
+
+func NewUsecase(publicationRepo PublicationRepository) *Publication {
+	return &Publication{
+		publicationRepo: publicationRepo,
+	}
+}
+
+func (p *Publication) Create(ctx context.Context, claim model.Claim, request model.PublicationRequest) (*model.Publication, error) {
+	if err := authz.RequireScope(claim, model.ScopeResumeWrite); err != nil {
+		return nil, err
+	}
+
+	publication := &model.Publication{OwnerID: claim.UserID}
+	if err := apply(publication, request); err != nil {
+		return nil, err
+	}
+
+	if err := p.publicationRepo.CreatePublication(ctx, publication); err != nil {
+		return nil, err
+	}
+
+	return publication, nil
+}
+
+func (p *Publication) Get(ctx context.Context, claim model.Claim, publicationID uint) (*model.Publication, error) {
+	return p.publicationRepo.GetPublication(ctx, claim.UserID, publicationID)
+}
+
+// List returns the publications of the owner, most recent first
+func (p *Publication) List(ctx context.Context, claim model.Claim) ([]model.Publication, error) {
+	publications, err := p.publicationRepo.ListPublications(ctx, claim.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	Sort(publications)
+	return publications, nil
+}
+
+func (p *Publication) Update(ctx context.Context, claim model.Claim, publicationID uint, request model.PublicationRequest) (*model.Publication, error) {
+	if err := authz.RequireScope(claim, model.ScopeResumeWrite); err != nil {
+		return nil, err
+	}
+
+	publication, err := p.publicationRepo.GetPublication(ctx, claim.UserID, publicationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := apply(publication, request); err != nil {
+		return nil, err
+	}
+
+	if err := p.publicationRepo.UpdatePublication(ctx, publication); err != nil {
+		return nil, err
+	}
+
+	return publication, nil
+}
+
+func (p *Publication) Delete(ctx context.Context, claim model.Claim, publicationID uint) error {
+	if err := authz.RequireScope(claim, model.ScopeResumeWrite); err != nil {
+		return err
+	}
+
+	return p.publicationRepo.DeletePublication(ctx, claim.UserID, publicationID)
+}
+
+// Sort orders publications most recent first
+func Sort(publications []model.Publication) {
+	slices.SortStableFunc(publications, func(a, b model.Publication) int {
+		return b.Date.Time().Compare(a.Date.Time())
+	})
+}
+
+// NormalizeDOI strips the resolver prefix a DOI may be written with, DOIs
+// being case insensitive it is lowercased too
+func NormalizeDOI(doi string) string {
+	doi = strings.TrimSpace(doi)
+	for _, prefix := range doiPrefixes {
+		if len(doi) >= len(prefix) && strings.EqualFold(doi[:len(prefix)], prefix) {
+			doi = doi[len(prefix):]
+			break
+		}
+	}
+
+	return strings.ToLower(doi)
+}
+
+// apply validates request and copies it onto publication
+func apply(publication *model.Publication, request model.PublicationRequest) error {
+	publication.Type = request.Type
+	publication.Title = strings.TrimSpace(request.Title)
+	publication.Description = strings.TrimSpace(request.Description)
+	publication.URL = strings.TrimSpace(request.URL)
+	publication.Date = request.Date
+	publication.Venue = strings.TrimSpace(request.Venue)
+	publication.DOI = NormalizeDOI(request.DOI)
+	publication.SlidesURL = strings.TrimSpace(request.SlidesURL)
+	publication.VideoURL = strings.TrimSpace(request.VideoURL)
+	publication.PullRequests = entry.Dedupe(entry.TrimAll(request.PullRequests))
+	publication.UpdatedAt = time.Now()
+
+	if details := validate(publication); len(details) > 0 {
+		err := errorx.New(errorx.TypeInvalidParameter, invalidPublicationMessage, nil)
+		err.Details = details
+		return err
+	}
+
+	return nil
+}
+
+func validate(publication *model.Publication) map[string]interface{} {
+	details := map[string]interface{}{}
+
+	if !slices.Contains(model.PublicationTypes, publication.Type) {
+		details["type"] = "must be one of article, talk, contribution"
+	}
+	if publication.Title == "" {
+		details["title"] = "must not be empty"
+	} else if utf8.RuneCountInString(publication.Title) > maxNameLength {
+		details["title"] = fmt.Sprintf("must not be longer than %d characters", maxNameLength)
+	}
+	if utf8.RuneCountInString(publication.Description) > maxDescriptionLength {
+		details["description"] = fmt.Sprintf("must not be longer than %d characters", maxDescriptionLength)
+	}
+	if utf8.RuneCountInString(publication.Venue) > maxNameLength {
+		details["venue"] = fmt.Sprintf("must not be longer than %d characters", maxNameLength)
+	}
+	if publication.Date.IsZero() {
+		details["date"] = "must not be empty"
+	}
+
+	for field, value := range map[string]string{
+		"url":        publication.URL,
+		"slides_url": publication.SlidesURL,
+		"video_url":  publication.VideoURL,
+	} {
+		if value != "" && !policy.IsWebURL(value) {
+			details[field] = "must be an http or https URL"
+		}
+	}
+	if publication.DOI != "" && !doiPattern.MatchString(publication.DOI) {
+		details["doi"] = "must be a DOI such as 10.1000/182"
+	}
+	if len(publication.PullRequests) > maxPullRequests {
+		details["pull_requests"] = fmt.Sprintf("must not have more than %d items", maxPullRequests)
+	}
+	for _, pullRequest := range publication.PullRequests {
+		if !policy.IsWebURL(pullRequest) {
+			details["pull_requests"] = "must only have http or https URLs"
+		}
+	}
+
+	// Fields of the other types are refused rather than silently dropped
+	switch publication.Type {
+	case model.PublicationArticle:
+		rejectFields(details, "an article", map[string]bool{
+			"slides_url":    publication.SlidesURL != "",
+			"video_url":     publication.VideoURL != "",
+			"pull_requests": len(publication.PullRequests) > 0,
+		})
+	case model.PublicationTalk:
+		if publication.Venue == "" {
+			details["venue"] = "must not be empty for a talk"
+		}
+		rejectFields(details, "a talk", map[string]bool{
+			"doi":           publication.DOI != "",
+			"pull_requests": len(publication.PullRequests) > 0,
+		})
+	case model.PublicationContribution:
+		if len(publication.PullRequests) == 0 {
+			details["pull_requests"] = "must not be empty for a contribution"
+		}
+		rejectFields(details, "a contribution", map[string]bool{
+			"venue":      publication.Venue != "",
+			"doi":        publication.DOI != "",
+			"slides_url": publication.SlidesURL != "",
+			"video_url":  publication.VideoURL != "",
+		})
+	}
+
+	return details
+}
+
+func rejectFields(details map[string]interface{}, kind string, set map[string]bool) {
+	for field, isSet := range set {
+		if isSet {
+			details[field] = "must be empty for " + kind
+		}
+	}
+}
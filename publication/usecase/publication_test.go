@@ -0,0 +1,118 @@
+package usecase_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/model"
+	"devoratio.dev/web-resume/publication/usecase"
+	"devoratio.dev/web-resume/publication/usecase/repositorymock"
+)
+
+var _ = Describe("Publication", Label("publication"), func() {
+	var (
+		mockController *gomock.Controller
+
+		publicationRepoMock *repositorymock.MockPublicationRepository
+
+		publicationUsecase *usecase.Publication
+		commonCtx          context.Context
+		claim              model.Claim
+		request            model.PublicationRequest
+	)
+
+	BeforeEach(func() {
+		mockController = gomock.NewController(GinkgoT())
+
+		publicationRepoMock = repositorymock.NewMockPublicationRepository(mockController)
+
+		publicationUsecase = usecase.NewUsecase(publicationRepoMock)
+
+		claim = model.Claim{UserID: 1, Username: "devoratio", Scopes: []string{model.ScopeResumeWrite}}
+		request = model.PublicationRequest{
+			Type:  model.PublicationArticle,
+			Title: "Consistent hashing in practice",
+			URL:   "https://journal.devoratio.dev/consistent-hashing",
+			Date:  model.NewDate(2023, time.May, 4),
+			Venue: "Journal of Systems Engineering",
+			DOI:   " https://doi.org/10.1145/3183713.3196930 ",
+		}
+
+		commonCtx = context.Background()
+	})
+
+	AfterEach(func() {
+		mockController.Finish()
+	})
+
+	Describe("Create a publication", func() {
+		When("the publication is invalid", func() {
+			It("tells the owner which fields are invalid", func(ctx SpecContext) {
+				request.Title = ""
+				request.DOI = "not-a-doi"
+				request.Date = model.Date{}
+
+				result, err := publicationUsecase.Create(commonCtx, claim, request)
+				Expect(err.(*errorx.Error).Type).Should(Equal(errorx.TypeInvalidParameter))
+				Expect(err.(*errorx.Error).Details).Should(HaveKey("title"))
+				Expect(err.(*errorx.Error).Details).Should(HaveKey("doi"))
+				Expect(err.(*errorx.Error).Details).Should(HaveKey("date"))
+				Expect(result).Should(BeNil())
+			}, SpecTimeout(time.Second*2))
+		})
+
+		When("a talk has fields of a contribution", func() {
+			It("refuses the fields that don't apply to talks", func(ctx SpecContext) {
+				request.Type = model.PublicationTalk
+				request.Venue = ""
+				request.DOI = ""
+				request.PullRequests = []string{"https://github.com/golang/go/pull/1"}
+
+				_, err := publicationUsecase.Create(commonCtx, claim, request)
+				Expect(err.(*errorx.Error).Details).Should(HaveKeyWithValue("venue", "must not be empty for a talk"))
+				Expect(err.(*errorx.Error).Details).Should(HaveKeyWithValue("pull_requests", "must be empty for a talk"))
+			}, SpecTimeout(time.Second*2))
+		})
+
+		When("a contribution has no pull requests", func() {
+			It("asks for them", func(ctx SpecContext) {
+				request.Type = model.PublicationContribution
+				request.Venue = ""
+				request.DOI = ""
+
+				_, err := publicationUsecase.Create(commonCtx, claim, request)
+				Expect(err.(*errorx.Error).Details).Should(HaveKeyWithValue("pull_requests", "must not be empty for a contribution"))
+			}, SpecTimeout(time.Second*2))
+		})
+
+		When("the article is valid", func() {
+			It("stores the DOI without its resolver", func(ctx SpecContext) {
+				publicationRepoMock.EXPECT().CreatePublication(commonCtx, gomock.Any()).Return(nil).Times(1)
+
+				result, err := publicationUsecase.Create(commonCtx, claim, request)
+				Expect(err).Should(BeNil())
+				Expect(result.OwnerID).Should(Equal(claim.UserID))
+				Expect(result.DOI).Should(Equal("10.1145/3183713.3196930"))
+			}, SpecTimeout(time.Second*2))
+		})
+	})
+
+	Describe("List the publications", func() {
+		It("lists the most recent first", func(ctx SpecContext) {
+			publicationRepoMock.EXPECT().ListPublications(commonCtx, claim.UserID).Return([]model.Publication{
+				{ID: 1, Date: model.NewDate(2021, time.March, 1)},
+				{ID: 2, Date: model.NewDate(2023, time.October, 12)},
+				{ID: 3, Date: model.NewDate(2022, time.July, 30)},
+			}, nil).Times(1)
+
+			result, err := publicationUsecase.List(commonCtx, claim)
+			Expect(err).Should(BeNil())
+			Expect([]uint{result[0].ID, result[1].ID, result[2].ID}).Should(Equal([]uint{2, 3, 1}))
+		}, SpecTimeout(time.Second*2))
+	})
+})
@@ -0,0 +1,108 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: devoratio.dev/web-resume/publication/usecase (interfaces: PublicationRepository)
+
+// Package repositorymock is a generated GoMock package.
+package repositorymock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "devoratio.dev/web-resume/model"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockPublicationRepository is a mock of PublicationRepository interface.
+type MockPublicationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPublicationRepositoryMockRecorder
+}
+
+// MockPublicationRepositoryMockRecorder is the mock recorder for MockPublicationRepository.
+type MockPublicationRepositoryMockRecorder struct {
+	mock *MockPublicationRepository
+}
+
+// NewMockPublicationRepository creates a new mock instance.
+func NewMockPublicationRepository(ctrl *gomock.Controller) *MockPublicationRepository {
+	mock := &MockPublicationRepository{ctrl: ctrl}
+	mock.recorder = &MockPublicationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPublicationRepository) EXPECT() *MockPublicationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreatePublication mocks base method.
+func (m *MockPublicationRepository) CreatePublication(arg0 context.Context, arg1 *model.Publication) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePublication", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreatePublication indicates an expected call of CreatePublication.
+func (mr *MockPublicationRepositoryMockRecorder) CreatePublication(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePublication", reflect.TypeOf((*MockPublicationRepository)(nil).CreatePublication), arg0, arg1)
+}
+
+// DeletePublication mocks base method.
+func (m *MockPublicationRepository) DeletePublication(arg0 context.Context, arg1, arg2 uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePublication", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePublication indicates an expected call of DeletePublication.
+func (mr *MockPublicationRepositoryMockRecorder) DeletePublication(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePublication", reflect.TypeOf((*MockPublicationRepository)(nil).DeletePublication), arg0, arg1, arg2)
+}
+
+// GetPublication mocks base method.
+func (m *MockPublicationRepository) GetPublication(arg0 context.Context, arg1, arg2 uint) (*model.Publication, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPublication", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*model.Publication)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPublication indicates an expected call of GetPublication.
+func (mr *MockPublicationRepositoryMockRecorder) GetPublication(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPublication", reflect.TypeOf((*MockPublicationRepository)(nil).GetPublication), arg0, arg1, arg2)
+}
+
+// ListPublications mocks base method.
+func (m *MockPublicationRepository) ListPublications(arg0 context.Context, arg1 uint) ([]model.Publication, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPublications", arg0, arg1)
+	ret0, _ := ret[0].([]model.Publication)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPublications indicates an expected call of ListPublications.
+func (mr *MockPublicationRepositoryMockRecorder) ListPublications(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPublications", reflect.TypeOf((*MockPublicationRepository)(nil).ListPublications), arg0, arg1)
+}
+
+// UpdatePublication mocks base method.
+func (m *MockPublicationRepository) UpdatePublication(arg0 context.Context, arg1 *model.Publication) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePublication", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePublication indicates an expected call of UpdatePublication.
+func (mr *MockPublicationRepositoryMockRecorder) UpdatePublication(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePublication", reflect.TypeOf((*MockPublicationRepository)(nil).UpdatePublication), arg0, arg1)
+}
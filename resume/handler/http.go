@@ -0,0 +1,62 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+
+	"devoratio.dev/web-resume/internal/httpx"
+	"devoratio.dev/web-resume/model"
+)
+
+type ResumeUsecase interface {
+	Get(ctx context.Context) (*model.Resume, error)
+	Projects(ctx context.Context, tag string) ([]model.Project, error)
+	Variant(ctx context.Context, slug string) (*model.Resume, error)
+}
+
+type HTTP struct {
+	resumeUsecase ResumeUsecase
+}
+
+func NewHTTP(resumeUsecase ResumeUsecase) *HTTP {
+	return &HTTP{
+		resumeUsecase: resumeUsecase,
+	}
+}
+
+// RegisterRoutes registers the public resume, which needs no authentication
+func (h *HTTP) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /v1/resume", h.get)
+	mux.HandleFunc("GET /v1/resume/projects", h.projects)
+	mux.HandleFunc("GET /v1/resume/{slug}", h.variant)
+}
+
+func (h *HTTP) get(w http.ResponseWriter, r *http.Request) {
+	resume, err := h.resumeUsecase.Get(r.Context())
+	if err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, http.StatusOK, resume)
+}
+
+func (h *HTTP) projects(w http.ResponseWriter, r *http.Request) {
+	projects, err := h.resumeUsecase.Projects(r.Context(), r.URL.Query().Get("tag"))
+	if err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, http.StatusOK, projects)
+}
+
+func (h *HTTP) variant(w http.ResponseWriter, r *http.Request) {
+	resume, err := h.resumeUsecase.Variant(r.Context(), r.PathValue("slug"))
+	if err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, http.StatusOK, resume)
+}
@@ -0,0 +1,177 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/internal/tenancy"
+	"devoratio.dev/web-resume/model"
+	"gorm.io/gorm"
+)
+
+type PostgreSQLDatabase struct {
+	db *gorm.DB
+}
+
+func NewPostgreSQL(db *gorm.DB) *PostgreSQLDatabase {
+	return &PostgreSQLDatabase{
+		db: db,
+	}
+}
+
+func (p *PostgreSQLDatabase) GetResumeOwnerID(ctx context.Context) (uint, error) {
+	owner := &model.OwnerAccount{}
+	result := p.db.WithContext(ctx).Select("id").Scopes(tenancy.Scope(ctx, "id")).Order("id").First(owner)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return 0, errorx.ErrNotFound
+		}
+		return 0, errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+
+	return owner.ID, nil
+}
+
+func (p *PostgreSQLDatabase) GetProfile(ctx context.Context, ownerID uint) (*model.Profile, error) {
+	profile := &model.Profile{}
+	result := p.db.WithContext(ctx).Where("owner_id = ?", ownerID).First(profile)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, errorx.ErrNotFound
+		}
+		return nil, errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+
+	return profile, nil
+}
+
+func (p *PostgreSQLDatabase) ListExperiences(ctx context.Context, ownerID uint) ([]model.Experience, error) {
+	var experiences []model.Experience
+	result := p.db.WithContext(ctx).Where("owner_id = ?", ownerID).Order("id").Find(&experiences)
+	if result.Error != nil {
+		return nil, errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+
+	return experiences, nil
+}
+
+func (p *PostgreSQLDatabase) ListEducation(ctx context.Context, ownerID uint) ([]model.Education, error) {
+	var education []model.Education
+	result := p.db.WithContext(ctx).Where("owner_id = ?", ownerID).Order("id").Find(&education)
+	if result.Error != nil {
+		return nil, errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+
+	return education, nil
+}
+
+func (p *PostgreSQLDatabase) ListProjects(ctx context.Context, ownerID uint) ([]model.Project, error) {
+	var projects []model.Project
+	result := p.db.WithContext(ctx).Where("owner_id = ?", ownerID).Order("id").Find(&projects)
+	if result.Error != nil {
+		return nil, errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+
+	return projects, nil
+}
+
+func (p *PostgreSQLDatabase) ListCertifications(ctx context.Context, ownerID uint) ([]model.Certification, error) {
+	var certifications []model.Certification
+	result := p.db.WithContext(ctx).Where("owner_id = ?", ownerID).Order("issued_on DESC, id").Find(&certifications)
+	if result.Error != nil {
+		return nil, errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+
+	return certifications, nil
+}
+
+func (p *PostgreSQLDatabase) ListPublications(ctx context.Context, ownerID uint) ([]model.Publication, error) {
+	var publications []model.Publication
+	result := p.db.WithContext(ctx).Where("owner_id = ?", ownerID).Order("date DESC, id").Find(&publications)
+	if result.Error != nil {
+		return nil, errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+
+	return publications, nil
+}
+
+func (p *PostgreSQLDatabase) ListLanguages(ctx context.Context, ownerID uint) ([]model.Language, error) {
+	var languages []model.Language
+	result := p.db.WithContext(ctx).Where("owner_id = ?", ownerID).Order("id").Find(&languages)
+	if result.Error != nil {
+		return nil, errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+
+	return languages, nil
+}
+
+func (p *PostgreSQLDatabase) ListPublicLinks(ctx context.Context, ownerID uint) ([]model.Link, error) {
+	var links []model.Link
+	result := p.db.WithContext(ctx).Where("owner_id = ? AND public", ownerID).Order("id").Find(&links)
+	if result.Error != nil {
+		return nil, errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+
+	return links, nil
+}
+
+func (p *PostgreSQLDatabase) GetDefaultVariant(ctx context.Context, ownerID uint) (*model.Variant, error) {
+	return p.getVariant(ctx, "owner_id = ? AND is_default", ownerID)
+}
+
+func (p *PostgreSQLDatabase) GetVariantBySlug(ctx context.Context, ownerID uint, slug string) (*model.Variant, error) {
+	return p.getVariant(ctx, "owner_id = ? AND slug = ?", ownerID, slug)
+}
+
+func (p *PostgreSQLDatabase) getVariant(ctx context.Context, query string, args ...interface{}) (*model.Variant, error) {
+	variant := &model.Variant{}
+	result := p.db.WithContext(ctx).Where(query, args...).First(variant)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, errorx.ErrNotFound
+		}
+		return nil, errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+
+	return variant, nil
+}
+
+func (p *PostgreSQLDatabase) ListSkillCategories(ctx context.Context, ownerID uint) ([]model.SkillCategory, error) {
+	var categories []model.SkillCategory
+	result := p.db.WithContext(ctx).Where("owner_id = ?", ownerID).Order("lower(name)").Find(&categories)
+	if result.Error != nil {
+		return nil, errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+
+	return categories, nil
+}
+
+func (p *PostgreSQLDatabase) ListSkills(ctx context.Context, ownerID uint) ([]model.Skill, error) {
+	var skills []model.Skill
+	result := p.db.WithContext(ctx).Where("owner_id = ?", ownerID).Order("lower(name)").Find(&skills)
+	if result.Error != nil {
+		return nil, errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+
+	var aliases []model.SkillAlias
+	result = p.db.WithContext(ctx).Where("owner_id = ?", ownerID).Order("id").Find(&aliases)
+	if result.Error != nil {
+		return nil, errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+
+	bySkill := map[uint][]string{}
+	for _, alias := range aliases {
+		bySkill[alias.SkillID] = append(bySkill[alias.SkillID], alias.Name)
+	}
+	for i := range skills {
+		skills[i].Aliases = []string{}
+		for _, name := range bySkill[skills[i].ID] {
+			if name != skills[i].Name {
+				skills[i].Aliases = append(skills[i].Aliases, name)
+			}
+		}
+	}
+
+	return skills, nil
+}
@@ -0,0 +1,231 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: devoratio.dev/web-resume/resume/usecase (interfaces: ResumeRepository)
+
+// Package repositorymock is a generated GoMock package.
+package repositorymock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "devoratio.dev/web-resume/model"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockResumeRepository is a mock of ResumeRepository interface.
+type MockResumeRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockResumeRepositoryMockRecorder
+}
+
+// MockResumeRepositoryMockRecorder is the mock recorder for MockResumeRepository.
+type MockResumeRepositoryMockRecorder struct {
+	mock *MockResumeRepository
+}
+
+// NewMockResumeRepository creates a new mock instance.
+func NewMockResumeRepository(ctrl *gomock.Controller) *MockResumeRepository {
+	mock := &MockResumeRepository{ctrl: ctrl}
+	mock.recorder = &MockResumeRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockResumeRepository) EXPECT() *MockResumeRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetDefaultVariant mocks base method.
+func (m *MockResumeRepository) GetDefaultVariant(arg0 context.Context, arg1 uint) (*model.Variant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDefaultVariant", arg0, arg1)
+	ret0, _ := ret[0].(*model.Variant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDefaultVariant indicates an expected call of GetDefaultVariant.
+func (mr *MockResumeRepositoryMockRecorder) GetDefaultVariant(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDefaultVariant", reflect.TypeOf((*MockResumeRepository)(nil).GetDefaultVariant), arg0, arg1)
+}
+
+// GetProfile mocks base method.
+func (m *MockResumeRepository) GetProfile(arg0 context.Context, arg1 uint) (*model.Profile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProfile", arg0, arg1)
+	ret0, _ := ret[0].(*model.Profile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProfile indicates an expected call of GetProfile.
+func (mr *MockResumeRepositoryMockRecorder) GetProfile(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProfile", reflect.TypeOf((*MockResumeRepository)(nil).GetProfile), arg0, arg1)
+}
+
+// GetResumeOwnerID mocks base method.
+func (m *MockResumeRepository) GetResumeOwnerID(arg0 context.Context) (uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetResumeOwnerID", arg0)
+	ret0, _ := ret[0].(uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetResumeOwnerID indicates an expected call of GetResumeOwnerID.
+func (mr *MockResumeRepositoryMockRecorder) GetResumeOwnerID(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResumeOwnerID", reflect.TypeOf((*MockResumeRepository)(nil).GetResumeOwnerID), arg0)
+}
+
+// GetVariantBySlug mocks base method.
+func (m *MockResumeRepository) GetVariantBySlug(arg0 context.Context, arg1 uint, arg2 string) (*model.Variant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVariantBySlug", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*model.Variant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVariantBySlug indicates an expected call of GetVariantBySlug.
+func (mr *MockResumeRepositoryMockRecorder) GetVariantBySlug(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVariantBySlug", reflect.TypeOf((*MockResumeRepository)(nil).GetVariantBySlug), arg0, arg1, arg2)
+}
+
+// ListCertifications mocks base method.
+func (m *MockResumeRepository) ListCertifications(arg0 context.Context, arg1 uint) ([]model.Certification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCertifications", arg0, arg1)
+	ret0, _ := ret[0].([]model.Certification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCertifications indicates an expected call of ListCertifications.
+func (mr *MockResumeRepositoryMockRecorder) ListCertifications(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCertifications", reflect.TypeOf((*MockResumeRepository)(nil).ListCertifications), arg0, arg1)
+}
+
+// ListEducation mocks base method.
+func (m *MockResumeRepository) ListEducation(arg0 context.Context, arg1 uint) ([]model.Education, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEducation", arg0, arg1)
+	ret0, _ := ret[0].([]model.Education)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEducation indicates an expected call of ListEducation.
+func (mr *MockResumeRepositoryMockRecorder) ListEducation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEducation", reflect.TypeOf((*MockResumeRepository)(nil).ListEducation), arg0, arg1)
+}
+
+// ListExperiences mocks base method.
+func (m *MockResumeRepository) ListExperiences(arg0 context.Context, arg1 uint) ([]model.Experience, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListExperiences", arg0, arg1)
+	ret0, _ := ret[0].([]model.Experience)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListExperiences indicates an expected call of ListExperiences.
+func (mr *MockResumeRepositoryMockRecorder) ListExperiences(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExperiences", reflect.TypeOf((*MockResumeRepository)(nil).ListExperiences), arg0, arg1)
+}
+
+// ListLanguages mocks base method.
+func (m *MockResumeRepository) ListLanguages(arg0 context.Context, arg1 uint) ([]model.Language, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLanguages", arg0, arg1)
+	ret0, _ := ret[0].([]model.Language)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLanguages indicates an expected call of ListLanguages.
+func (mr *MockResumeRepositoryMockRecorder) ListLanguages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLanguages", reflect.TypeOf((*MockResumeRepository)(nil).ListLanguages), arg0, arg1)
+}
+
+// ListProjects mocks base method.
+func (m *MockResumeRepository) ListProjects(arg0 context.Context, arg1 uint) ([]model.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProjects", arg0, arg1)
+	ret0, _ := ret[0].([]model.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProjects indicates an expected call of ListProjects.
+func (mr *MockResumeRepositoryMockRecorder) ListProjects(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjects", reflect.TypeOf((*MockResumeRepository)(nil).ListProjects), arg0, arg1)
+}
+
+// ListPublicLinks mocks base method.
+func (m *MockResumeRepository) ListPublicLinks(arg0 context.Context, arg1 uint) ([]model.Link, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPublicLinks", arg0, arg1)
+	ret0, _ := ret[0].([]model.Link)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPublicLinks indicates an expected call of ListPublicLinks.
+func (mr *MockResumeRepositoryMockRecorder) ListPublicLinks(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPublicLinks", reflect.TypeOf((*MockResumeRepository)(nil).ListPublicLinks), arg0, arg1)
+}
+
+// ListPublications mocks base method.
+func (m *MockResumeRepository) ListPublications(arg0 context.Context, arg1 uint) ([]model.Publication, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPublications", arg0, arg1)
+	ret0, _ := ret[0].([]model.Publication)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPublications indicates an expected call of ListPublications.
+func (mr *MockResumeRepositoryMockRecorder) ListPublications(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPublications", reflect.TypeOf((*MockResumeRepository)(nil).ListPublications), arg0, arg1)
+}
+
+// ListSkillCategories mocks base method.
+func (m *MockResumeRepository) ListSkillCategories(arg0 context.Context, arg1 uint) ([]model.SkillCategory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSkillCategories", arg0, arg1)
+	ret0, _ := ret[0].([]model.SkillCategory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSkillCategories indicates an expected call of ListSkillCategories.
+func (mr *MockResumeRepositoryMockRecorder) ListSkillCategories(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSkillCategories", reflect.TypeOf((*MockResumeRepository)(nil).ListSkillCategories), arg0, arg1)
+}
+
+// ListSkills mocks base method.
+func (m *MockResumeRepository) ListSkills(arg0 context.Context, arg1 uint) ([]model.Skill, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSkills", arg0, arg1)
+	ret0, _ := ret[0].([]model.Skill)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSkills indicates an expected call of ListSkills.
+func (mr *MockResumeRepositoryMockRecorder) ListSkills(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSkills", reflect.TypeOf((*MockResumeRepository)(nil).ListSkills), arg0, arg1)
+}
@@ -0,0 +1,280 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	certificationusecase "devoratio.dev/web-resume/certification/usecase"
+	"devoratio.dev/web-resume/config"
+	educationusecase "devoratio.dev/web-resume/education/usecase"
+	experienceusecase "devoratio.dev/web-resume/experience/usecase"
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/internal/identifier"
+	languageusecase "devoratio.dev/web-resume/language/usecase"
+	linkusecase "devoratio.dev/web-resume/link/usecase"
+	"devoratio.dev/web-resume/model"
+	projectusecase "devoratio.dev/web-resume/project/usecase"
+	publicationusecase "devoratio.dev/web-resume/publication/usecase"
+	skillusecase "devoratio.dev/web-resume/skill/usecase"
+)
+
+//go:generate mockgen -destination=repositorymock/postgresqlmock.go -package=repositorymock . ResumeRepository
+type ResumeRepository interface {
+	// GetResumeOwnerID returns the owner of the tenant in ctx, or the owner who
+	// set the service up without a tenant
+	GetResumeOwnerID(ctx context.Context) (uint, error)
+	GetProfile(ctx context.Context, ownerID uint) (*model.Profile, error)
+	ListExperiences(ctx context.Context, ownerID uint) ([]model.Experience, error)
+	ListEducation(ctx context.Context, ownerID uint) ([]model.Education, error)
+	ListProjects(ctx context.Context, ownerID uint) ([]model.Project, error)
+	ListSkillCategories(ctx context.Context, ownerID uint) ([]model.SkillCategory, error)
+	// ListSkills returns the skills of the owner along with their aliases
+	ListSkills(ctx context.Context, ownerID uint) ([]model.Skill, error)
+	ListCertifications(ctx context.Context, ownerID uint) ([]model.Certification, error)
+	ListPublications(ctx context.Context, ownerID uint) ([]model.Publication, error)
+	ListLanguages(ctx context.Context, ownerID uint) ([]model.Language, error)
+	// ListPublicLinks returns the links of the owner shown on the public resume
+	ListPublicLinks(ctx context.Context, ownerID uint) ([]model.Link, error)
+	GetDefaultVariant(ctx context.Context, ownerID uint) (*model.Variant, error)
+	GetVariantBySlug(ctx context.Context, ownerID uint, slug string) (*model.Variant, error)
+}
+
+type Resume struct {
+	resumeRepo ResumeRepository
+	now        func() time.Time
+	appConfig  *config.Application
+}
+
+// NewUsecase creates the resume usecase, now tells the time current roles are
+// counted up to and credentials expire by
+func NewUsecase(resumeRepo ResumeRepository, now func() time.Time, appConfig *config.Application) *Resume {
+	return &Resume{
+		resumeRepo: resumeRepo,
+		now:        now,
+		appConfig:  appConfig,
+	}
+}
+
+// Get returns the public resume of the owner the request was addressed to,
+// tailored by their default variant when they have one
+func (r *Resume) Get(ctx context.Context) (*model.Resume, error) {
+	ownerID, err := r.resumeRepo.GetResumeOwnerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	variant, err := r.defaultVariant(ctx, ownerID)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.build(ctx, ownerID, variant)
+}
+
+// Variant returns the public resume tailored by the variant with slug
+func (r *Resume) Variant(ctx context.Context, slug string) (*model.Resume, error) {
+	ownerID, err := r.resumeRepo.GetResumeOwnerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	variant, err := r.resumeRepo.GetVariantBySlug(ctx, ownerID, strings.ToLower(slug))
+	if err != nil {
+		return nil, err
+	}
+
+	return r.build(ctx, ownerID, variant)
+}
+
+// build gathers the resume of the owner, variant picking the experiences,
+// projects and skills shown when it is not nil
+func (r *Resume) build(ctx context.Context, ownerID uint, variant *model.Variant) (*model.Resume, error) {
+	profile, err := r.resumeRepo.GetProfile(ctx, ownerID)
+	if err != nil {
+		if !errorx.Is(err, errorx.ErrNotFound) {
+			return nil, err
+		}
+		profile = &model.Profile{OwnerID: ownerID, Availability: model.AvailabilityNotLooking}
+	}
+	if !profile.PhonePublic {
+		profile.Phone = ""
+	}
+
+	experiences, err := r.resumeRepo.ListExperiences(ctx, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	experienceusecase.Sort(experiences)
+
+	education, err := r.resumeRepo.ListEducation(ctx, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	educationusecase.Sort(education)
+
+	projects, err := r.resumeRepo.ListProjects(ctx, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	projectusecase.Sort(projects)
+
+	categories, err := r.resumeRepo.ListSkillCategories(ctx, ownerID)
+	if err != nil {
+		return nil, err
+	}
+
+	skills, err := r.resumeRepo.ListSkills(ctx, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	// Skills count every role and project, including those the variant leaves
+	// out
+	skillusecase.DeriveExperience(skills, experiences, projects, r.now())
+
+	certifications, err := r.resumeRepo.ListCertifications(ctx, ownerID)
+	if err != nil {
+		return nil, err
+	}
+
+	publications, err := r.resumeRepo.ListPublications(ctx, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	publicationusecase.Sort(publications)
+
+	languages, err := r.resumeRepo.ListLanguages(ctx, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	languageusecase.Sort(languages)
+
+	links, err := r.resumeRepo.ListPublicLinks(ctx, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	linkusecase.Sort(links)
+
+	resume := &model.Resume{
+		Profile:         *profile,
+		Experiences:     experiences,
+		Education:       education,
+		Projects:        projects,
+		SkillCategories: categories,
+		Skills:          skills,
+		Certifications:  r.publicCertifications(certifications),
+		Publications:    publications,
+		Languages:       languages,
+		Links:           links,
+	}
+	if variant != nil {
+		resume.Variant = variant.Slug
+		if variant.Headline != "" {
+			resume.Profile.Headline = variant.Headline
+		}
+		if variant.Summary != "" {
+			resume.Profile.Summary = variant.Summary
+		}
+		resume.Experiences = pick(experiences, variant.ExperienceIDs, func(experience model.Experience) uint { return experience.ID })
+		resume.Projects = pick(projects, variant.ProjectIDs, func(project model.Project) uint { return project.ID })
+		resume.Skills = pick(skills, variant.SkillIDs, func(skill model.Skill) uint { return skill.ID })
+	}
+
+	return resume, nil
+}
+
+// Projects returns the projects on the public resume. With a tag, only the
+// projects tagged with it are returned, or with another name of the same
+// skill.
+func (r *Resume) Projects(ctx context.Context, tag string) ([]model.Project, error) {
+	ownerID, err := r.resumeRepo.GetResumeOwnerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	variant, err := r.defaultVariant(ctx, ownerID)
+	if err != nil {
+		return nil, err
+	}
+
+	projects, err := r.resumeRepo.ListProjects(ctx, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	projectusecase.Sort(projects)
+	if variant != nil {
+		projects = pick(projects, variant.ProjectIDs, func(project model.Project) uint { return project.ID })
+	}
+
+	if tag = strings.TrimSpace(tag); tag == "" {
+		return projects, nil
+	}
+
+	skills, err := r.resumeRepo.ListSkills(ctx, ownerID)
+	if err != nil {
+		return nil, err
+	}
+
+	resolve := skillusecase.NewResolver(skills)
+	skill := resolve([]string{tag})
+	key := identifier.Normalize(tag)
+
+	filtered := []model.Project{}
+	for _, project := range projects {
+		tagged := slices.ContainsFunc(project.Tags, func(projectTag string) bool {
+			return identifier.Normalize(projectTag) == key
+		})
+		if tagged || (len(skill) > 0 && slices.Contains(resolve(project.Tags), skill[0])) {
+			filtered = append(filtered, project)
+		}
+	}
+
+	return filtered, nil
+}
+
+// defaultVariant returns the default variant of the owner, or nil when every
+// entry is shown
+func (r *Resume) defaultVariant(ctx context.Context, ownerID uint) (*model.Variant, error) {
+	variant, err := r.resumeRepo.GetDefaultVariant(ctx, ownerID)
+	if err != nil {
+		if errorx.Is(err, errorx.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return variant, nil
+}
+
+// pick returns the entries with ids in the order of ids, leaving out the ids
+// of entries deleted since the variant was saved
+func pick[T any](entries []T, ids []uint, id func(T) uint) []T {
+	picked := make([]T, 0, len(ids))
+	for _, wanted := range ids {
+		if i := slices.IndexFunc(entries, func(entry T) bool { return id(entry) == wanted }); i >= 0 {
+			picked = append(picked, entries[i])
+		}
+	}
+
+	return picked
+}
+
+// publicCertifications marks the expired certifications, or leaves them out
+// when they are to be hidden
+func (r *Resume) publicCertifications(certifications []model.Certification) []model.Certification {
+	certificationusecase.Sort(certifications)
+
+	today := model.DateOf(r.now())
+	public := make([]model.Certification, 0, len(certifications))
+	for _, certification := range certifications {
+		certification.Expired = certificationusecase.IsExpired(certification, today)
+		if certification.Expired && r.appConfig.Usecase.Certification.Expired == certificationusecase.ExpiredHide {
+			continue
+		}
+
+		public = append(public, certification)
+	}
+
+	return public
+}
@@ -0,0 +1,299 @@
+package usecase_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	certificationusecase "devoratio.dev/web-resume/certification/usecase"
+	"devoratio.dev/web-resume/config"
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/model"
+	"devoratio.dev/web-resume/resume/usecase"
+	"devoratio.dev/web-resume/resume/usecase/repositorymock"
+)
+
+var _ = Describe("Resume", Label("resume"), func() {
+	var (
+		mockController *gomock.Controller
+
+		resumeRepoMock *repositorymock.MockResumeRepository
+
+		resumeUsecase *usecase.Resume
+		appConfig     *config.Application
+		now           time.Time
+		commonCtx     context.Context
+		ownerID       uint
+	)
+
+	BeforeEach(func() {
+		mockController = gomock.NewController(GinkgoT())
+
+		resumeRepoMock = repositorymock.NewMockResumeRepository(mockController)
+
+		now = time.Date(2024, time.June, 15, 0, 0, 0, 0, time.UTC)
+		appConfig = &config.Application{
+			Usecase: config.Usecase{
+				Certification: config.Certification{Expired: certificationusecase.ExpiredLabel},
+			},
+		}
+		resumeUsecase = usecase.NewUsecase(resumeRepoMock, func() time.Time { return now }, appConfig)
+
+		ownerID = 1
+		commonCtx = context.Background()
+	})
+
+	AfterEach(func() {
+		mockController.Finish()
+	})
+
+	Describe("Get the resume", func() {
+		When("the service is not set up yet", func() {
+			It("tells the visitor there is no resume", func(ctx SpecContext) {
+				resumeRepoMock.EXPECT().GetResumeOwnerID(commonCtx).Return(uint(0), errorx.ErrNotFound).Times(1)
+
+				result, err := resumeUsecase.Get(commonCtx)
+				Expect(err).Should(Equal(errorx.ErrNotFound))
+				Expect(result).Should(BeNil())
+			}, SpecTimeout(time.Second*2))
+		})
+
+		When("the owner filled in their resume", func() {
+			It("counts the current role up to now in the years of each skill", func(ctx SpecContext) {
+				resumeRepoMock.EXPECT().GetResumeOwnerID(commonCtx).Return(ownerID, nil).Times(1)
+				resumeRepoMock.EXPECT().GetDefaultVariant(commonCtx, ownerID).Return(nil, errorx.ErrNotFound).Times(1)
+				resumeRepoMock.EXPECT().GetProfile(commonCtx, ownerID).Return(nil, errorx.ErrNotFound).Times(1)
+				resumeRepoMock.EXPECT().ListExperiences(commonCtx, ownerID).Return([]model.Experience{
+					{ID: 1, Start: model.NewMonth(2023, time.July), Current: true, Tags: []string{"golang"}},
+				}, nil).Times(1)
+				resumeRepoMock.EXPECT().ListEducation(commonCtx, ownerID).Return(nil, nil).Times(1)
+				resumeRepoMock.EXPECT().ListProjects(commonCtx, ownerID).Return(nil, nil).Times(1)
+				resumeRepoMock.EXPECT().ListSkillCategories(commonCtx, ownerID).Return(nil, nil).Times(1)
+				resumeRepoMock.EXPECT().ListSkills(commonCtx, ownerID).Return([]model.Skill{
+					{ID: 1, Name: "Go", Aliases: []string{"golang"}},
+				}, nil).Times(1)
+				resumeRepoMock.EXPECT().ListCertifications(commonCtx, ownerID).Return(nil, nil).Times(1)
+				resumeRepoMock.EXPECT().ListPublications(commonCtx, ownerID).Return(nil, nil).Times(1)
+				resumeRepoMock.EXPECT().ListLanguages(commonCtx, ownerID).Return([]model.Language{
+					{ID: 1, Name: "German", Level: model.LanguageB1},
+					{ID: 2, Name: "French", Level: model.LanguageNative},
+				}, nil).Times(1)
+				resumeRepoMock.EXPECT().ListPublicLinks(commonCtx, ownerID).Return([]model.Link{
+					{ID: 1, Type: model.LinkGitHub, Value: "https://github.com/devoratio", Public: true},
+					{ID: 2, Type: model.LinkEmail, Value: "hello@devoratio.dev", Public: true},
+				}, nil).Times(1)
+
+				result, err := resumeUsecase.Get(commonCtx)
+				Expect(err).Should(BeNil())
+				Expect(result.Profile.Availability).Should(Equal(model.AvailabilityNotLooking))
+				Expect(result.Languages[0].Name).Should(Equal("French"))
+				Expect([]uint{result.Links[0].ID, result.Links[1].ID}).Should(Equal([]uint{2, 1}))
+				Expect(result.Skills[0].ExperienceMonths).Should(Equal(12))
+				Expect(result.Skills[0].ExperienceYears).Should(Equal(1.0))
+			}, SpecTimeout(time.Second*2))
+		})
+	})
+
+	Describe("Show the certifications", func() {
+		BeforeEach(func() {
+			expired, valid := model.NewDate(2024, time.June, 14), model.NewDate(2025, time.June, 14)
+			resumeRepoMock.EXPECT().GetResumeOwnerID(commonCtx).Return(ownerID, nil).Times(1)
+			resumeRepoMock.EXPECT().GetDefaultVariant(commonCtx, ownerID).Return(nil, errorx.ErrNotFound).Times(1)
+			resumeRepoMock.EXPECT().GetProfile(commonCtx, ownerID).Return(&model.Profile{OwnerID: ownerID}, nil).Times(1)
+			resumeRepoMock.EXPECT().ListExperiences(commonCtx, ownerID).Return(nil, nil).Times(1)
+			resumeRepoMock.EXPECT().ListEducation(commonCtx, ownerID).Return(nil, nil).Times(1)
+			resumeRepoMock.EXPECT().ListProjects(commonCtx, ownerID).Return(nil, nil).Times(1)
+			resumeRepoMock.EXPECT().ListSkillCategories(commonCtx, ownerID).Return(nil, nil).Times(1)
+			resumeRepoMock.EXPECT().ListSkills(commonCtx, ownerID).Return(nil, nil).Times(1)
+			resumeRepoMock.EXPECT().ListCertifications(commonCtx, ownerID).Return([]model.Certification{
+				{ID: 1, IssuedOn: model.NewDate(2021, time.June, 14), ExpiresOn: &expired},
+				{ID: 2, IssuedOn: model.NewDate(2022, time.June, 14), ExpiresOn: &valid},
+				{ID: 3, IssuedOn: model.NewDate(2020, time.March, 1)},
+			}, nil).Times(1)
+			resumeRepoMock.EXPECT().ListPublications(commonCtx, ownerID).Return(nil, nil).Times(1)
+			resumeRepoMock.EXPECT().ListLanguages(commonCtx, ownerID).Return(nil, nil).Times(1)
+			resumeRepoMock.EXPECT().ListPublicLinks(commonCtx, ownerID).Return(nil, nil).Times(1)
+		})
+
+		When("expired certifications are labelled", func() {
+			It("marks the expired ones", func(ctx SpecContext) {
+				result, err := resumeUsecase.Get(commonCtx)
+				Expect(err).Should(BeNil())
+				Expect(result.Certifications).Should(HaveLen(3))
+				Expect(result.Certifications[0].ID).Should(Equal(uint(2)))
+				Expect(result.Certifications[0].Expired).Should(BeFalse())
+				Expect(result.Certifications[1].ID).Should(Equal(uint(1)))
+				Expect(result.Certifications[1].Expired).Should(BeTrue())
+				Expect(result.Certifications[2].Expired).Should(BeFalse())
+			}, SpecTimeout(time.Second*2))
+		})
+
+		When("expired certifications are hidden", func() {
+			It("leaves the expired ones out", func(ctx SpecContext) {
+				appConfig.Usecase.Certification.Expired = certificationusecase.ExpiredHide
+
+				result, err := resumeUsecase.Get(commonCtx)
+				Expect(err).Should(BeNil())
+				Expect(result.Certifications).Should(HaveLen(2))
+				Expect([]uint{result.Certifications[0].ID, result.Certifications[1].ID}).Should(Equal([]uint{2, 3}))
+			}, SpecTimeout(time.Second*2))
+		})
+	})
+
+	Describe("Show the phone number", func() {
+		var profile *model.Profile
+
+		BeforeEach(func() {
+			profile = &model.Profile{OwnerID: ownerID, Phone: "+62 812 3456 7890"}
+
+			resumeRepoMock.EXPECT().GetResumeOwnerID(commonCtx).Return(ownerID, nil).Times(1)
+			resumeRepoMock.EXPECT().GetDefaultVariant(commonCtx, ownerID).Return(nil, errorx.ErrNotFound).Times(1)
+			resumeRepoMock.EXPECT().GetProfile(commonCtx, ownerID).DoAndReturn(
+				func(_ context.Context, _ uint) (*model.Profile, error) {
+					return profile, nil
+				}).Times(1)
+			resumeRepoMock.EXPECT().ListExperiences(commonCtx, ownerID).Return(nil, nil).Times(1)
+			resumeRepoMock.EXPECT().ListEducation(commonCtx, ownerID).Return(nil, nil).Times(1)
+			resumeRepoMock.EXPECT().ListProjects(commonCtx, ownerID).Return(nil, nil).Times(1)
+			resumeRepoMock.EXPECT().ListSkillCategories(commonCtx, ownerID).Return(nil, nil).Times(1)
+			resumeRepoMock.EXPECT().ListSkills(commonCtx, ownerID).Return(nil, nil).Times(1)
+			resumeRepoMock.EXPECT().ListCertifications(commonCtx, ownerID).Return(nil, nil).Times(1)
+			resumeRepoMock.EXPECT().ListPublications(commonCtx, ownerID).Return(nil, nil).Times(1)
+			resumeRepoMock.EXPECT().ListLanguages(commonCtx, ownerID).Return(nil, nil).Times(1)
+			resumeRepoMock.EXPECT().ListPublicLinks(commonCtx, ownerID).Return(nil, nil).Times(1)
+		})
+
+		When("the owner keeps it private", func() {
+			It("leaves it out", func(ctx SpecContext) {
+				result, err := resumeUsecase.Get(commonCtx)
+				Expect(err).Should(BeNil())
+				Expect(result.Profile.Phone).Should(BeEmpty())
+			}, SpecTimeout(time.Second*2))
+		})
+
+		When("the owner publishes it", func() {
+			It("shows it", func(ctx SpecContext) {
+				profile.PhonePublic = true
+
+				result, err := resumeUsecase.Get(commonCtx)
+				Expect(err).Should(BeNil())
+				Expect(result.Profile.Phone).Should(Equal("+62 812 3456 7890"))
+			}, SpecTimeout(time.Second*2))
+		})
+	})
+
+	Describe("Tailor the resume with a variant", func() {
+		BeforeEach(func() {
+			resumeRepoMock.EXPECT().GetResumeOwnerID(commonCtx).Return(ownerID, nil).Times(1)
+		})
+
+		When("the variant doesn't exist", func() {
+			It("tells the visitor there is no such resume", func(ctx SpecContext) {
+				resumeRepoMock.EXPECT().GetVariantBySlug(commonCtx, ownerID, "sre").Return(nil, errorx.ErrNotFound).Times(1)
+
+				result, err := resumeUsecase.Variant(commonCtx, "SRE")
+				Expect(err).Should(Equal(errorx.ErrNotFound))
+				Expect(result).Should(BeNil())
+			}, SpecTimeout(time.Second*2))
+		})
+
+		When("the variant exists", func() {
+			It("shows the entries it picks in its order and its headline", func(ctx SpecContext) {
+				resumeRepoMock.EXPECT().GetVariantBySlug(commonCtx, ownerID, "backend").Return(&model.Variant{
+					Slug:          "backend",
+					Headline:      "Backend engineer",
+					ExperienceIDs: []uint{1, 2},
+					ProjectIDs:    []uint{3},
+					SkillIDs:      []uint{2, 1},
+				}, nil).Times(1)
+				resumeRepoMock.EXPECT().GetProfile(commonCtx, ownerID).Return(&model.Profile{
+					OwnerID:  ownerID,
+					Headline: "Engineering manager",
+					Summary:  "Building teams and systems",
+				}, nil).Times(1)
+				resumeRepoMock.EXPECT().ListExperiences(commonCtx, ownerID).Return([]model.Experience{
+					{ID: 1, Start: model.NewMonth(2020, time.January), End: ptr(model.NewMonth(2021, time.December)), Tags: []string{"golang"}},
+					{ID: 3, Start: model.NewMonth(2022, time.January), Current: true, Tags: []string{"golang"}},
+				}, nil).Times(1)
+				resumeRepoMock.EXPECT().ListEducation(commonCtx, ownerID).Return(nil, nil).Times(1)
+				resumeRepoMock.EXPECT().ListProjects(commonCtx, ownerID).Return([]model.Project{
+					{ID: 3, Start: model.NewMonth(2023, time.January)},
+					{ID: 4, Start: model.NewMonth(2023, time.March)},
+				}, nil).Times(1)
+				resumeRepoMock.EXPECT().ListSkillCategories(commonCtx, ownerID).Return(nil, nil).Times(1)
+				resumeRepoMock.EXPECT().ListSkills(commonCtx, ownerID).Return([]model.Skill{
+					{ID: 1, Name: "Go", Aliases: []string{"golang"}},
+					{ID: 2, Name: "PostgreSQL"},
+					{ID: 3, Name: "Hiring"},
+				}, nil).Times(1)
+				resumeRepoMock.EXPECT().ListCertifications(commonCtx, ownerID).Return(nil, nil).Times(1)
+				resumeRepoMock.EXPECT().ListPublications(commonCtx, ownerID).Return(nil, nil).Times(1)
+				resumeRepoMock.EXPECT().ListLanguages(commonCtx, ownerID).Return(nil, nil).Times(1)
+				resumeRepoMock.EXPECT().ListPublicLinks(commonCtx, ownerID).Return(nil, nil).Times(1)
+
+				result, err := resumeUsecase.Variant(commonCtx, "backend")
+				Expect(err).Should(BeNil())
+				Expect(result.Variant).Should(Equal("backend"))
+				Expect(result.Profile.Headline).Should(Equal("Backend engineer"))
+				Expect(result.Profile.Summary).Should(Equal("Building teams and systems"))
+				Expect(result.Experiences).Should(HaveLen(1))
+				Expect(result.Experiences[0].ID).Should(Equal(uint(1)))
+				Expect(result.Projects).Should(HaveLen(1))
+				Expect([]uint{result.Skills[0].ID, result.Skills[1].ID}).Should(Equal([]uint{2, 1}))
+				// The current role is left out but still counts towards Go
+				Expect(result.Skills[1].ExperienceMonths).Should(Equal(54))
+			}, SpecTimeout(time.Second*2))
+		})
+	})
+
+	Describe("List the projects", func() {
+		BeforeEach(func() {
+			resumeRepoMock.EXPECT().GetResumeOwnerID(commonCtx).Return(ownerID, nil).Times(1)
+			resumeRepoMock.EXPECT().GetDefaultVariant(commonCtx, ownerID).Return(nil, errorx.ErrNotFound).Times(1)
+			resumeRepoMock.EXPECT().ListProjects(commonCtx, ownerID).Return([]model.Project{
+				{ID: 1, Start: model.NewMonth(2022, time.January), Tags: []string{"golang"}},
+				{ID: 2, Start: model.NewMonth(2023, time.January), Tags: []string{"Rust"}},
+				{ID: 3, Start: model.NewMonth(2021, time.January), Tags: []string{"Terraform"}},
+			}, nil).Times(1)
+		})
+
+		When("no tag is given", func() {
+			It("lists every project", func(ctx SpecContext) {
+				result, err := resumeUsecase.Projects(commonCtx, "")
+				Expect(err).Should(BeNil())
+				Expect(result).Should(HaveLen(3))
+			}, SpecTimeout(time.Second*2))
+		})
+
+		When("the tag is another name of a skill", func() {
+			It("lists the projects tagged with any name of the skill", func(ctx SpecContext) {
+				resumeRepoMock.EXPECT().ListSkills(commonCtx, ownerID).Return([]model.Skill{
+					{ID: 1, Name: "Go", Aliases: []string{"golang"}},
+				}, nil).Times(1)
+
+				result, err := resumeUsecase.Projects(commonCtx, "Go")
+				Expect(err).Should(BeNil())
+				Expect(result).Should(HaveLen(1))
+				Expect(result[0].ID).Should(Equal(uint(1)))
+			}, SpecTimeout(time.Second*2))
+		})
+
+		When("the tag is not a skill", func() {
+			It("lists the projects tagged with it regardless of case", func(ctx SpecContext) {
+				resumeRepoMock.EXPECT().ListSkills(commonCtx, ownerID).Return(nil, nil).Times(1)
+
+				result, err := resumeUsecase.Projects(commonCtx, "terraform")
+				Expect(err).Should(BeNil())
+				Expect(result).Should(HaveLen(1))
+				Expect(result[0].ID).Should(Equal(uint(3)))
+			}, SpecTimeout(time.Second*2))
+		})
+	})
+})
+
+func ptr[T any](value T) *T {
+	return &value
+}
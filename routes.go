@@ -0,0 +1,178 @@
+package main
+
+import (
+	"net/http"
+	"time"
+
+	audithandler "devoratio.dev/web-resume/audit/handler"
+	auditrepository "devoratio.dev/web-resume/audit/repository"
+	auditusecase "devoratio.dev/web-resume/audit/usecase"
+	authenticationrepository "devoratio.dev/web-resume/authentication/repository"
+	authenticationusecase "devoratio.dev/web-resume/authentication/usecase"
+	certificationhandler "devoratio.dev/web-resume/certification/handler"
+	certificationrepository "devoratio.dev/web-resume/certification/repository"
+	certificationusecase "devoratio.dev/web-resume/certification/usecase"
+	"devoratio.dev/web-resume/config"
+	educationhandler "devoratio.dev/web-resume/education/handler"
+	educationrepository "devoratio.dev/web-resume/education/repository"
+	educationusecase "devoratio.dev/web-resume/education/usecase"
+	emailverificationhandler "devoratio.dev/web-resume/emailverification/handler"
+	emailverificationrepository "devoratio.dev/web-resume/emailverification/repository"
+	emailverificationusecase "devoratio.dev/web-resume/emailverification/usecase"
+	experiencehandler "devoratio.dev/web-resume/experience/handler"
+	experiencerepository "devoratio.dev/web-resume/experience/repository"
+	experienceusecase "devoratio.dev/web-resume/experience/usecase"
+	identityhandler "devoratio.dev/web-resume/identity/handler"
+	identityrepository "devoratio.dev/web-resume/identity/repository"
+	identityusecase "devoratio.dev/web-resume/identity/usecase"
+	"devoratio.dev/web-resume/internal/geoip"
+	"devoratio.dev/web-resume/internal/httpx"
+	"devoratio.dev/web-resume/internal/ipfilter"
+	"devoratio.dev/web-resume/internal/mailer"
+	"devoratio.dev/web-resume/internal/oauth"
+	"devoratio.dev/web-resume/internal/ratelimit"
+	"devoratio.dev/web-resume/internal/webhook"
+	languagehandler "devoratio.dev/web-resume/language/handler"
+	languagerepository "devoratio.dev/web-resume/language/repository"
+	languageusecase "devoratio.dev/web-resume/language/usecase"
+	linkhandler "devoratio.dev/web-resume/link/handler"
+	linkrepository "devoratio.dev/web-resume/link/repository"
+	linkusecase "devoratio.dev/web-resume/link/usecase"
+	loginhandler "devoratio.dev/web-resume/login/handler"
+	loginrepository "devoratio.dev/web-resume/login/repository"
+	loginusecase "devoratio.dev/web-resume/login/usecase"
+	loginalerthandler "devoratio.dev/web-resume/loginalert/handler"
+	loginalertrepository "devoratio.dev/web-resume/loginalert/repository"
+	loginalertusecase "devoratio.dev/web-resume/loginalert/usecase"
+	"devoratio.dev/web-resume/model"
+	ownerhandler "devoratio.dev/web-resume/owner/handler"
+	ownerrepository "devoratio.dev/web-resume/owner/repository"
+	ownerusecase "devoratio.dev/web-resume/owner/usecase"
+	passwordresethandler "devoratio.dev/web-resume/passwordreset/handler"
+	passwordresetrepository "devoratio.dev/web-resume/passwordreset/repository"
+	passwordresetusecase "devoratio.dev/web-resume/passwordreset/usecase"
+	personaltokenhandler "devoratio.dev/web-resume/personaltoken/handler"
+	personaltokenrepository "devoratio.dev/web-resume/personaltoken/repository"
+	personaltokenusecase "devoratio.dev/web-resume/personaltoken/usecase"
+	profilehandler "devoratio.dev/web-resume/profile/handler"
+	profilerepository "devoratio.dev/web-resume/profile/repository"
+	profileusecase "devoratio.dev/web-resume/profile/usecase"
+	projecthandler "devoratio.dev/web-resume/project/handler"
+	projectrepository "devoratio.dev/web-resume/project/repository"
+	projectusecase "devoratio.dev/web-resume/project/usecase"
+	publicationhandler "devoratio.dev/web-resume/publication/handler"
+	publicationrepository "devoratio.dev/web-resume/publication/repository"
+	publicationusecase "devoratio.dev/web-resume/publication/usecase"
+	resumehandler "devoratio.dev/web-resume/resume/handler"
+	resumerepository "devoratio.dev/web-resume/resume/repository"
+	resumeusecase "devoratio.dev/web-resume/resume/usecase"
+	sessionhandler "devoratio.dev/web-resume/session/handler"
+	sessionrepository "devoratio.dev/web-resume/session/repository"
+	sessionusecase "devoratio.dev/web-resume/session/usecase"
+	setuphandler "devoratio.dev/web-resume/setup/handler"
+	setuprepository "devoratio.dev/web-resume/setup/repository"
+	setupusecase "devoratio.dev/web-resume/setup/usecase"
+	skillhandler "devoratio.dev/web-resume/skill/handler"
+	skillrepository "devoratio.dev/web-resume/skill/repository"
+	skillusecase "devoratio.dev/web-resume/skill/usecase"
+	tenanthandler "devoratio.dev/web-resume/tenant/handler"
+	tenantrepository "devoratio.dev/web-resume/tenant/repository"
+	tenantusecase "devoratio.dev/web-resume/tenant/usecase"
+	varianthandler "devoratio.dev/web-resume/variant/handler"
+	variantrepository "devoratio.dev/web-resume/variant/repository"
+	variantusecase "devoratio.dev/web-resume/variant/usecase"
+	"gorm.io/gorm"
+)
+
+func newHandler(appConfig *config.Application, db *gorm.DB, mail mailer.Mailer, geoIP *geoip.Database, identityProviders *oauth.Registry, tokenTransport *httpx.TokenTransport, accessControl *ipfilter.AccessControl, setupToken string) http.Handler {
+	auditRepo := auditrepository.NewPostgreSQL(db)
+	authenticationRepo := authenticationrepository.NewPostgreSQL(db, appConfig.Authentication)
+	certificationRepo := certificationrepository.NewPostgreSQL(db)
+	educationRepo := educationrepository.NewPostgreSQL(db)
+	emailVerificationRepo := emailverificationrepository.NewPostgreSQL(db)
+	experienceRepo := experiencerepository.NewPostgreSQL(db)
+	identityRepo := identityrepository.NewPostgreSQL(db)
+	languageRepo := languagerepository.NewPostgreSQL(db)
+	linkRepo := linkrepository.NewPostgreSQL(db)
+	loginAlertRepo := loginalertrepository.NewPostgreSQL(db)
+	loginRepo := loginrepository.NewPostgreSQL(db, appConfig.Authentication)
+	ownerRepo := ownerrepository.NewPostgreSQL(db)
+	passwordResetRepo := passwordresetrepository.NewPostgreSQL(db, appConfig.Authentication)
+	personalTokenRepo := personaltokenrepository.NewPostgreSQL(db)
+	profileRepo := profilerepository.NewPostgreSQL(db)
+	projectRepo := projectrepository.NewPostgreSQL(db)
+	publicationRepo := publicationrepository.NewPostgreSQL(db)
+	resumeRepo := resumerepository.NewPostgreSQL(db)
+	sessionRepo := sessionrepository.NewPostgreSQL(db)
+	setupRepo := setuprepository.NewPostgreSQL(db)
+	skillRepo := skillrepository.NewPostgreSQL(db)
+	tenantRepo := tenantrepository.NewPostgreSQL(db)
+	variantRepo := variantrepository.NewPostgreSQL(db)
+
+	auditUsecase := auditusecase.NewUsecase(auditRepo)
+	authenticationUsecase := authenticationusecase.NewUsecase(authenticationRepo)
+	emailVerificationUsecase := emailverificationusecase.NewUsecase(authenticationUsecase, emailVerificationRepo, mail, appConfig)
+	loginRateLimit := appConfig.Usecase.Login.RateLimit
+	loginRateLimiter := ratelimit.New(loginRateLimit.Attempts, loginRateLimit.Window)
+	passwordResetUsecase := passwordresetusecase.NewUsecase(passwordResetRepo, mail, auditUsecase, loginRateLimiter, appConfig)
+	loginAlertWebhook := webhook.New(appConfig.Usecase.LoginAlert.Webhook)
+	loginAlertUsecase := loginalertusecase.NewUsecase(loginAlertRepo, passwordResetUsecase, mail, loginAlertWebhook, geoIP, appConfig)
+	sessionUsecase := sessionusecase.NewUsecase(sessionRepo, loginAlertUsecase, appConfig)
+
+	loginUsecase := loginusecase.NewUsecase(authenticationUsecase, loginRepo, sessionUsecase, auditUsecase, identityProviders, mail, loginRateLimiter, appConfig)
+	certificationUsecase := certificationusecase.NewUsecase(certificationRepo, mail, time.Now, appConfig)
+	educationUsecase := educationusecase.NewUsecase(educationRepo)
+	experienceUsecase := experienceusecase.NewUsecase(experienceRepo)
+	identityUsecase := identityusecase.NewUsecase(identityRepo, identityProviders, appConfig)
+	languageUsecase := languageusecase.NewUsecase(languageRepo)
+	linkUsecase := linkusecase.NewUsecase(linkRepo)
+	ownerUsecase := ownerusecase.NewUsecase(authenticationUsecase, ownerRepo)
+	personalTokenUsecase := personaltokenusecase.NewUsecase(personalTokenRepo)
+	profileUsecase := profileusecase.NewUsecase(profileRepo)
+	projectUsecase := projectusecase.NewUsecase(projectRepo)
+	publicationUsecase := publicationusecase.NewUsecase(publicationRepo)
+	resumeUsecase := resumeusecase.NewUsecase(resumeRepo, time.Now, appConfig)
+	setupUsecase := setupusecase.NewUsecase(setupRepo, emailVerificationUsecase, setupToken)
+	skillUsecase := skillusecase.NewUsecase(skillRepo, time.Now)
+	tenantUsecase := tenantusecase.NewUsecase(tenantRepo, emailVerificationUsecase, appConfig)
+	variantUsecase := variantusecase.NewUsecase(variantRepo)
+
+	restrictLogin := httpx.RestrictIP(accessControl.Login, auditUsecase)
+	restrictOwner := httpx.RestrictIP(accessControl.Owner, auditUsecase)
+	authenticate := httpx.Chain(restrictOwner, tokenTransport.RequireAuthentication(authenticationUsecase))
+	manageAccount := httpx.Chain(authenticate, httpx.RequireScope(model.ScopeAccountManage))
+	administer := httpx.Chain(manageAccount, httpx.RequireRole(model.RoleAdmin))
+	readResume := httpx.Chain(authenticate, httpx.RequireScope(model.ScopeResumeRead))
+	writeResume := httpx.Chain(authenticate, httpx.RequireScope(model.ScopeResumeWrite))
+
+	mux := http.NewServeMux()
+	audithandler.NewHTTP(auditUsecase).RegisterRoutes(mux, manageAccount)
+	certificationhandler.NewHTTP(certificationUsecase).RegisterRoutes(mux, readResume, writeResume)
+	educationhandler.NewHTTP(educationUsecase).RegisterRoutes(mux, readResume, writeResume)
+	emailverificationhandler.NewHTTP(emailVerificationUsecase).RegisterRoutes(mux, restrictLogin, manageAccount)
+	experiencehandler.NewHTTP(experienceUsecase).RegisterRoutes(mux, readResume, writeResume)
+	identityhandler.NewHTTP(identityUsecase).RegisterRoutes(mux, manageAccount)
+	languagehandler.NewHTTP(languageUsecase).RegisterRoutes(mux, readResume, writeResume)
+	linkhandler.NewHTTP(linkUsecase).RegisterRoutes(mux, readResume, writeResume)
+	loginhandler.NewHTTP(loginUsecase, tokenTransport).RegisterRoutes(mux, restrictLogin)
+	loginalerthandler.NewHTTP(loginAlertUsecase).RegisterRoutes(mux, restrictLogin)
+	ownerhandler.NewHTTP(ownerUsecase).RegisterRoutes(mux, manageAccount)
+	passwordresethandler.NewHTTP(passwordResetUsecase).RegisterRoutes(mux, restrictLogin)
+	personaltokenhandler.NewHTTP(personalTokenUsecase).RegisterRoutes(mux, manageAccount)
+	profilehandler.NewHTTP(profileUsecase).RegisterRoutes(mux, readResume, writeResume)
+	projecthandler.NewHTTP(projectUsecase).RegisterRoutes(mux, readResume, writeResume)
+	publicationhandler.NewHTTP(publicationUsecase).RegisterRoutes(mux, readResume, writeResume)
+	resumehandler.NewHTTP(resumeUsecase).RegisterRoutes(mux)
+	sessionhandler.NewHTTP(sessionUsecase, tokenTransport).RegisterRoutes(mux, manageAccount)
+	setuphandler.NewHTTP(setupUsecase).RegisterRoutes(mux, restrictLogin)
+	skillhandler.NewHTTP(skillUsecase).RegisterRoutes(mux, readResume, writeResume)
+	tenanthandler.NewHTTP(tenantUsecase).RegisterRoutes(mux, administer)
+	varianthandler.NewHTTP(variantUsecase).RegisterRoutes(mux, readResume, writeResume)
+
+	var handler http.Handler = mux
+	if appConfig.Tenancy.Enabled {
+		handler = httpx.ResolveTenant(tenantUsecase)(handler)
+	}
+
+	return httpx.WithRequestInfo(accessControl.TrustedProxies)(handler)
+}
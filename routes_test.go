@@ -0,0 +1,71 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"devoratio.dev/web-resume/config"
+	"devoratio.dev/web-resume/internal/httpx"
+	"devoratio.dev/web-resume/internal/ipfilter"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+func TestNewHandlerRestrictsLogin(t *testing.T) {
+	// Nothing reaches the database, the denied attempts are only recorded
+	db, err := gorm.Open(postgres.New(postgres.Config{DSN: "postgres://resume@localhost/resume"}), &gorm.Config{
+		DryRun:               true,
+		DisableAutomaticPing: true,
+	})
+	if err != nil {
+		t.Fatalf("gorm.Open() error = %v", err)
+	}
+	accessControl, err := ipfilter.NewAccessControl(config.AccessControl{
+		Login: config.IPFilter{Allow: []string{"198.51.100.0/24"}},
+	})
+	if err != nil {
+		t.Fatalf("ipfilter.NewAccessControl() error = %v", err)
+	}
+	tokenTransport, err := httpx.NewTokenTransport(config.Authentication{
+		SigningKey: []byte("secret"),
+		Modes:      []string{httpx.ModeBearer},
+		Cookie:     config.Cookie{SameSite: "strict"},
+	})
+	if err != nil {
+		t.Fatalf("httpx.NewTokenTransport() error = %v", err)
+	}
+	handler := newHandler(&config.Application{}, db, nil, nil, nil, tokenTransport, accessControl, "")
+
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		wantStatus int
+	}{
+		{name: "password login", method: http.MethodPost, path: "/v1/login", wantStatus: http.StatusForbidden},
+		{name: "magic link request", method: http.MethodPost, path: "/v1/login/magic-link", wantStatus: http.StatusForbidden},
+		{name: "magic link sign-in", method: http.MethodPost, path: "/v1/login/magic-link/verify", wantStatus: http.StatusForbidden},
+		{name: "provider login", method: http.MethodPost, path: "/v1/login/oauth/github", wantStatus: http.StatusForbidden},
+		{name: "provider callback", method: http.MethodPost, path: "/v1/login/oauth/github/callback", wantStatus: http.StatusForbidden},
+		{name: "sign-in report", method: http.MethodPost, path: "/v1/login/report", wantStatus: http.StatusForbidden},
+		{name: "password reset request", method: http.MethodPost, path: "/v1/password/forgot", wantStatus: http.StatusForbidden},
+		{name: "password reset", method: http.MethodPost, path: "/v1/password/reset", wantStatus: http.StatusForbidden},
+		{name: "setup status", method: http.MethodGet, path: "/v1/setup", wantStatus: http.StatusForbidden},
+		{name: "setup", method: http.MethodPost, path: "/v1/setup", wantStatus: http.StatusForbidden},
+		{name: "email confirmation", method: http.MethodPost, path: "/v1/email/verify", wantStatus: http.StatusForbidden},
+		{name: "owner routes are left to the owner filter", method: http.MethodPost, path: "/v1/owner/email/verification", wantStatus: http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := httptest.NewRequest(tt.method, tt.path, nil)
+			request.RemoteAddr = "203.0.113.7:52110"
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, request)
+
+			if recorder.Code != tt.wantStatus {
+				t.Errorf("%s %s status = %v, want %v", tt.method, tt.path, recorder.Code, tt.wantStatus)
+			}
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+	"os/signal"
+	"syscall"
+	"time"
+
+	certificationrepository "devoratio.dev/web-resume/certification/repository"
+	certificationusecase "devoratio.dev/web-resume/certification/usecase"
+	"devoratio.dev/web-resume/config"
+	"devoratio.dev/web-resume/internal/generator"
+	"devoratio.dev/web-resume/internal/geoip"
+	"devoratio.dev/web-resume/internal/httpx"
+	"devoratio.dev/web-resume/internal/initializer/database"
+	"devoratio.dev/web-resume/internal/initializer/server"
+	"devoratio.dev/web-resume/internal/ipfilter"
+	"devoratio.dev/web-resume/internal/mailer"
+	"devoratio.dev/web-resume/internal/oauth"
+	"devoratio.dev/web-resume/internal/schedule"
+	setuprepository "devoratio.dev/web-resume/setup/repository"
+)
+
+func serve(ctx context.Context, appConfig *config.Application) error {
+	db := database.PostgreSQL(appConfig.Service.PostgreSQL)
+
+	setupToken, err := issueSetupToken(ctx, setuprepository.NewPostgreSQL(db))
+	if err != nil {
+		return err
+	}
+
+	mail, err := mailer.New(appConfig.Service.Mailer)
+	if err != nil {
+		return err
+	}
+
+	geoIP, err := geoip.Open(appConfig.Usecase.LoginAlert.GeoIPDatabase)
+	if err != nil {
+		return err
+	}
+
+	identityProviders, err := oauth.NewRegistry(appConfig.Authentication.OAuth)
+	if err != nil {
+		return err
+	}
+
+	tokenTransport, err := httpx.NewTokenTransport(appConfig.Authentication)
+	if err != nil {
+		return err
+	}
+
+	accessControl, err := ipfilter.NewAccessControl(appConfig.AccessControl)
+	if err != nil {
+		return err
+	}
+
+	srv := server.HTTP(appConfig.Server, newHandler(appConfig, db, mail, geoIP, identityProviders, tokenTransport, accessControl, setupToken))
+
+	ctx, stop := signal.NotifyContext(ctx, syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	certificationUsecase := certificationusecase.NewUsecase(certificationrepository.NewPostgreSQL(db), mail, time.Now, appConfig)
+	go schedule.Every(ctx, "certification expiry reminders", appConfig.Usecase.Certification.ReminderInterval, certificationUsecase.SendExpiryReminders)
+
+	go func() {
+		log.Printf("listening on %s", srv.Addr)
+		if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Fatalf("failed to serve: %s", err)
+		}
+	}()
+
+	<-ctx.Done()
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), appConfig.Server.ShutdownTimeout)
+	defer cancel()
+
+	return srv.Shutdown(shutdownCtx)
+}
+
+// issueSetupToken generates the token guarding the setup endpoint as long as
+// no owner has been created. Once setup is completed no token is issued and
+// the endpoint stays locked.
+func issueSetupToken(ctx context.Context, setupRepo *setuprepository.PostgreSQLDatabase) (string, error) {
+	completed, err := setupRepo.IsSetupCompleted(ctx)
+	if err != nil {
+		return "", err
+	}
+	if completed {
+		return "", nil
+	}
+
+	setupToken, err := generator.GenerateRandomToken()
+	if err != nil {
+		return "", err
+	}
+
+	log.Printf("setup has not been completed, create the first owner with setup token: %s", setupToken)
+	return setupToken, nil
+}
@@ -0,0 +1,106 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/internal/httpx"
+	"devoratio.dev/web-resume/model"
+)
+
+type SessionUsecase interface {
+	Refresh(ctx context.Context, refreshToken string) (*model.TokenPair, error)
+	List(ctx context.Context, claim model.Claim) ([]model.Session, error)
+	Revoke(ctx context.Context, claim model.Claim, sessionID uint) error
+	SignOut(ctx context.Context, claim model.Claim) error
+}
+
+type HTTP struct {
+	sessionUsecase SessionUsecase
+	transport      *httpx.TokenTransport
+}
+
+func NewHTTP(sessionUsecase SessionUsecase, transport *httpx.TokenTransport) *HTTP {
+	return &HTTP{
+		sessionUsecase: sessionUsecase,
+		transport:      transport,
+	}
+}
+
+func (h *HTTP) RegisterRoutes(mux *http.ServeMux, authenticate httpx.Middleware) {
+	mux.HandleFunc("POST /v1/token/refresh", h.refresh)
+	mux.Handle("POST /v1/logout", authenticate(http.HandlerFunc(h.signOut)))
+	mux.Handle("GET /v1/owner/sessions", authenticate(http.HandlerFunc(h.list)))
+	mux.Handle("DELETE /v1/owner/sessions/{id}", authenticate(http.HandlerFunc(h.revoke)))
+}
+
+func (h *HTTP) refresh(w http.ResponseWriter, r *http.Request) {
+	refreshToken, err := h.transport.RefreshToken(w, r)
+	if err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	tokenPair, err := h.sessionUsecase.Refresh(r.Context(), refreshToken)
+	if err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	h.transport.WriteTokenPair(w, r, tokenPair)
+}
+
+func (h *HTTP) signOut(w http.ResponseWriter, r *http.Request) {
+	claim, ok := httpx.ClaimFromContext(r.Context())
+	if !ok {
+		httpx.WriteError(w, errorx.ErrUnauthorized)
+		return
+	}
+
+	if err := h.sessionUsecase.SignOut(r.Context(), *claim); err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	h.transport.ClearTokens(w)
+	httpx.WriteNoContent(w)
+}
+
+func (h *HTTP) list(w http.ResponseWriter, r *http.Request) {
+	claim, ok := httpx.ClaimFromContext(r.Context())
+	if !ok {
+		httpx.WriteError(w, errorx.ErrUnauthorized)
+		return
+	}
+
+	sessions, err := h.sessionUsecase.List(r.Context(), *claim)
+	if err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, http.StatusOK, sessions)
+}
+
+func (h *HTTP) revoke(w http.ResponseWriter, r *http.Request) {
+	claim, ok := httpx.ClaimFromContext(r.Context())
+	if !ok {
+		httpx.WriteError(w, errorx.ErrUnauthorized)
+		return
+	}
+
+	sessionID, err := strconv.ParseUint(r.PathValue("id"), 10, 0)
+	if err != nil {
+		httpx.WriteError(w, errorx.ErrNotFound)
+		return
+	}
+
+	if err := h.sessionUsecase.Revoke(r.Context(), *claim, uint(sessionID)); err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	httpx.WriteNoContent(w)
+}
@@ -0,0 +1,161 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/internal/tenancy"
+	"devoratio.dev/web-resume/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type PostgreSQLDatabase struct {
+	db *gorm.DB
+}
+
+func NewPostgreSQL(db *gorm.DB) *PostgreSQLDatabase {
+	return &PostgreSQLDatabase{
+		db: db,
+	}
+}
+
+func (p *PostgreSQLDatabase) GetOwnerByID(ctx context.Context, ownerID uint) (*model.OwnerAccount, error) {
+	owner := &model.OwnerAccount{}
+	result := p.db.WithContext(ctx).Scopes(tenancy.Scope(ctx, "id")).First(owner, ownerID)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, errorx.ErrNotFound
+		}
+		return nil, errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+
+	return owner, nil
+}
+
+func (p *PostgreSQLDatabase) CreateSession(ctx context.Context, session *model.Session, refreshToken *model.RefreshToken) error {
+	err := p.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(session).Error; err != nil {
+			return err
+		}
+
+		return tx.Create(refreshToken).Error
+	})
+	if err != nil {
+		return errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), err)
+	}
+
+	return nil
+}
+
+func (p *PostgreSQLDatabase) ConsumeRefreshToken(ctx context.Context, tokenHash string, now time.Time) (*model.RefreshToken, error) {
+	token := &model.RefreshToken{}
+
+	// A single conditional update keeps concurrent refreshes with the same
+	// token from both succeeding
+	result := p.db.WithContext(ctx).Model(token).Clauses(clause.Returning{}).
+		Where("token_hash = ? AND used_at IS NULL", tokenHash).
+		Update("used_at", now)
+	if result.Error != nil {
+		return nil, errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil, errorx.ErrNotFound
+	}
+
+	return token, nil
+}
+
+func (p *PostgreSQLDatabase) GetRefreshToken(ctx context.Context, tokenHash string) (*model.RefreshToken, error) {
+	token := &model.RefreshToken{}
+	result := p.db.WithContext(ctx).Where("token_hash = ?", tokenHash).First(token)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, errorx.ErrNotFound
+		}
+		return nil, errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+
+	return token, nil
+}
+
+func (p *PostgreSQLDatabase) GetSessionByFamilyID(ctx context.Context, familyID string) (*model.Session, error) {
+	session := &model.Session{}
+	result := p.db.WithContext(ctx).Scopes(tenancy.Scope(ctx, "owner_id")).Where("family_id = ?", familyID).First(session)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, errorx.ErrNotFound
+		}
+		return nil, errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+
+	return session, nil
+}
+
+func (p *PostgreSQLDatabase) RenewSession(ctx context.Context, session *model.Session, refreshToken *model.RefreshToken) error {
+	err := p.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&model.Session{}).Where("id = ? AND revoked_at IS NULL", session.ID).Updates(map[string]interface{}{
+			"token_id":     session.TokenID,
+			"client_ip":    session.ClientIP,
+			"user_agent":   session.UserAgent,
+			"last_seen_at": session.LastSeenAt,
+			"expires_at":   session.ExpiresAt,
+		})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		return tx.Create(refreshToken).Error
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errorx.ErrUnauthorized
+		}
+		return errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), err)
+	}
+
+	return nil
+}
+
+func (p *PostgreSQLDatabase) RevokeFamily(ctx context.Context, familyID string, now time.Time) error {
+	result := p.db.WithContext(ctx).Model(&model.Session{}).
+		Where("family_id = ? AND revoked_at IS NULL", familyID).
+		Update("revoked_at", now)
+	if result.Error != nil {
+		return errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+
+	return nil
+}
+
+func (p *PostgreSQLDatabase) ListSessions(ctx context.Context, ownerID uint, now time.Time) ([]model.Session, error) {
+	var sessions []model.Session
+	result := p.db.WithContext(ctx).
+		Where("owner_id = ? AND revoked_at IS NULL AND expires_at > ?", ownerID, now).
+		Order("last_seen_at DESC").Order("id DESC").
+		Find(&sessions)
+	if result.Error != nil {
+		return nil, errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+
+	return sessions, nil
+}
+
+func (p *PostgreSQLDatabase) RevokeSession(ctx context.Context, ownerID, sessionID uint, now time.Time) error {
+	result := p.db.WithContext(ctx).Model(&model.Session{}).
+		Where("id = ? AND owner_id = ? AND revoked_at IS NULL", sessionID, ownerID).
+		Update("revoked_at", now)
+	if result.Error != nil {
+		return errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return errorx.ErrNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,243 @@
+package usecase
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"devoratio.dev/web-resume/config"
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/internal/generator"
+	"devoratio.dev/web-resume/internal/hasher"
+	"devoratio.dev/web-resume/internal/requestinfo"
+	"devoratio.dev/web-resume/internal/tenancy"
+	"devoratio.dev/web-resume/internal/useragent"
+	"devoratio.dev/web-resume/model"
+	"github.com/google/uuid"
+)
+
+const (
+	tokenTypeBearer              = "Bearer"
+	passwordResetRequiredMessage = "password has to be reset before signing in"
+)
+
+//go:generate mockgen -destination=repositorymock/postgresqlmock.go -package=repositorymock . SessionRepository
+type SessionRepository interface {
+	GetOwnerByID(ctx context.Context, ownerID uint) (*model.OwnerAccount, error)
+	// CreateSession stores a new session along with the first refresh token of
+	// its family
+	CreateSession(ctx context.Context, session *model.Session, refreshToken *model.RefreshToken) error
+	// ConsumeRefreshToken marks an unused refresh token as used and returns it
+	ConsumeRefreshToken(ctx context.Context, tokenHash string, now time.Time) (*model.RefreshToken, error)
+	GetRefreshToken(ctx context.Context, tokenHash string) (*model.RefreshToken, error)
+	GetSessionByFamilyID(ctx context.Context, familyID string) (*model.Session, error)
+	// RenewSession stores the session as updated by a refresh along with the
+	// next refresh token of its family
+	RenewSession(ctx context.Context, session *model.Session, refreshToken *model.RefreshToken) error
+	RevokeFamily(ctx context.Context, familyID string, now time.Time) error
+	// ListSessions returns the sessions of the owner that are neither revoked
+	// nor expired, the most recently seen first
+	ListSessions(ctx context.Context, ownerID uint, now time.Time) ([]model.Session, error)
+	RevokeSession(ctx context.Context, ownerID, sessionID uint, now time.Time) error
+}
+
+//go:generate mockgen -destination=usecasemock/loginalertmock.go -package=usecasemock . LoginAlertUsecase
+type LoginAlertUsecase interface {
+	Inspect(ctx context.Context, owner *model.Owner, session *model.Session)
+}
+
+type Session struct {
+	sessionRepo       SessionRepository
+	loginAlertUsecase LoginAlertUsecase
+	appConfig         *config.Application
+}
+
+func NewUsecase(sessionRepo SessionRepository, loginAlertUsecase LoginAlertUsecase, appConfig *config.Application) *Session {
+	return &Session{
+		sessionRepo:       sessionRepo,
+		loginAlertUsecase: loginAlertUsecase,
+		appConfig:         appConfig,
+	}
+}
+
+// Start opens a session for an owner who just proved who they are, on the
+// device behind the current request.
+func (s *Session) Start(ctx context.Context, owner *model.Owner) (*model.TokenPair, error) {
+	// Every way of signing in ends here. Set when the owner reported a sign-in
+	// that wasn't them, whoever signed in may know the password or control the
+	// mailbox or the identity provider account.
+	ownerAccount, err := s.sessionRepo.GetOwnerByID(ctx, owner.ID)
+	if err != nil {
+		return nil, err
+	}
+	if ownerAccount.PasswordResetRequired {
+		return nil, errorx.New(errorx.TypeForbidden, passwordResetRequiredMessage, nil)
+	}
+
+	now := time.Now()
+	info := requestinfo.FromContext(ctx)
+
+	session := &model.Session{
+		OwnerID:    owner.ID,
+		TenantID:   tenancy.ID(ctx),
+		FamilyID:   uuid.New().String(),
+		DeviceName: useragent.DeviceName(info.UserAgent),
+		ClientIP:   info.ClientIP,
+		UserAgent:  info.UserAgent,
+		CreatedAt:  now,
+		LastSeenAt: now,
+	}
+
+	refreshToken, tokenPair, err := s.issueTokens(owner, session, now)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.sessionRepo.CreateSession(ctx, session, refreshToken); err != nil {
+		return nil, err
+	}
+
+	s.loginAlertUsecase.Inspect(ctx, owner, session)
+
+	return tokenPair, nil
+}
+
+// Refresh trades a refresh token for a new token pair. Refresh tokens are
+// single-use, presenting one twice means it leaked, so the whole session is
+// revoked.
+func (s *Session) Refresh(ctx context.Context, refreshToken string) (*model.TokenPair, error) {
+	now := time.Now()
+	tokenHash := hasher.HashToken(refreshToken)
+
+	usedToken, err := s.sessionRepo.ConsumeRefreshToken(ctx, tokenHash, now)
+	if err != nil {
+		if errorx.Is(err, errorx.ErrNotFound) {
+			s.revokeReusedFamily(ctx, tokenHash, now)
+			return nil, errorx.ErrUnauthorized
+		}
+		return nil, err
+	}
+
+	if !usedToken.ExpiresAt.After(now) {
+		return nil, errorx.ErrUnauthorized
+	}
+
+	session, err := s.sessionRepo.GetSessionByFamilyID(ctx, usedToken.FamilyID)
+	if err != nil {
+		if errorx.Is(err, errorx.ErrNotFound) {
+			return nil, errorx.ErrUnauthorized
+		}
+		return nil, err
+	}
+	// A session is refreshed on the host it was opened on
+	if session.RevokedAt != nil || session.TenantID != tenancy.ID(ctx) {
+		return nil, errorx.ErrUnauthorized
+	}
+
+	ownerAccount, err := s.sessionRepo.GetOwnerByID(ctx, session.OwnerID)
+	if err != nil {
+		if errorx.Is(err, errorx.ErrNotFound) {
+			return nil, errorx.ErrUnauthorized
+		}
+		return nil, err
+	}
+
+	info := requestinfo.FromContext(ctx)
+	session.ClientIP = info.ClientIP
+	session.UserAgent = info.UserAgent
+	session.LastSeenAt = now
+
+	nextToken, tokenPair, err := s.issueTokens(&ownerAccount.Owner, session, now)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.sessionRepo.RenewSession(ctx, session, nextToken); err != nil {
+		return nil, err
+	}
+
+	return tokenPair, nil
+}
+
+func (s *Session) List(ctx context.Context, claim model.Claim) ([]model.Session, error) {
+	sessions, err := s.sessionRepo.ListSessions(ctx, claim.UserID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range sessions {
+		sessions[i].Current = sessions[i].FamilyID == claim.SessionID
+	}
+
+	return sessions, nil
+}
+
+// Revoke signs a device out. Its access token stops working right away and its
+// refresh token can no longer be used.
+func (s *Session) Revoke(ctx context.Context, claim model.Claim, sessionID uint) error {
+	return s.sessionRepo.RevokeSession(ctx, claim.UserID, sessionID, time.Now())
+}
+
+// SignOut ends the session the request was authenticated with
+func (s *Session) SignOut(ctx context.Context, claim model.Claim) error {
+	if claim.SessionID == "" {
+		return errorx.ErrForbidden
+	}
+
+	return s.sessionRepo.RevokeFamily(ctx, claim.SessionID, time.Now())
+}
+
+// issueTokens creates the next access and refresh tokens of session, updating
+// the session to point at them
+func (s *Session) issueTokens(owner *model.Owner, session *model.Session, now time.Time) (*model.RefreshToken, *model.TokenPair, error) {
+	session.TokenID = uuid.New().String()
+	session.ExpiresAt = now.Add(s.appConfig.Authentication.RefreshTokenTTL)
+
+	accessToken, err := generator.GenerateAccessToken(model.Claim{
+		UserID:    owner.ID,
+		Username:  owner.Username,
+		SessionID: session.FamilyID,
+		TenantID:  session.TenantID,
+		Roles:     owner.Roles(),
+		Scopes:    model.SessionScopes,
+		TokenID:   session.TokenID,
+	}, s.appConfig.Authentication.SigningKey)
+	if err != nil {
+		return nil, nil, errorx.ErrInternal
+	}
+
+	refreshToken, err := generator.GenerateRandomToken()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	nextToken := &model.RefreshToken{
+		FamilyID:  session.FamilyID,
+		TokenHash: hasher.HashToken(refreshToken),
+		ExpiresAt: session.ExpiresAt,
+	}
+	tokenPair := &model.TokenPair{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		TokenType:    tokenTypeBearer,
+	}
+
+	return nextToken, tokenPair, nil
+}
+
+// revokeReusedFamily revokes the session of a refresh token that was already
+// used, if the token is known at all
+func (s *Session) revokeReusedFamily(ctx context.Context, tokenHash string, now time.Time) {
+	reusedToken, err := s.sessionRepo.GetRefreshToken(ctx, tokenHash)
+	if err != nil {
+		if !errorx.Is(err, errorx.ErrNotFound) {
+			log.Printf("failed to look up reused refresh token: %s", err)
+		}
+		return
+	}
+
+	log.Printf("refresh token of session %s was reused, revoking the session", reusedToken.FamilyID)
+	if err := s.sessionRepo.RevokeFamily(ctx, reusedToken.FamilyID, now); err != nil {
+		log.Printf("failed to revoke session %s: %s", reusedToken.FamilyID, err)
+	}
+}
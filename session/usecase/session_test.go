@@ -0,0 +1,247 @@
+package usecase_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"devoratio.dev/web-resume/config"
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/internal/generator"
+	"devoratio.dev/web-resume/internal/hasher"
+	"devoratio.dev/web-resume/internal/requestinfo"
+	"devoratio.dev/web-resume/model"
+	"devoratio.dev/web-resume/session/usecase"
+	"devoratio.dev/web-resume/session/usecase/repositorymock"
+	"devoratio.dev/web-resume/session/usecase/usecasemock"
+)
+
+var _ = Describe("Owner sessions", Label("session"), func() {
+	var (
+		mockController *gomock.Controller
+
+		sessionRepoMock       *repositorymock.MockSessionRepository
+		loginAlertUsecaseMock *usecasemock.MockLoginAlertUsecase
+
+		commonCtx        context.Context
+		sessionUsecase   *usecase.Session
+		ownerAccountStub model.OwnerAccount
+		sessionStub      model.Session
+		appConfig        *config.Application
+
+		refreshToken = "refreshtoken"
+		tokenHash    = hasher.HashToken("refreshtoken")
+	)
+
+	BeforeEach(func() {
+		gofakeit.Seed(time.Now().UnixNano())
+		mockController = gomock.NewController(GinkgoT())
+
+		sessionRepoMock = repositorymock.NewMockSessionRepository(mockController)
+		loginAlertUsecaseMock = usecasemock.NewMockLoginAlertUsecase(mockController)
+		appConfig = &config.Application{
+			Authentication: config.Authentication{
+				SigningKey:      []byte("veryverysecretsigningkey"),
+				RefreshTokenTTL: 720 * time.Hour,
+			},
+		}
+
+		sessionUsecase = usecase.NewUsecase(sessionRepoMock, loginAlertUsecaseMock, appConfig)
+
+		gofakeit.Struct(&ownerAccountStub)
+		ownerAccountStub.PasswordResetRequired = false
+		sessionStub = model.Session{
+			ID:       7,
+			OwnerID:  ownerAccountStub.ID,
+			FamilyID: "2b7c0f6e-5b1a-4d8e-9c3f-7a6e1d2c4b5a",
+			TokenID:  "previous",
+		}
+
+		commonCtx = requestinfo.WithInfo(context.Background(), requestinfo.Info{
+			ClientIP:  "203.0.113.7",
+			UserAgent: "Mozilla/5.0 (X11; Linux x86_64; rv:125.0) Gecko/20100101 Firefox/125.0",
+		})
+	})
+
+	AfterEach(func() {
+		mockController.Finish()
+	})
+
+	When("the owner signs in", func() {
+		It("opens a session for the device, issues its first tokens and inspects it", func(ctx SpecContext) {
+			var stored *model.Session
+			sessionRepoMock.EXPECT().GetOwnerByID(commonCtx, ownerAccountStub.ID).Return(&ownerAccountStub, nil)
+			sessionRepoMock.EXPECT().CreateSession(commonCtx, gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, session *model.Session, token *model.RefreshToken) error {
+					Expect(session.OwnerID).Should(Equal(ownerAccountStub.ID))
+					Expect(session.DeviceName).Should(Equal("Firefox on Linux"))
+					Expect(session.ClientIP).Should(Equal("203.0.113.7"))
+					Expect(session.ExpiresAt).Should(BeTemporally("~", time.Now().Add(720*time.Hour), time.Minute))
+					Expect(token.FamilyID).Should(Equal(session.FamilyID))
+					stored = session
+					return nil
+				})
+			loginAlertUsecaseMock.EXPECT().Inspect(commonCtx, &ownerAccountStub.Owner, gomock.Any()).Do(
+				func(_ context.Context, _ *model.Owner, session *model.Session) {
+					Expect(session).Should(BeIdenticalTo(stored))
+				})
+
+			tokenPair, err := sessionUsecase.Start(commonCtx, &ownerAccountStub.Owner)
+			Expect(err).Should(BeNil())
+			Expect(tokenPair.TokenType).Should(Equal("Bearer"))
+			Expect(tokenPair.RefreshToken).ShouldNot(BeEmpty())
+
+			claim, err := generator.VerifyAccessToken(tokenPair.AccessToken, appConfig.Authentication.SigningKey)
+			Expect(err).Should(BeNil())
+			Expect(claim.SessionID).Should(Equal(stored.FamilyID))
+			Expect(claim.TokenID).Should(Equal(stored.TokenID))
+			Expect(claim.Roles).Should(Equal(ownerAccountStub.Roles()))
+			Expect(claim.Scopes).Should(Equal(model.SessionScopes))
+		}, SpecTimeout(time.Second*2))
+	})
+
+	When("the owner signs in after reporting a sign-in that wasn't them", func() {
+		It("refuses to open a session until the password is reset", func(ctx SpecContext) {
+			ownerAccountStub.PasswordResetRequired = true
+			sessionRepoMock.EXPECT().GetOwnerByID(commonCtx, ownerAccountStub.ID).Return(&ownerAccountStub, nil)
+
+			tokenPair, err := sessionUsecase.Start(commonCtx, &ownerAccountStub.Owner)
+			Expect(err.(*errorx.Error).Type).Should(Equal(errorx.TypeForbidden))
+			Expect(tokenPair).Should(BeNil())
+		}, SpecTimeout(time.Second*2))
+	})
+
+	When("the owner refreshes their tokens", func() {
+		Context("the refresh token is unknown", func() {
+			It("tells the user that the token is unauthorized", func(ctx SpecContext) {
+				sessionRepoMock.EXPECT().ConsumeRefreshToken(commonCtx, tokenHash, gomock.Any()).Return(nil, errorx.ErrNotFound)
+				sessionRepoMock.EXPECT().GetRefreshToken(commonCtx, tokenHash).Return(nil, errorx.ErrNotFound)
+
+				tokenPair, err := sessionUsecase.Refresh(commonCtx, refreshToken)
+				Expect(err).Should(Equal(errorx.ErrUnauthorized))
+				Expect(tokenPair).Should(BeNil())
+			}, SpecTimeout(time.Second*2))
+		})
+
+		Context("the refresh token was already used", func() {
+			It("revokes the whole session", func(ctx SpecContext) {
+				usedAt := time.Now().Add(-time.Hour)
+				sessionRepoMock.EXPECT().ConsumeRefreshToken(commonCtx, tokenHash, gomock.Any()).Return(nil, errorx.ErrNotFound)
+				sessionRepoMock.EXPECT().GetRefreshToken(commonCtx, tokenHash).
+					Return(&model.RefreshToken{FamilyID: sessionStub.FamilyID, UsedAt: &usedAt}, nil)
+				sessionRepoMock.EXPECT().RevokeFamily(commonCtx, sessionStub.FamilyID, gomock.Any()).Return(nil)
+
+				tokenPair, err := sessionUsecase.Refresh(commonCtx, refreshToken)
+				Expect(err).Should(Equal(errorx.ErrUnauthorized))
+				Expect(tokenPair).Should(BeNil())
+			}, SpecTimeout(time.Second*2))
+		})
+
+		Context("the session has been revoked", func() {
+			It("tells the user that the token is unauthorized", func(ctx SpecContext) {
+				revokedAt := time.Now().Add(-time.Minute)
+				sessionStub.RevokedAt = &revokedAt
+				sessionRepoMock.EXPECT().ConsumeRefreshToken(commonCtx, tokenHash, gomock.Any()).
+					Return(&model.RefreshToken{FamilyID: sessionStub.FamilyID, ExpiresAt: time.Now().Add(time.Hour)}, nil)
+				sessionRepoMock.EXPECT().GetSessionByFamilyID(commonCtx, sessionStub.FamilyID).Return(&sessionStub, nil)
+
+				tokenPair, err := sessionUsecase.Refresh(commonCtx, refreshToken)
+				Expect(err).Should(Equal(errorx.ErrUnauthorized))
+				Expect(tokenPair).Should(BeNil())
+			}, SpecTimeout(time.Second*2))
+		})
+
+		Context("the refresh token has expired", func() {
+			It("tells the user that the token is unauthorized", func(ctx SpecContext) {
+				sessionRepoMock.EXPECT().ConsumeRefreshToken(commonCtx, tokenHash, gomock.Any()).
+					Return(&model.RefreshToken{FamilyID: sessionStub.FamilyID, ExpiresAt: time.Now().Add(-time.Minute)}, nil)
+
+				tokenPair, err := sessionUsecase.Refresh(commonCtx, refreshToken)
+				Expect(err).Should(Equal(errorx.ErrUnauthorized))
+				Expect(tokenPair).Should(BeNil())
+			}, SpecTimeout(time.Second*2))
+		})
+
+		Context("the refresh token is valid", func() {
+			It("rotates the refresh token and issues a new access token", func(ctx SpecContext) {
+				sessionRepoMock.EXPECT().ConsumeRefreshToken(commonCtx, tokenHash, gomock.Any()).
+					Return(&model.RefreshToken{FamilyID: sessionStub.FamilyID, ExpiresAt: time.Now().Add(time.Hour)}, nil)
+				sessionRepoMock.EXPECT().GetSessionByFamilyID(commonCtx, sessionStub.FamilyID).Return(&sessionStub, nil)
+				sessionRepoMock.EXPECT().GetOwnerByID(commonCtx, sessionStub.OwnerID).Return(&ownerAccountStub, nil)
+				sessionRepoMock.EXPECT().RenewSession(commonCtx, gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, session *model.Session, token *model.RefreshToken) error {
+						Expect(session.TokenID).ShouldNot(Equal("previous"))
+						Expect(session.LastSeenAt).Should(BeTemporally("~", time.Now(), time.Second))
+						Expect(token.FamilyID).Should(Equal(sessionStub.FamilyID))
+						Expect(token.TokenHash).ShouldNot(Equal(tokenHash))
+						return nil
+					})
+
+				tokenPair, err := sessionUsecase.Refresh(commonCtx, refreshToken)
+				Expect(err).Should(BeNil())
+				Expect(tokenPair.RefreshToken).ShouldNot(Equal(refreshToken))
+
+				claim, err := generator.VerifyAccessToken(tokenPair.AccessToken, appConfig.Authentication.SigningKey)
+				Expect(err).Should(BeNil())
+				Expect(claim.UserID).Should(Equal(ownerAccountStub.ID))
+				Expect(claim.SessionID).Should(Equal(sessionStub.FamilyID))
+			}, SpecTimeout(time.Second*2))
+		})
+	})
+
+	When("the owner lists their sessions", func() {
+		It("marks the session the request was made from", func(ctx SpecContext) {
+			otherSession := model.Session{ID: 8, OwnerID: ownerAccountStub.ID, FamilyID: "other"}
+			sessionRepoMock.EXPECT().ListSessions(commonCtx, ownerAccountStub.ID, gomock.Any()).
+				Return([]model.Session{otherSession, sessionStub}, nil)
+
+			sessions, err := sessionUsecase.List(commonCtx, model.Claim{UserID: ownerAccountStub.ID, SessionID: sessionStub.FamilyID})
+			Expect(err).Should(BeNil())
+			Expect(sessions).Should(HaveLen(2))
+			Expect(sessions[0].Current).Should(BeFalse())
+			Expect(sessions[1].Current).Should(BeTrue())
+		}, SpecTimeout(time.Second*2))
+	})
+
+	When("the owner revokes a session", func() {
+		Context("the session does not belong to the owner", func() {
+			It("tells the user that the session is not found", func(ctx SpecContext) {
+				sessionRepoMock.EXPECT().RevokeSession(commonCtx, ownerAccountStub.ID, uint(99), gomock.Any()).Return(errorx.ErrNotFound)
+
+				err := sessionUsecase.Revoke(commonCtx, model.Claim{UserID: ownerAccountStub.ID}, 99)
+				Expect(err).Should(Equal(errorx.ErrNotFound))
+			}, SpecTimeout(time.Second*2))
+		})
+
+		Context("the session is active", func() {
+			It("revokes it", func(ctx SpecContext) {
+				sessionRepoMock.EXPECT().RevokeSession(commonCtx, ownerAccountStub.ID, sessionStub.ID, gomock.Any()).Return(nil)
+
+				err := sessionUsecase.Revoke(commonCtx, model.Claim{UserID: ownerAccountStub.ID}, sessionStub.ID)
+				Expect(err).Should(BeNil())
+			}, SpecTimeout(time.Second*2))
+		})
+	})
+
+	When("the owner signs out", func() {
+		Context("the request was not made with a session token", func() {
+			It("forbids it", func(ctx SpecContext) {
+				err := sessionUsecase.SignOut(commonCtx, model.Claim{UserID: ownerAccountStub.ID, PersonalTokenID: 7})
+				Expect(err).Should(Equal(errorx.ErrForbidden))
+			}, SpecTimeout(time.Second*2))
+		})
+
+		Context("the request was made with a session token", func() {
+			It("revokes the session", func(ctx SpecContext) {
+				sessionRepoMock.EXPECT().RevokeFamily(commonCtx, sessionStub.FamilyID, gomock.Any()).Return(nil)
+
+				err := sessionUsecase.SignOut(commonCtx, model.Claim{UserID: ownerAccountStub.ID, SessionID: sessionStub.FamilyID})
+				Expect(err).Should(BeNil())
+			}, SpecTimeout(time.Second*2))
+		})
+	})
+})
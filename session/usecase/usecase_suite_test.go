@@ -0,0 +1,13 @@
+package usecase_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestUsecase(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Usecase Suite")
+}
@@ -0,0 +1,48 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: devoratio.dev/web-resume/session/usecase (interfaces: LoginAlertUsecase)
+
+// Package usecasemock is a generated GoMock package.
+package usecasemock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "devoratio.dev/web-resume/model"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockLoginAlertUsecase is a mock of LoginAlertUsecase interface.
+type MockLoginAlertUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockLoginAlertUsecaseMockRecorder
+}
+
+// MockLoginAlertUsecaseMockRecorder is the mock recorder for MockLoginAlertUsecase.
+type MockLoginAlertUsecaseMockRecorder struct {
+	mock *MockLoginAlertUsecase
+}
+
+// NewMockLoginAlertUsecase creates a new mock instance.
+func NewMockLoginAlertUsecase(ctrl *gomock.Controller) *MockLoginAlertUsecase {
+	mock := &MockLoginAlertUsecase{ctrl: ctrl}
+	mock.recorder = &MockLoginAlertUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLoginAlertUsecase) EXPECT() *MockLoginAlertUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Inspect mocks base method.
+func (m *MockLoginAlertUsecase) Inspect(arg0 context.Context, arg1 *model.Owner, arg2 *model.Session) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Inspect", arg0, arg1, arg2)
+}
+
+// Inspect indicates an expected call of Inspect.
+func (mr *MockLoginAlertUsecaseMockRecorder) Inspect(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Inspect", reflect.TypeOf((*MockLoginAlertUsecase)(nil).Inspect), arg0, arg1, arg2)
+}
@@ -0,0 +1,62 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+
+	"devoratio.dev/web-resume/internal/httpx"
+	"devoratio.dev/web-resume/model"
+)
+
+const setupTokenHeader = "X-Setup-Token"
+
+type SetupUsecase interface {
+	IsCompleted(ctx context.Context) (bool, error)
+	Bootstrap(ctx context.Context, setupToken string, registration model.OwnerRegistration) (*model.Owner, error)
+}
+
+type HTTP struct {
+	setupUsecase SetupUsecase
+}
+
+func NewHTTP(setupUsecase SetupUsecase) *HTTP {
+	return &HTTP{
+		setupUsecase: setupUsecase,
+	}
+}
+
+// RegisterRoutes registers the setup routes, wrapped by restrict
+func (h *HTTP) RegisterRoutes(mux *http.ServeMux, restrict httpx.Middleware) {
+	mux.Handle("GET /v1/setup", restrict(http.HandlerFunc(h.status)))
+	mux.Handle("POST /v1/setup", restrict(http.HandlerFunc(h.bootstrap)))
+}
+
+type statusResponse struct {
+	Completed bool `json:"completed"`
+}
+
+func (h *HTTP) status(w http.ResponseWriter, r *http.Request) {
+	completed, err := h.setupUsecase.IsCompleted(r.Context())
+	if err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, http.StatusOK, statusResponse{Completed: completed})
+}
+
+func (h *HTTP) bootstrap(w http.ResponseWriter, r *http.Request) {
+	var registration model.OwnerRegistration
+	if err := httpx.DecodeJSON(w, r, &registration); err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	owner, err := h.setupUsecase.Bootstrap(r.Context(), r.Header.Get(setupTokenHeader), registration)
+	if err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, http.StatusCreated, owner)
+}
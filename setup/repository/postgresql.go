@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// setupStateID is the only row ever stored in the setup state table
+const setupStateID = 1
+
+type PostgreSQLDatabase struct {
+	db *gorm.DB
+}
+
+func NewPostgreSQL(db *gorm.DB) *PostgreSQLDatabase {
+	return &PostgreSQLDatabase{
+		db: db,
+	}
+}
+
+func (p *PostgreSQLDatabase) IsSetupCompleted(ctx context.Context) (bool, error) {
+	var count int64
+	result := p.db.WithContext(ctx).Model(&model.SetupState{}).Where("id = ?", setupStateID).Count(&count)
+	if result.Error != nil {
+		return false, errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+
+	return count > 0, nil
+}
+
+func (p *PostgreSQLDatabase) CreateFirstOwner(ctx context.Context, ownerAccount *model.OwnerAccount) error {
+	err := p.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Take the lock row first so concurrent setups serialize on its primary key
+		state := &model.SetupState{ID: setupStateID, CompletedAt: time.Now()}
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(state)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return errorx.ErrForbidden
+		}
+
+		var owners int64
+		if err := tx.Model(&model.OwnerAccount{}).Count(&owners).Error; err != nil {
+			return err
+		}
+		if owners > 0 {
+			return errorx.ErrForbidden
+		}
+
+		if err := tx.Create(ownerAccount).Error; err != nil {
+			return err
+		}
+
+		return tx.Model(state).Update("owner_id", ownerAccount.ID).Error
+	})
+
+	if err != nil {
+		if errors.Is(err, errorx.ErrForbidden) {
+			return errorx.ErrForbidden
+		}
+		return errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), err)
+	}
+
+	return nil
+}
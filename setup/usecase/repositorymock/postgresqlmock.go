@@ -0,0 +1,65 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: devoratio.dev/web-resume/setup/usecase (interfaces: SetupRepository)
+
+// Package repositorymock is a generated GoMock package.
+package repositorymock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "devoratio.dev/web-resume/model"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockSetupRepository is a mock of SetupRepository interface.
+type MockSetupRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSetupRepositoryMockRecorder
+}
+
+// MockSetupRepositoryMockRecorder is the mock recorder for MockSetupRepository.
+type MockSetupRepositoryMockRecorder struct {
+	mock *MockSetupRepository
+}
+
+// NewMockSetupRepository creates a new mock instance.
+func NewMockSetupRepository(ctrl *gomock.Controller) *MockSetupRepository {
+	mock := &MockSetupRepository{ctrl: ctrl}
+	mock.recorder = &MockSetupRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSetupRepository) EXPECT() *MockSetupRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateFirstOwner mocks base method.
+func (m *MockSetupRepository) CreateFirstOwner(arg0 context.Context, arg1 *model.OwnerAccount) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFirstOwner", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateFirstOwner indicates an expected call of CreateFirstOwner.
+func (mr *MockSetupRepositoryMockRecorder) CreateFirstOwner(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFirstOwner", reflect.TypeOf((*MockSetupRepository)(nil).CreateFirstOwner), arg0, arg1)
+}
+
+// IsSetupCompleted mocks base method.
+func (m *MockSetupRepository) IsSetupCompleted(arg0 context.Context) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsSetupCompleted", arg0)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsSetupCompleted indicates an expected call of IsSetupCompleted.
+func (mr *MockSetupRepositoryMockRecorder) IsSetupCompleted(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsSetupCompleted", reflect.TypeOf((*MockSetupRepository)(nil).IsSetupCompleted), arg0)
+}
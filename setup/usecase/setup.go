@@ -0,0 +1,116 @@
+package usecase
+
+import (
+	"context"
+	"crypto/subtle"
+	"log"
+	"strings"
+
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/internal/hasher"
+	"devoratio.dev/web-resume/internal/identifier"
+	"devoratio.dev/web-resume/internal/policy"
+	"devoratio.dev/web-resume/model"
+)
+
+const (
+	setupCompletedMessage    = "setup has already been completed"
+	invalidSetupTokenMessage = "setup token is invalid"
+)
+
+//go:generate mockgen -destination=repositorymock/postgresqlmock.go -package=repositorymock . SetupRepository
+type SetupRepository interface {
+	IsSetupCompleted(ctx context.Context) (bool, error)
+	// CreateFirstOwner stores the owner and locks the setup flow atomically. It
+	// returns errorx.ErrForbidden when the setup flow is already locked or an
+	// owner already exists.
+	CreateFirstOwner(ctx context.Context, ownerAccount *model.OwnerAccount) error
+}
+
+//go:generate mockgen -destination=usecasemock/emailverificationmock.go -package=usecasemock . EmailVerificationUsecase
+type EmailVerificationUsecase interface {
+	SendVerification(ctx context.Context, ownerID uint) error
+}
+
+type Setup struct {
+	setupRepo           SetupRepository
+	verificationUsecase EmailVerificationUsecase
+	setupToken          string
+}
+
+// NewUsecase creates the setup usecase. setupToken is the one-time token that
+// guards the HTTP setup endpoint, an empty token disables the endpoint.
+func NewUsecase(setupRepo SetupRepository, verificationUsecase EmailVerificationUsecase, setupToken string) *Setup {
+	return &Setup{
+		setupRepo:           setupRepo,
+		verificationUsecase: verificationUsecase,
+		setupToken:          setupToken,
+	}
+}
+
+func (s *Setup) IsCompleted(ctx context.Context) (bool, error) {
+	return s.setupRepo.IsSetupCompleted(ctx)
+}
+
+// Bootstrap creates the first owner on behalf of an HTTP client, which has to
+// present the setup token printed at startup.
+func (s *Setup) Bootstrap(ctx context.Context, setupToken string, registration model.OwnerRegistration) (*model.Owner, error) {
+	if s.setupToken == "" {
+		return nil, errorx.New(errorx.TypeForbidden, setupCompletedMessage, nil)
+	}
+
+	if subtle.ConstantTimeCompare([]byte(s.setupToken), []byte(setupToken)) != 1 {
+		return nil, errorx.New(errorx.TypeForbidden, invalidSetupTokenMessage, nil)
+	}
+
+	return s.CreateFirstOwner(ctx, registration)
+}
+
+// CreateFirstOwner creates the first owner without checking the setup token,
+// it is meant for the command line where database access is already trusted.
+func (s *Setup) CreateFirstOwner(ctx context.Context, registration model.OwnerRegistration) (*model.Owner, error) {
+	if err := policy.ValidateRegistration(registration); err != nil {
+		return nil, err
+	}
+
+	completed, err := s.setupRepo.IsSetupCompleted(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if completed {
+		return nil, errorx.New(errorx.TypeForbidden, setupCompletedMessage, nil)
+	}
+
+	hashedPassword, err := hasher.GenerateFromPassword(registration.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	ownerAccount := &model.OwnerAccount{
+		Owner: model.Owner{
+			Username: identifier.Normalize(registration.Username),
+			FistName: strings.TrimSpace(registration.FirstName),
+			LastName: strings.TrimSpace(registration.LastName),
+			Email:    identifier.Normalize(registration.Email),
+			// The first owner administers the deployment
+			SiteAdmin: true,
+		},
+		Password: hashedPassword,
+	}
+
+	err = s.setupRepo.CreateFirstOwner(ctx, ownerAccount)
+	if err != nil {
+		if errorx.Is(err, errorx.ErrForbidden) {
+			return nil, errorx.New(errorx.TypeForbidden, setupCompletedMessage, err)
+		}
+		return nil, err
+	}
+
+	// The owner exists at this point, a failed delivery must not fail the setup
+	// since the link can be sent again once signed in
+	if err := s.verificationUsecase.SendVerification(ctx, ownerAccount.ID); err != nil {
+		log.Printf("failed to send email verification to the first owner: %s", err)
+	}
+
+	return &ownerAccount.Owner, nil
+}
@@ -0,0 +1,170 @@
+package usecase_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/internal/hasher"
+	"devoratio.dev/web-resume/model"
+	"devoratio.dev/web-resume/setup/usecase"
+	"devoratio.dev/web-resume/setup/usecase/repositorymock"
+	"devoratio.dev/web-resume/setup/usecase/usecasemock"
+)
+
+var _ = Describe("Create the first owner", Label("setup"), func() {
+	var (
+		mockController *gomock.Controller
+
+		setupRepoMock                *repositorymock.MockSetupRepository
+		emailVerificationUsecaseMock *usecasemock.MockEmailVerificationUsecase
+
+		setupUsecase *usecase.Setup
+		setupToken   = "onetimesetuptoken"
+		commonCtx    context.Context
+		registration model.OwnerRegistration
+	)
+
+	BeforeEach(func() {
+		mockController = gomock.NewController(GinkgoT())
+
+		setupRepoMock = repositorymock.NewMockSetupRepository(mockController)
+		emailVerificationUsecaseMock = usecasemock.NewMockEmailVerificationUsecase(mockController)
+
+		setupUsecase = usecase.NewUsecase(setupRepoMock, emailVerificationUsecaseMock, setupToken)
+
+		registration = model.OwnerRegistration{
+			Username:  "devoratio",
+			FirstName: "Andre",
+			LastName:  "Febrianto",
+			Email:     "owner@devoratio.dev",
+			Password:  "veryverysecurepassword",
+		}
+
+		commonCtx = context.Background()
+	})
+
+	AfterEach(func() {
+		mockController.Finish()
+	})
+
+	When("the client sends an invalid setup token", func() {
+		It("refuses to create the owner", func(ctx SpecContext) {
+			result, err := setupUsecase.Bootstrap(commonCtx, "guessedtoken", registration)
+			Expect(err.(*errorx.Error).Type).Should(Equal(errorx.TypeForbidden))
+			Expect(result).Should(BeNil())
+		}, SpecTimeout(time.Second*2))
+	})
+
+	When("the server was started after setup had been completed", func() {
+		It("refuses to create the owner", func(ctx SpecContext) {
+			setupUsecase = usecase.NewUsecase(setupRepoMock, emailVerificationUsecaseMock, "")
+
+			result, err := setupUsecase.Bootstrap(commonCtx, "", registration)
+			Expect(err.(*errorx.Error).Type).Should(Equal(errorx.TypeForbidden))
+			Expect(result).Should(BeNil())
+		}, SpecTimeout(time.Second*2))
+	})
+
+	When("the client sends the correct setup token", func() {
+		Context("the owner data is invalid", func() {
+			It("tells the client which fields are invalid", func(ctx SpecContext) {
+				registration.Email = "not an email"
+				registration.Password = "short"
+
+				result, err := setupUsecase.Bootstrap(commonCtx, setupToken, registration)
+				Expect(err.(*errorx.Error).Type).Should(Equal(errorx.TypeInvalidParameter))
+				Expect(err.(*errorx.Error).Details).Should(HaveKey("email"))
+				Expect(err.(*errorx.Error).Details).Should(HaveKey("password"))
+				Expect(result).Should(BeNil())
+			}, SpecTimeout(time.Second*2))
+		})
+
+		Context("the username could be mistaken for another one", func() {
+			It("refuses the username", func(ctx SpecContext) {
+				registration.Username = "devorаtio"
+
+				result, err := setupUsecase.Bootstrap(commonCtx, setupToken, registration)
+				Expect(err.(*errorx.Error).Type).Should(Equal(errorx.TypeInvalidParameter))
+				Expect(err.(*errorx.Error).Details).Should(HaveKey("username"))
+				Expect(result).Should(BeNil())
+			}, SpecTimeout(time.Second*2))
+		})
+
+		Context("setup has already been completed", func() {
+			It("refuses to create the owner", func(ctx SpecContext) {
+				setupRepoMock.EXPECT().IsSetupCompleted(commonCtx).Return(true, nil)
+
+				result, err := setupUsecase.Bootstrap(commonCtx, setupToken, registration)
+				Expect(err.(*errorx.Error).Type).Should(Equal(errorx.TypeForbidden))
+				Expect(result).Should(BeNil())
+			}, SpecTimeout(time.Second*2))
+		})
+
+		Context("another setup completes first", func() {
+			It("refuses to create the owner", func(ctx SpecContext) {
+				setupRepoMock.EXPECT().IsSetupCompleted(commonCtx).Return(false, nil)
+				setupRepoMock.EXPECT().CreateFirstOwner(commonCtx, gomock.Any()).Return(errorx.ErrForbidden)
+
+				result, err := setupUsecase.Bootstrap(commonCtx, setupToken, registration)
+				Expect(err.(*errorx.Error).Type).Should(Equal(errorx.TypeForbidden))
+				Expect(result).Should(BeNil())
+			}, SpecTimeout(time.Second*5))
+		})
+
+		Context("no owner exists yet", func() {
+			It("creates the owner with a hashed password and asks them to verify their email", func(ctx SpecContext) {
+				setupRepoMock.EXPECT().IsSetupCompleted(commonCtx).Return(false, nil)
+				setupRepoMock.EXPECT().CreateFirstOwner(commonCtx, gomock.Any()).DoAndReturn(
+					func(_ context.Context, ownerAccount *model.OwnerAccount) error {
+						Expect(hasher.VerifyPassword(ownerAccount.Password, registration.Password)).Should(Succeed())
+						Expect(ownerAccount.EmailVerified).Should(BeFalse())
+						ownerAccount.ID = 1
+						return nil
+					})
+				emailVerificationUsecaseMock.EXPECT().SendVerification(commonCtx, uint(1)).Return(nil)
+
+				result, err := setupUsecase.Bootstrap(commonCtx, setupToken, registration)
+				Expect(err).Should(BeNil())
+				Expect(result.ID).Should(Equal(uint(1)))
+				Expect(result.Username).Should(Equal(registration.Username))
+			}, SpecTimeout(time.Second*5))
+
+			It("stores the username and email normalized", func(ctx SpecContext) {
+				registration.Username = "DevoRatio"
+				registration.Email = "Owner@Devoratio.DEV"
+
+				setupRepoMock.EXPECT().IsSetupCompleted(commonCtx).Return(false, nil)
+				setupRepoMock.EXPECT().CreateFirstOwner(commonCtx, gomock.Any()).DoAndReturn(
+					func(_ context.Context, ownerAccount *model.OwnerAccount) error {
+						Expect(ownerAccount.Username).Should(Equal("devoratio"))
+						Expect(ownerAccount.Email).Should(Equal("owner@devoratio.dev"))
+						ownerAccount.ID = 1
+						return nil
+					})
+				emailVerificationUsecaseMock.EXPECT().SendVerification(commonCtx, uint(1)).Return(nil)
+
+				_, err := setupUsecase.Bootstrap(commonCtx, setupToken, registration)
+				Expect(err).Should(BeNil())
+			}, SpecTimeout(time.Second*2))
+
+			It("still creates the owner when the verification email can't be sent", func(ctx SpecContext) {
+				setupRepoMock.EXPECT().IsSetupCompleted(commonCtx).Return(false, nil)
+				setupRepoMock.EXPECT().CreateFirstOwner(commonCtx, gomock.Any()).DoAndReturn(
+					func(_ context.Context, ownerAccount *model.OwnerAccount) error {
+						ownerAccount.ID = 1
+						return nil
+					})
+				emailVerificationUsecaseMock.EXPECT().SendVerification(commonCtx, uint(1)).Return(errorx.ErrBadGateway)
+
+				result, err := setupUsecase.Bootstrap(commonCtx, setupToken, registration)
+				Expect(err).Should(BeNil())
+				Expect(result.ID).Should(Equal(uint(1)))
+			}, SpecTimeout(time.Second*5))
+		})
+	})
+})
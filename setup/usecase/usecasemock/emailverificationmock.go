@@ -0,0 +1,49 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: devoratio.dev/web-resume/setup/usecase (interfaces: EmailVerificationUsecase)
+
+// Package usecasemock is a generated GoMock package.
+package usecasemock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockEmailVerificationUsecase is a mock of EmailVerificationUsecase interface.
+type MockEmailVerificationUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockEmailVerificationUsecaseMockRecorder
+}
+
+// MockEmailVerificationUsecaseMockRecorder is the mock recorder for MockEmailVerificationUsecase.
+type MockEmailVerificationUsecaseMockRecorder struct {
+	mock *MockEmailVerificationUsecase
+}
+
+// NewMockEmailVerificationUsecase creates a new mock instance.
+func NewMockEmailVerificationUsecase(ctrl *gomock.Controller) *MockEmailVerificationUsecase {
+	mock := &MockEmailVerificationUsecase{ctrl: ctrl}
+	mock.recorder = &MockEmailVerificationUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEmailVerificationUsecase) EXPECT() *MockEmailVerificationUsecaseMockRecorder {
+	return m.recorder
+}
+
+// SendVerification mocks base method.
+func (m *MockEmailVerificationUsecase) SendVerification(arg0 context.Context, arg1 uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendVerification", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendVerification indicates an expected call of SendVerification.
+func (mr *MockEmailVerificationUsecaseMockRecorder) SendVerification(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendVerification", reflect.TypeOf((*MockEmailVerificationUsecase)(nil).SendVerification), arg0, arg1)
+}
@@ -0,0 +1,261 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/internal/httpx"
+	"devoratio.dev/web-resume/model"
+)
+
+type SkillUsecase interface {
+	CreateCategory(ctx context.Context, claim model.Claim, request model.SkillCategoryRequest) (*model.SkillCategory, error)
+	ListCategories(ctx context.Context, claim model.Claim) ([]model.SkillCategory, error)
+	UpdateCategory(ctx context.Context, claim model.Claim, categoryID uint, request model.SkillCategoryRequest) (*model.SkillCategory, error)
+	DeleteCategory(ctx context.Context, claim model.Claim, categoryID uint) error
+	Create(ctx context.Context, claim model.Claim, request model.SkillRequest) (*model.Skill, error)
+	Get(ctx context.Context, claim model.Claim, skillID uint) (*model.Skill, error)
+	List(ctx context.Context, claim model.Claim) ([]model.Skill, error)
+	Update(ctx context.Context, claim model.Claim, skillID uint, request model.SkillRequest) (*model.Skill, error)
+	Delete(ctx context.Context, claim model.Claim, skillID uint) error
+	References(ctx context.Context, claim model.Claim) ([]model.SkillReferences, error)
+}
+
+type HTTP struct {
+	skillUsecase SkillUsecase
+}
+
+func NewHTTP(skillUsecase SkillUsecase) *HTTP {
+	return &HTTP{
+		skillUsecase: skillUsecase,
+	}
+}
+
+// RegisterRoutes registers the skill and skill category routes, read is
+// required to get them and write to change them
+func (h *HTTP) RegisterRoutes(mux *http.ServeMux, read, write httpx.Middleware) {
+	mux.Handle("GET /v1/skill-categories", read(http.HandlerFunc(h.listCategories)))
+	mux.Handle("POST /v1/skill-categories", write(http.HandlerFunc(h.createCategory)))
+	mux.Handle("PUT /v1/skill-categories/{id}", write(http.HandlerFunc(h.updateCategory)))
+	mux.Handle("DELETE /v1/skill-categories/{id}", write(http.HandlerFunc(h.deleteCategory)))
+	mux.Handle("GET /v1/skills", read(http.HandlerFunc(h.list)))
+	mux.Handle("POST /v1/skills", write(http.HandlerFunc(h.create)))
+	mux.Handle("GET /v1/skills/references", read(http.HandlerFunc(h.references)))
+	mux.Handle("GET /v1/skills/{id}", read(http.HandlerFunc(h.get)))
+	mux.Handle("PUT /v1/skills/{id}", write(http.HandlerFunc(h.update)))
+	mux.Handle("DELETE /v1/skills/{id}", write(http.HandlerFunc(h.delete)))
+}
+
+func (h *HTTP) listCategories(w http.ResponseWriter, r *http.Request) {
+	claim, ok := httpx.ClaimFromContext(r.Context())
+	if !ok {
+		httpx.WriteError(w, errorx.ErrUnauthorized)
+		return
+	}
+
+	categories, err := h.skillUsecase.ListCategories(r.Context(), *claim)
+	if err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, http.StatusOK, categories)
+}
+
+func (h *HTTP) createCategory(w http.ResponseWriter, r *http.Request) {
+	claim, ok := httpx.ClaimFromContext(r.Context())
+	if !ok {
+		httpx.WriteError(w, errorx.ErrUnauthorized)
+		return
+	}
+
+	var request model.SkillCategoryRequest
+	if err := httpx.DecodeJSON(w, r, &request); err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	category, err := h.skillUsecase.CreateCategory(r.Context(), *claim, request)
+	if err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, http.StatusCreated, category)
+}
+
+func (h *HTTP) updateCategory(w http.ResponseWriter, r *http.Request) {
+	claim, ok := httpx.ClaimFromContext(r.Context())
+	if !ok {
+		httpx.WriteError(w, errorx.ErrUnauthorized)
+		return
+	}
+
+	categoryID, err := strconv.ParseUint(r.PathValue("id"), 10, 0)
+	if err != nil {
+		httpx.WriteError(w, errorx.ErrNotFound)
+		return
+	}
+
+	var request model.SkillCategoryRequest
+	if err := httpx.DecodeJSON(w, r, &request); err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	category, err := h.skillUsecase.UpdateCategory(r.Context(), *claim, uint(categoryID), request)
+	if err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, http.StatusOK, category)
+}
+
+func (h *HTTP) deleteCategory(w http.ResponseWriter, r *http.Request) {
+	claim, ok := httpx.ClaimFromContext(r.Context())
+	if !ok {
+		httpx.WriteError(w, errorx.ErrUnauthorized)
+		return
+	}
+
+	categoryID, err := strconv.ParseUint(r.PathValue("id"), 10, 0)
+	if err != nil {
+		httpx.WriteError(w, errorx.ErrNotFound)
+		return
+	}
+
+	if err := h.skillUsecase.DeleteCategory(r.Context(), *claim, uint(categoryID)); err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	httpx.WriteNoContent(w)
+}
+
+func (h *HTTP) list(w http.ResponseWriter, r *http.Request) {
+	claim, ok := httpx.ClaimFromContext(r.Context())
+	if !ok {
+		httpx.WriteError(w, errorx.ErrUnauthorized)
+		return
+	}
+
+	skills, err := h.skillUsecase.List(r.Context(), *claim)
+	if err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, http.StatusOK, skills)
+}
+
+func (h *HTTP) create(w http.ResponseWriter, r *http.Request) {
+	claim, ok := httpx.ClaimFromContext(r.Context())
+	if !ok {
+		httpx.WriteError(w, errorx.ErrUnauthorized)
+		return
+	}
+
+	var request model.SkillRequest
+	if err := httpx.DecodeJSON(w, r, &request); err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	skill, err := h.skillUsecase.Create(r.Context(), *claim, request)
+	if err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, http.StatusCreated, skill)
+}
+
+func (h *HTTP) references(w http.ResponseWriter, r *http.Request) {
+	claim, ok := httpx.ClaimFromContext(r.Context())
+	if !ok {
+		httpx.WriteError(w, errorx.ErrUnauthorized)
+		return
+	}
+
+	references, err := h.skillUsecase.References(r.Context(), *claim)
+	if err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, http.StatusOK, references)
+}
+
+func (h *HTTP) get(w http.ResponseWriter, r *http.Request) {
+	claim, ok := httpx.ClaimFromContext(r.Context())
+	if !ok {
+		httpx.WriteError(w, errorx.ErrUnauthorized)
+		return
+	}
+
+	skillID, err := strconv.ParseUint(r.PathValue("id"), 10, 0)
+	if err != nil {
+		httpx.WriteError(w, errorx.ErrNotFound)
+		return
+	}
+
+	skill, err := h.skillUsecase.Get(r.Context(), *claim, uint(skillID))
+	if err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, http.StatusOK, skill)
+}
+
+func (h *HTTP) update(w http.ResponseWriter, r *http.Request) {
+	claim, ok := httpx.ClaimFromContext(r.Context())
+	if !ok {
+		httpx.WriteError(w, errorx.ErrUnauthorized)
+		return
+	}
+
+	skillID, err := strconv.ParseUint(r.PathValue("id"), 10, 0)
+	if err != nil {
+		httpx.WriteError(w, errorx.ErrNotFound)
+		return
+	}
+
+	var request model.SkillRequest
+	if err := httpx.DecodeJSON(w, r, &request); err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	skill, err := h.skillUsecase.Update(r.Context(), *claim, uint(skillID), request)
+	if err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, http.StatusOK, skill)
+}
+
+func (h *HTTP) delete(w http.ResponseWriter, r *http.Request) {
+	claim, ok := httpx.ClaimFromContext(r.Context())
+	if !ok {
+		httpx.WriteError(w, errorx.ErrUnauthorized)
+		return
+	}
+
+	skillID, err := strconv.ParseUint(r.PathValue("id"), 10, 0)
+	if err != nil {
+		httpx.WriteError(w, errorx.ErrNotFound)
+		return
+	}
+
+	if err := h.skillUsecase.Delete(r.Context(), *claim, uint(skillID)); err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	httpx.WriteNoContent(w)
+}
@@ -0,0 +1,266 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/internal/identifier"
+	"devoratio.dev/web-resume/model"
+	"gorm.io/gorm"
+)
+
+type PostgreSQLDatabase struct {
+	db *gorm.DB
+}
+
+func NewPostgreSQL(db *gorm.DB) *PostgreSQLDatabase {
+	return &PostgreSQLDatabase{
+		db: db,
+	}
+}
+
+func (p *PostgreSQLDatabase) CreateSkillCategory(ctx context.Context, category *model.SkillCategory) error {
+	if err := p.db.WithContext(ctx).Create(category).Error; err != nil {
+		return errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), err)
+	}
+
+	return nil
+}
+
+func (p *PostgreSQLDatabase) GetSkillCategory(ctx context.Context, ownerID, categoryID uint) (*model.SkillCategory, error) {
+	category := &model.SkillCategory{}
+	result := p.db.WithContext(ctx).Where("id = ? AND owner_id = ?", categoryID, ownerID).First(category)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, errorx.ErrNotFound
+		}
+		return nil, errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+
+	return category, nil
+}
+
+func (p *PostgreSQLDatabase) ListSkillCategories(ctx context.Context, ownerID uint) ([]model.SkillCategory, error) {
+	var categories []model.SkillCategory
+	result := p.db.WithContext(ctx).Where("owner_id = ?", ownerID).Order("lower(name)").Find(&categories)
+	if result.Error != nil {
+		return nil, errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+
+	return categories, nil
+}
+
+func (p *PostgreSQLDatabase) UpdateSkillCategory(ctx context.Context, category *model.SkillCategory) error {
+	result := p.db.WithContext(ctx).Model(category).
+		Where("owner_id = ?", category.OwnerID).
+		Update("name", category.Name)
+	if result.Error != nil {
+		return errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return errorx.ErrNotFound
+	}
+
+	return nil
+}
+
+func (p *PostgreSQLDatabase) DeleteSkillCategory(ctx context.Context, ownerID, categoryID uint) error {
+	err := p.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&model.Skill{}).Where("owner_id = ? AND category_id = ?", ownerID, categoryID).Update("category_id", nil).Error
+		if err != nil {
+			return err
+		}
+
+		result := tx.Where("id = ? AND owner_id = ?", categoryID, ownerID).Delete(&model.SkillCategory{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errorx.ErrNotFound
+		}
+		return errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), err)
+	}
+
+	return nil
+}
+
+func (p *PostgreSQLDatabase) CreateSkill(ctx context.Context, skill *model.Skill) error {
+	err := p.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(skill).Error; err != nil {
+			return err
+		}
+
+		return tx.Create(aliasesOf(skill)).Error
+	})
+	if err != nil {
+		return errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), err)
+	}
+
+	return nil
+}
+
+func (p *PostgreSQLDatabase) GetSkill(ctx context.Context, ownerID, skillID uint) (*model.Skill, error) {
+	skill := model.Skill{}
+	result := p.db.WithContext(ctx).Where("id = ? AND owner_id = ?", skillID, ownerID).First(&skill)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, errorx.ErrNotFound
+		}
+		return nil, errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+
+	skills := []model.Skill{skill}
+	if err := p.loadAliases(ctx, ownerID, skills); err != nil {
+		return nil, err
+	}
+
+	return &skills[0], nil
+}
+
+func (p *PostgreSQLDatabase) ListSkills(ctx context.Context, ownerID uint) ([]model.Skill, error) {
+	var skills []model.Skill
+	result := p.db.WithContext(ctx).Where("owner_id = ?", ownerID).Order("lower(name)").Find(&skills)
+	if result.Error != nil {
+		return nil, errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+
+	if err := p.loadAliases(ctx, ownerID, skills); err != nil {
+		return nil, err
+	}
+
+	return skills, nil
+}
+
+func (p *PostgreSQLDatabase) UpdateSkill(ctx context.Context, skill *model.Skill) error {
+	err := p.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(skill).
+			Where("owner_id = ?", skill.OwnerID).
+			Select("*").Omit("id", "owner_id", "created_at").
+			Updates(skill)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		if err := tx.Where("skill_id = ?", skill.ID).Delete(&model.SkillAlias{}).Error; err != nil {
+			return err
+		}
+
+		return tx.Create(aliasesOf(skill)).Error
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errorx.ErrNotFound
+		}
+		return errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), err)
+	}
+
+	return nil
+}
+
+func (p *PostgreSQLDatabase) DeleteSkill(ctx context.Context, ownerID, skillID uint) error {
+	err := p.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("id = ? AND owner_id = ?", skillID, ownerID).Delete(&model.Skill{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		return tx.Where("skill_id = ?", skillID).Delete(&model.SkillAlias{}).Error
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errorx.ErrNotFound
+		}
+		return errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), err)
+	}
+
+	return nil
+}
+
+func (p *PostgreSQLDatabase) FindSkillAliases(ctx context.Context, ownerID uint, keys []string, exceptSkillID uint) ([]model.SkillAlias, error) {
+	var aliases []model.SkillAlias
+	result := p.db.WithContext(ctx).
+		Where("owner_id = ? AND key IN ? AND skill_id <> ?", ownerID, keys, exceptSkillID).
+		Find(&aliases)
+	if result.Error != nil {
+		return nil, errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+
+	return aliases, nil
+}
+
+func (p *PostgreSQLDatabase) ListExperiences(ctx context.Context, ownerID uint) ([]model.Experience, error) {
+	var experiences []model.Experience
+	result := p.db.WithContext(ctx).Where("owner_id = ?", ownerID).Order("id").Find(&experiences)
+	if result.Error != nil {
+		return nil, errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+
+	return experiences, nil
+}
+
+func (p *PostgreSQLDatabase) ListProjects(ctx context.Context, ownerID uint) ([]model.Project, error) {
+	var projects []model.Project
+	result := p.db.WithContext(ctx).Where("owner_id = ?", ownerID).Order("id").Find(&projects)
+	if result.Error != nil {
+		return nil, errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+
+	return projects, nil
+}
+
+// loadAliases fills in the aliases of skills, leaving out their canonical
+// names
+func (p *PostgreSQLDatabase) loadAliases(ctx context.Context, ownerID uint, skills []model.Skill) error {
+	var aliases []model.SkillAlias
+	result := p.db.WithContext(ctx).Where("owner_id = ?", ownerID).Order("id").Find(&aliases)
+	if result.Error != nil {
+		return errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+
+	bySkill := map[uint][]string{}
+	for _, alias := range aliases {
+		bySkill[alias.SkillID] = append(bySkill[alias.SkillID], alias.Name)
+	}
+
+	for i := range skills {
+		skills[i].Aliases = []string{}
+		for _, name := range bySkill[skills[i].ID] {
+			if name != skills[i].Name {
+				skills[i].Aliases = append(skills[i].Aliases, name)
+			}
+		}
+	}
+
+	return nil
+}
+
+// aliasesOf returns the rows of the alias table for the name and aliases of
+// skill
+func aliasesOf(skill *model.Skill) []model.SkillAlias {
+	names := append([]string{skill.Name}, skill.Aliases...)
+	aliases := make([]model.SkillAlias, 0, len(names))
+	for _, name := range names {
+		aliases = append(aliases, model.SkillAlias{
+			OwnerID: skill.OwnerID,
+			SkillID: skill.ID,
+			Name:    name,
+			Key:     identifier.Normalize(name),
+		})
+	}
+
+	return aliases
+}
@@ -0,0 +1,225 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: devoratio.dev/web-resume/skill/usecase (interfaces: SkillRepository)
+
+// Package repositorymock is a generated GoMock package.
+package repositorymock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "devoratio.dev/web-resume/model"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockSkillRepository is a mock of SkillRepository interface.
+type MockSkillRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSkillRepositoryMockRecorder
+}
+
+// MockSkillRepositoryMockRecorder is the mock recorder for MockSkillRepository.
+type MockSkillRepositoryMockRecorder struct {
+	mock *MockSkillRepository
+}
+
+// NewMockSkillRepository creates a new mock instance.
+func NewMockSkillRepository(ctrl *gomock.Controller) *MockSkillRepository {
+	mock := &MockSkillRepository{ctrl: ctrl}
+	mock.recorder = &MockSkillRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSkillRepository) EXPECT() *MockSkillRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateSkill mocks base method.
+func (m *MockSkillRepository) CreateSkill(arg0 context.Context, arg1 *model.Skill) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSkill", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateSkill indicates an expected call of CreateSkill.
+func (mr *MockSkillRepositoryMockRecorder) CreateSkill(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSkill", reflect.TypeOf((*MockSkillRepository)(nil).CreateSkill), arg0, arg1)
+}
+
+// CreateSkillCategory mocks base method.
+func (m *MockSkillRepository) CreateSkillCategory(arg0 context.Context, arg1 *model.SkillCategory) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSkillCategory", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateSkillCategory indicates an expected call of CreateSkillCategory.
+func (mr *MockSkillRepositoryMockRecorder) CreateSkillCategory(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSkillCategory", reflect.TypeOf((*MockSkillRepository)(nil).CreateSkillCategory), arg0, arg1)
+}
+
+// DeleteSkill mocks base method.
+func (m *MockSkillRepository) DeleteSkill(arg0 context.Context, arg1, arg2 uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSkill", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSkill indicates an expected call of DeleteSkill.
+func (mr *MockSkillRepositoryMockRecorder) DeleteSkill(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSkill", reflect.TypeOf((*MockSkillRepository)(nil).DeleteSkill), arg0, arg1, arg2)
+}
+
+// DeleteSkillCategory mocks base method.
+func (m *MockSkillRepository) DeleteSkillCategory(arg0 context.Context, arg1, arg2 uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSkillCategory", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSkillCategory indicates an expected call of DeleteSkillCategory.
+func (mr *MockSkillRepositoryMockRecorder) DeleteSkillCategory(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSkillCategory", reflect.TypeOf((*MockSkillRepository)(nil).DeleteSkillCategory), arg0, arg1, arg2)
+}
+
+// FindSkillAliases mocks base method.
+func (m *MockSkillRepository) FindSkillAliases(arg0 context.Context, arg1 uint, arg2 []string, arg3 uint) ([]model.SkillAlias, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSkillAliases", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]model.SkillAlias)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindSkillAliases indicates an expected call of FindSkillAliases.
+func (mr *MockSkillRepositoryMockRecorder) FindSkillAliases(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSkillAliases", reflect.TypeOf((*MockSkillRepository)(nil).FindSkillAliases), arg0, arg1, arg2, arg3)
+}
+
+// GetSkill mocks base method.
+func (m *MockSkillRepository) GetSkill(arg0 context.Context, arg1, arg2 uint) (*model.Skill, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSkill", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*model.Skill)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSkill indicates an expected call of GetSkill.
+func (mr *MockSkillRepositoryMockRecorder) GetSkill(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSkill", reflect.TypeOf((*MockSkillRepository)(nil).GetSkill), arg0, arg1, arg2)
+}
+
+// GetSkillCategory mocks base method.
+func (m *MockSkillRepository) GetSkillCategory(arg0 context.Context, arg1, arg2 uint) (*model.SkillCategory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSkillCategory", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*model.SkillCategory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSkillCategory indicates an expected call of GetSkillCategory.
+func (mr *MockSkillRepositoryMockRecorder) GetSkillCategory(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSkillCategory", reflect.TypeOf((*MockSkillRepository)(nil).GetSkillCategory), arg0, arg1, arg2)
+}
+
+// ListExperiences mocks base method.
+func (m *MockSkillRepository) ListExperiences(arg0 context.Context, arg1 uint) ([]model.Experience, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListExperiences", arg0, arg1)
+	ret0, _ := ret[0].([]model.Experience)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListExperiences indicates an expected call of ListExperiences.
+func (mr *MockSkillRepositoryMockRecorder) ListExperiences(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExperiences", reflect.TypeOf((*MockSkillRepository)(nil).ListExperiences), arg0, arg1)
+}
+
+// ListProjects mocks base method.
+func (m *MockSkillRepository) ListProjects(arg0 context.Context, arg1 uint) ([]model.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProjects", arg0, arg1)
+	ret0, _ := ret[0].([]model.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProjects indicates an expected call of ListProjects.
+func (mr *MockSkillRepositoryMockRecorder) ListProjects(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjects", reflect.TypeOf((*MockSkillRepository)(nil).ListProjects), arg0, arg1)
+}
+
+// ListSkillCategories mocks base method.
+func (m *MockSkillRepository) ListSkillCategories(arg0 context.Context, arg1 uint) ([]model.SkillCategory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSkillCategories", arg0, arg1)
+	ret0, _ := ret[0].([]model.SkillCategory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSkillCategories indicates an expected call of ListSkillCategories.
+func (mr *MockSkillRepositoryMockRecorder) ListSkillCategories(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSkillCategories", reflect.TypeOf((*MockSkillRepository)(nil).ListSkillCategories), arg0, arg1)
+}
+
+// ListSkills mocks base method.
+func (m *MockSkillRepository) ListSkills(arg0 context.Context, arg1 uint) ([]model.Skill, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSkills", arg0, arg1)
+	ret0, _ := ret[0].([]model.Skill)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSkills indicates an expected call of ListSkills.
+func (mr *MockSkillRepositoryMockRecorder) ListSkills(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSkills", reflect.TypeOf((*MockSkillRepository)(nil).ListSkills), arg0, arg1)
+}
+
+// UpdateSkill mocks base method.
+func (m *MockSkillRepository) UpdateSkill(arg0 context.Context, arg1 *model.Skill) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSkill", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSkill indicates an expected call of UpdateSkill.
+func (mr *MockSkillRepositoryMockRecorder) UpdateSkill(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSkill", reflect.TypeOf((*MockSkillRepository)(nil).UpdateSkill), arg0, arg1)
+}
+
+// UpdateSkillCategory mocks base method.
+func (m *MockSkillRepository) UpdateSkillCategory(arg0 context.Context, arg1 *model.SkillCategory) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSkillCategory", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSkillCategory indicates an expected call of UpdateSkillCategory.
+func (mr *MockSkillRepositoryMockRecorder) UpdateSkillCategory(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSkillCategory", reflect.TypeOf((*MockSkillRepository)(nil).UpdateSkillCategory), arg0, arg1)
+}
@@ -0,0 +1,419 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	experienceusecase "devoratio.dev/web-resume/experience/usecase"
+	"devoratio.dev/web-resume/internal/authz"
+	"devoratio.dev/web-resume/internal/entry"
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/internal/identifier"
+	"devoratio.dev/web-resume/model"
+	projectusecase "devoratio.dev/web-resume/project/usecase"
+)
+
+const (
+	invalidSkillMessage    = "skill is invalid"
+	invalidCategoryMessage = "skill category is invalid"
+
+	maxNameLength = 50
+	maxAliases    = 20
+	maxYearsOfUse = 60
+)
+
+//go:generate mockgen -destination=repositorymock/postgresqlmock.go -package=repositorymock . SkillRepository
+type SkillRepository interface {
+	CreateSkillCategory(ctx context.Context, category *model.SkillCategory) error
+	GetSkillCategory(ctx context.Context, ownerID, categoryID uint) (*model.SkillCategory, error)
+	ListSkillCategories(ctx context.Context, ownerID uint) ([]model.SkillCategory, error)
+	UpdateSkillCategory(ctx context.Context, category *model.SkillCategory) error
+	// DeleteSkillCategory deletes the category and leaves its skills without
+	// one
+	DeleteSkillCategory(ctx context.Context, ownerID, categoryID uint) error
+	// CreateSkill stores the skill along with its name and aliases in the
+	// alias table
+	CreateSkill(ctx context.Context, skill *model.Skill) error
+	GetSkill(ctx context.Context, ownerID, skillID uint) (*model.Skill, error)
+	ListSkills(ctx context.Context, ownerID uint) ([]model.Skill, error)
+	// UpdateSkill stores the skill and replaces its aliases
+	UpdateSkill(ctx context.Context, skill *model.Skill) error
+	DeleteSkill(ctx context.Context, ownerID, skillID uint) error
+	// FindSkillAliases returns the aliases of the owner with one of keys that
+	// belong to a skill other than exceptSkillID
+	FindSkillAliases(ctx context.Context, ownerID uint, keys []string, exceptSkillID uint) ([]model.SkillAlias, error)
+	ListExperiences(ctx context.Context, ownerID uint) ([]model.Experience, error)
+	ListProjects(ctx context.Context, ownerID uint) ([]model.Project, error)
+}
+
+type Skill struct {
+	skillRepo SkillRepository
+	now       func() time.Time
+}
+
+// NewUsecase creates the skill usecase, now tells the time current roles are
+// counted up to
+func NewUsecase(skillRepo SkillRepository, now func() time.Time) *Skill {
+	return &Skill{
+		skillRepo: skillRepo,
+		now:       now,
+	}
+}
+
+func (s *Skill) CreateCategory(ctx context.Context, claim model.Claim, request model.SkillCategoryRequest) (*model.SkillCategory, error) {
+	if err := authz.RequireScope(claim, model.ScopeResumeWrite); err != nil {
+		return nil, err
+	}
+
+	category := &model.SkillCategory{OwnerID: claim.UserID}
+	if err := applyCategory(category, request); err != nil {
+		return nil, err
+	}
+
+	if err := s.skillRepo.CreateSkillCategory(ctx, category); err != nil {
+		return nil, err
+	}
+
+	return category, nil
+}
+
+func (s *Skill) ListCategories(ctx context.Context, claim model.Claim) ([]model.SkillCategory, error) {
+	return s.skillRepo.ListSkillCategories(ctx, claim.UserID)
+}
+
+func (s *Skill) UpdateCategory(ctx context.Context, claim model.Claim, categoryID uint, request model.SkillCategoryRequest) (*model.SkillCategory, error) {
+	if err := authz.RequireScope(claim, model.ScopeResumeWrite); err != nil {
+		return nil, err
+	}
+
+	category, err := s.skillRepo.GetSkillCategory(ctx, claim.UserID, categoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := applyCategory(category, request); err != nil {
+		return nil, err
+	}
+
+	if err := s.skillRepo.UpdateSkillCategory(ctx, category); err != nil {
+		return nil, err
+	}
+
+	return category, nil
+}
+
+func (s *Skill) DeleteCategory(ctx context.Context, claim model.Claim, categoryID uint) error {
+	if err := authz.RequireScope(claim, model.ScopeResumeWrite); err != nil {
+		return err
+	}
+
+	return s.skillRepo.DeleteSkillCategory(ctx, claim.UserID, categoryID)
+}
+
+func (s *Skill) Create(ctx context.Context, claim model.Claim, request model.SkillRequest) (*model.Skill, error) {
+	if err := authz.RequireScope(claim, model.ScopeResumeWrite); err != nil {
+		return nil, err
+	}
+
+	skill := &model.Skill{OwnerID: claim.UserID}
+	if err := s.apply(ctx, skill, request); err != nil {
+		return nil, err
+	}
+
+	if err := s.skillRepo.CreateSkill(ctx, skill); err != nil {
+		return nil, err
+	}
+
+	if err := s.deriveExperience(ctx, skill); err != nil {
+		return nil, err
+	}
+
+	return skill, nil
+}
+
+func (s *Skill) Get(ctx context.Context, claim model.Claim, skillID uint) (*model.Skill, error) {
+	skill, err := s.skillRepo.GetSkill(ctx, claim.UserID, skillID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.deriveExperience(ctx, skill); err != nil {
+		return nil, err
+	}
+
+	return skill, nil
+}
+
+func (s *Skill) List(ctx context.Context, claim model.Claim) ([]model.Skill, error) {
+	skills, err := s.skillRepo.ListSkills(ctx, claim.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	experiences, err := s.skillRepo.ListExperiences(ctx, claim.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	projects, err := s.skillRepo.ListProjects(ctx, claim.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	DeriveExperience(skills, experiences, projects, s.now())
+	return skills, nil
+}
+
+func (s *Skill) Update(ctx context.Context, claim model.Claim, skillID uint, request model.SkillRequest) (*model.Skill, error) {
+	if err := authz.RequireScope(claim, model.ScopeResumeWrite); err != nil {
+		return nil, err
+	}
+
+	skill, err := s.skillRepo.GetSkill(ctx, claim.UserID, skillID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.apply(ctx, skill, request); err != nil {
+		return nil, err
+	}
+
+	if err := s.skillRepo.UpdateSkill(ctx, skill); err != nil {
+		return nil, err
+	}
+
+	if err := s.deriveExperience(ctx, skill); err != nil {
+		return nil, err
+	}
+
+	return skill, nil
+}
+
+func (s *Skill) Delete(ctx context.Context, claim model.Claim, skillID uint) error {
+	if err := authz.RequireScope(claim, model.ScopeResumeWrite); err != nil {
+		return err
+	}
+
+	return s.skillRepo.DeleteSkill(ctx, claim.UserID, skillID)
+}
+
+// References returns every skill of the owner with the entries tagged with
+// its name or one of its aliases
+func (s *Skill) References(ctx context.Context, claim model.Claim) ([]model.SkillReferences, error) {
+	skills, err := s.skillRepo.ListSkills(ctx, claim.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	experiences, err := s.skillRepo.ListExperiences(ctx, claim.UserID)
+	if err != nil {
+		return nil, err
+	}
+	experienceusecase.Sort(experiences)
+
+	projects, err := s.skillRepo.ListProjects(ctx, claim.UserID)
+	if err != nil {
+		return nil, err
+	}
+	projectusecase.Sort(projects)
+
+	DeriveExperience(skills, experiences, projects, s.now())
+
+	resolve := NewResolver(skills)
+	references := make([]model.SkillReferences, len(skills))
+	for i, skill := range skills {
+		references[i] = model.SkillReferences{Skill: skill, Experiences: []model.Experience{}, Projects: []model.Project{}}
+	}
+	for _, experience := range experiences {
+		for _, i := range resolve(experience.Tags) {
+			references[i].Experiences = append(references[i].Experiences, experience)
+		}
+	}
+	for _, project := range projects {
+		for _, i := range resolve(project.Tags) {
+			references[i].Projects = append(references[i].Projects, project)
+		}
+	}
+
+	return references, nil
+}
+
+// DeriveExperience sets how long each of skills was used for, from the date
+// ranges of the experiences and projects tagged with it. The current role and
+// ongoing projects count up to now.
+func DeriveExperience(skills []model.Skill, experiences []model.Experience, projects []model.Project, now time.Time) {
+	resolve := NewResolver(skills)
+	spans := make([][]entry.Span, len(skills))
+	for _, experience := range experiences {
+		span := entry.Span{Start: experience.Start, End: model.MonthOf(now)}
+		if experience.End != nil && !experience.Current {
+			span.End = *experience.End
+		}
+
+		for _, i := range resolve(experience.Tags) {
+			spans[i] = append(spans[i], span)
+		}
+	}
+	for _, project := range projects {
+		span := entry.Span{Start: project.Start, End: model.MonthOf(now)}
+		if project.End != nil {
+			span.End = *project.End
+		}
+
+		for _, i := range resolve(project.Tags) {
+			spans[i] = append(spans[i], span)
+		}
+	}
+
+	for i := range skills {
+		skills[i].ExperienceMonths = entry.Months(spans[i])
+		skills[i].ExperienceYears = math.Round(float64(skills[i].ExperienceMonths)/12*10) / 10
+	}
+}
+
+// deriveExperience is DeriveExperience for a single skill of the owner
+func (s *Skill) deriveExperience(ctx context.Context, skill *model.Skill) error {
+	experiences, err := s.skillRepo.ListExperiences(ctx, skill.OwnerID)
+	if err != nil {
+		return err
+	}
+
+	projects, err := s.skillRepo.ListProjects(ctx, skill.OwnerID)
+	if err != nil {
+		return err
+	}
+
+	skills := []model.Skill{*skill}
+	DeriveExperience(skills, experiences, projects, s.now())
+	*skill = skills[0]
+
+	return nil
+}
+
+// NewResolver returns a function that gives the indexes in skills of the
+// skills tags refer to, each at most once
+func NewResolver(skills []model.Skill) func(tags []string) []int {
+	indexes := map[string]int{}
+	for i, skill := range skills {
+		indexes[identifier.Normalize(skill.Name)] = i
+		for _, alias := range skill.Aliases {
+			indexes[identifier.Normalize(alias)] = i
+		}
+	}
+
+	return func(tags []string) []int {
+		var resolved []int
+		for _, tag := range tags {
+			i, ok := indexes[identifier.Normalize(strings.TrimSpace(tag))]
+			if ok && !slices.Contains(resolved, i) {
+				resolved = append(resolved, i)
+			}
+		}
+
+		return resolved
+	}
+}
+
+func applyCategory(category *model.SkillCategory, request model.SkillCategoryRequest) error {
+	category.Name = strings.TrimSpace(request.Name)
+
+	details := map[string]interface{}{}
+	switch {
+	case category.Name == "":
+		details["name"] = "must not be empty"
+	case utf8.RuneCountInString(category.Name) > maxNameLength:
+		details["name"] = fmt.Sprintf("must not be longer than %d characters", maxNameLength)
+	}
+
+	if len(details) > 0 {
+		err := errorx.New(errorx.TypeInvalidParameter, invalidCategoryMessage, nil)
+		err.Details = details
+		return err
+	}
+
+	return nil
+}
+
+// apply validates request and copies it onto skill
+func (s *Skill) apply(ctx context.Context, skill *model.Skill, request model.SkillRequest) error {
+	skill.CategoryID = request.CategoryID
+	skill.Name = strings.TrimSpace(request.Name)
+	skill.Proficiency = request.Proficiency
+	skill.YearsOfUse = request.YearsOfUse
+	skill.UpdatedAt = time.Now()
+
+	nameKey := identifier.Normalize(skill.Name)
+	keys := []string{nameKey}
+	skill.Aliases = []string{}
+	for _, alias := range entry.TrimAll(request.Aliases) {
+		key := identifier.Normalize(alias)
+		if !slices.Contains(keys, key) {
+			keys = append(keys, key)
+			skill.Aliases = append(skill.Aliases, alias)
+		}
+	}
+
+	details := map[string]interface{}{}
+
+	switch {
+	case skill.Name == "":
+		details["name"] = "must not be empty"
+	case utf8.RuneCountInString(skill.Name) > maxNameLength:
+		details["name"] = fmt.Sprintf("must not be longer than %d characters", maxNameLength)
+	}
+
+	if len(skill.Aliases) > maxAliases {
+		details["aliases"] = fmt.Sprintf("must not have more than %d items", maxAliases)
+	}
+	for _, alias := range skill.Aliases {
+		if utf8.RuneCountInString(alias) > maxNameLength {
+			details["aliases"] = fmt.Sprintf("must not have items longer than %d characters", maxNameLength)
+		}
+	}
+
+	if !slices.Contains(model.Proficiencies, skill.Proficiency) {
+		details["proficiency"] = "must be one of beginner, intermediate, advanced, expert"
+	}
+
+	if skill.YearsOfUse != nil && (*skill.YearsOfUse < 0 || *skill.YearsOfUse > maxYearsOfUse) {
+		details["years_of_use"] = fmt.Sprintf("must be between 0 and %d", maxYearsOfUse)
+	}
+
+	if skill.CategoryID != nil {
+		_, err := s.skillRepo.GetSkillCategory(ctx, skill.OwnerID, *skill.CategoryID)
+		switch {
+		case errorx.Is(err, errorx.ErrNotFound):
+			details["category_id"] = "does not exist"
+		case err != nil:
+			return err
+		}
+	}
+
+	if _, ok := details["name"]; !ok {
+		taken, err := s.skillRepo.FindSkillAliases(ctx, skill.OwnerID, keys, skill.ID)
+		if err != nil {
+			return err
+		}
+
+		for _, alias := range taken {
+			if alias.Key == nameKey {
+				details["name"] = "is already used by another skill"
+			} else {
+				details["aliases"] = fmt.Sprintf("%q is already used by another skill", alias.Name)
+			}
+		}
+	}
+
+	if len(details) > 0 {
+		err := errorx.New(errorx.TypeInvalidParameter, invalidSkillMessage, nil)
+		err.Details = details
+		return err
+	}
+
+	return nil
+}
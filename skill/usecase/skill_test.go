@@ -0,0 +1,182 @@
+package usecase_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/model"
+	"devoratio.dev/web-resume/skill/usecase"
+	"devoratio.dev/web-resume/skill/usecase/repositorymock"
+)
+
+var _ = Describe("Skill", Label("skill"), func() {
+	var (
+		mockController *gomock.Controller
+
+		skillRepoMock *repositorymock.MockSkillRepository
+
+		skillUsecase *usecase.Skill
+		now          time.Time
+		commonCtx    context.Context
+		claim        model.Claim
+		request      model.SkillRequest
+	)
+
+	BeforeEach(func() {
+		mockController = gomock.NewController(GinkgoT())
+
+		skillRepoMock = repositorymock.NewMockSkillRepository(mockController)
+
+		now = time.Date(2024, time.June, 15, 0, 0, 0, 0, time.UTC)
+		skillUsecase = usecase.NewUsecase(skillRepoMock, func() time.Time { return now })
+
+		claim = model.Claim{UserID: 1, Username: "devoratio", Scopes: []string{model.ScopeResumeWrite}}
+		categoryID := uint(2)
+		request = model.SkillRequest{
+			CategoryID:  &categoryID,
+			Name:        " Go ",
+			Aliases:     []string{"golang", "GoLang", "go", ""},
+			Proficiency: model.ProficiencyExpert,
+		}
+
+		commonCtx = context.Background()
+	})
+
+	AfterEach(func() {
+		mockController.Finish()
+	})
+
+	Describe("Create a skill", func() {
+		When("the skill is invalid", func() {
+			It("tells the owner which fields are invalid", func(ctx SpecContext) {
+				years := 99
+				request.Name = ""
+				request.Proficiency = "guru"
+				request.YearsOfUse = &years
+
+				skillRepoMock.EXPECT().GetSkillCategory(commonCtx, claim.UserID, uint(2)).Return(nil, errorx.ErrNotFound).Times(1)
+
+				result, err := skillUsecase.Create(commonCtx, claim, request)
+				Expect(err.(*errorx.Error).Type).Should(Equal(errorx.TypeInvalidParameter))
+				Expect(err.(*errorx.Error).Details).Should(HaveKey("name"))
+				Expect(err.(*errorx.Error).Details).Should(HaveKey("proficiency"))
+				Expect(err.(*errorx.Error).Details).Should(HaveKey("years_of_use"))
+				Expect(err.(*errorx.Error).Details).Should(HaveKeyWithValue("category_id", "does not exist"))
+				Expect(result).Should(BeNil())
+			}, SpecTimeout(time.Second*2))
+		})
+
+		When("an alias belongs to another skill", func() {
+			It("tells the owner which alias is taken", func(ctx SpecContext) {
+				skillRepoMock.EXPECT().GetSkillCategory(commonCtx, claim.UserID, uint(2)).Return(&model.SkillCategory{ID: 2}, nil).Times(1)
+				skillRepoMock.EXPECT().FindSkillAliases(commonCtx, claim.UserID, []string{"go", "golang"}, uint(0)).
+					Return([]model.SkillAlias{{SkillID: 5, Name: "Golang", Key: "golang"}}, nil).Times(1)
+
+				result, err := skillUsecase.Create(commonCtx, claim, request)
+				Expect(err.(*errorx.Error).Details).Should(HaveKeyWithValue("aliases", `"Golang" is already used by another skill`))
+				Expect(result).Should(BeNil())
+			}, SpecTimeout(time.Second*2))
+		})
+
+		When("the skill is valid", func() {
+			It("stores the skill with its distinct aliases", func(ctx SpecContext) {
+				skillRepoMock.EXPECT().GetSkillCategory(commonCtx, claim.UserID, uint(2)).Return(&model.SkillCategory{ID: 2}, nil).Times(1)
+				skillRepoMock.EXPECT().FindSkillAliases(commonCtx, claim.UserID, []string{"go", "golang"}, uint(0)).Return(nil, nil).Times(1)
+				skillRepoMock.EXPECT().CreateSkill(commonCtx, gomock.Any()).Return(nil).Times(1)
+				skillRepoMock.EXPECT().ListExperiences(commonCtx, claim.UserID).Return(nil, nil).Times(1)
+				skillRepoMock.EXPECT().ListProjects(commonCtx, claim.UserID).Return(nil, nil).Times(1)
+
+				result, err := skillUsecase.Create(commonCtx, claim, request)
+				Expect(err).Should(BeNil())
+				Expect(result.Name).Should(Equal("Go"))
+				Expect(result.Aliases).Should(Equal([]string{"golang"}))
+				Expect(result.OwnerID).Should(Equal(claim.UserID))
+			}, SpecTimeout(time.Second*2))
+		})
+	})
+
+	Describe("Update a skill", func() {
+		When("the skill keeps its own name", func() {
+			It("ignores the skill itself when looking for taken names", func(ctx SpecContext) {
+				request.CategoryID = nil
+
+				skillRepoMock.EXPECT().GetSkill(commonCtx, claim.UserID, uint(5)).
+					Return(&model.Skill{ID: 5, OwnerID: claim.UserID, Name: "Go"}, nil).Times(1)
+				skillRepoMock.EXPECT().FindSkillAliases(commonCtx, claim.UserID, gomock.Any(), uint(5)).Return(nil, nil).Times(1)
+				skillRepoMock.EXPECT().UpdateSkill(commonCtx, gomock.Any()).Return(nil).Times(1)
+				skillRepoMock.EXPECT().ListExperiences(commonCtx, claim.UserID).Return(nil, nil).Times(1)
+				skillRepoMock.EXPECT().ListProjects(commonCtx, claim.UserID).Return(nil, nil).Times(1)
+
+				result, err := skillUsecase.Update(commonCtx, claim, 5, request)
+				Expect(err).Should(BeNil())
+				Expect(result.CategoryID).Should(BeNil())
+			}, SpecTimeout(time.Second*2))
+		})
+	})
+
+	Describe("Create a skill category", func() {
+		When("the name is empty", func() {
+			It("refuses the category", func(ctx SpecContext) {
+				result, err := skillUsecase.CreateCategory(commonCtx, claim, model.SkillCategoryRequest{Name: " "})
+				Expect(err.(*errorx.Error).Details).Should(HaveKey("name"))
+				Expect(result).Should(BeNil())
+			}, SpecTimeout(time.Second*2))
+		})
+	})
+
+	Describe("List the skills", func() {
+		It("derives how long each skill was used without counting overlaps twice", func(ctx SpecContext) {
+			first, second := model.NewMonth(2020, time.December), model.NewMonth(2021, time.June)
+			skillRepoMock.EXPECT().ListSkills(commonCtx, claim.UserID).Return([]model.Skill{
+				{ID: 1, Name: "Go", Aliases: []string{"golang"}},
+				{ID: 2, Name: "Kubernetes", Aliases: []string{"k8s"}},
+			}, nil).Times(1)
+			skillRepoMock.EXPECT().ListExperiences(commonCtx, claim.UserID).Return([]model.Experience{
+				{ID: 1, Start: model.NewMonth(2020, time.January), End: &first, Tags: []string{"Go"}},
+				{ID: 2, Start: model.NewMonth(2020, time.July), End: &second, Tags: []string{"golang", "Kubernetes"}},
+				{ID: 3, Start: model.NewMonth(2023, time.July), Current: true, Tags: []string{"go"}},
+			}, nil).Times(1)
+			skillRepoMock.EXPECT().ListProjects(commonCtx, claim.UserID).Return([]model.Project{
+				{ID: 1, Start: model.NewMonth(2024, time.January), Tags: []string{"k8s", "Kubernetes"}},
+			}, nil).Times(1)
+
+			result, err := skillUsecase.List(commonCtx, claim)
+			Expect(err).Should(BeNil())
+			Expect(result[0].ExperienceMonths).Should(Equal(18 + 12))
+			Expect(result[0].ExperienceYears).Should(Equal(2.5))
+			Expect(result[1].ExperienceMonths).Should(Equal(12 + 6))
+			Expect(result[1].ExperienceYears).Should(Equal(1.5))
+		}, SpecTimeout(time.Second*2))
+	})
+
+	Describe("List the skills with their references", func() {
+		It("links entries tagged with a name or alias of a skill", func(ctx SpecContext) {
+			end := model.NewMonth(2021, time.January)
+			skillRepoMock.EXPECT().ListSkills(commonCtx, claim.UserID).Return([]model.Skill{
+				{ID: 1, Name: "Go", Aliases: []string{"golang"}},
+				{ID: 2, Name: "Kubernetes", Aliases: []string{"k8s"}},
+			}, nil).Times(1)
+			skillRepoMock.EXPECT().ListExperiences(commonCtx, claim.UserID).Return([]model.Experience{
+				{ID: 1, Start: model.NewMonth(2018, time.March), End: &end, Tags: []string{"Golang", "Go"}},
+				{ID: 2, Start: model.NewMonth(2021, time.February), Current: true, Tags: []string{"GO", "PostgreSQL"}},
+			}, nil).Times(1)
+			skillRepoMock.EXPECT().ListProjects(commonCtx, claim.UserID).Return([]model.Project{
+				{ID: 4, Start: model.NewMonth(2022, time.May), Tags: []string{"Go"}},
+			}, nil).Times(1)
+
+			result, err := skillUsecase.References(commonCtx, claim)
+			Expect(err).Should(BeNil())
+			Expect(result).Should(HaveLen(2))
+			Expect(result[0].Experiences).Should(HaveLen(2))
+			Expect(result[0].Experiences[0].ID).Should(Equal(uint(2)))
+			Expect(result[0].Projects).Should(HaveLen(1))
+			Expect(result[1].Experiences).Should(BeEmpty())
+			Expect(result[1].Projects).Should(BeEmpty())
+		}, SpecTimeout(time.Second*2))
+	})
+})
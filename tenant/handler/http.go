@@ -0,0 +1,70 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/internal/httpx"
+	"devoratio.dev/web-resume/model"
+)
+
+type TenantUsecase interface {
+	Create(ctx context.Context, claim model.Claim, request model.TenantRequest) (*model.Tenant, error)
+	List(ctx context.Context, claim model.Claim) ([]model.Tenant, error)
+}
+
+type HTTP struct {
+	tenantUsecase TenantUsecase
+}
+
+func NewHTTP(tenantUsecase TenantUsecase) *HTTP {
+	return &HTTP{
+		tenantUsecase: tenantUsecase,
+	}
+}
+
+// RegisterRoutes registers the tenant administration routes, authenticate has
+// to restrict them to the site administrator
+func (h *HTTP) RegisterRoutes(mux *http.ServeMux, authenticate httpx.Middleware) {
+	mux.Handle("POST /v1/admin/tenants", authenticate(http.HandlerFunc(h.create)))
+	mux.Handle("GET /v1/admin/tenants", authenticate(http.HandlerFunc(h.list)))
+}
+
+func (h *HTTP) create(w http.ResponseWriter, r *http.Request) {
+	claim, ok := httpx.ClaimFromContext(r.Context())
+	if !ok {
+		httpx.WriteError(w, errorx.ErrUnauthorized)
+		return
+	}
+
+	var request model.TenantRequest
+	if err := httpx.DecodeJSON(w, r, &request); err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	tenant, err := h.tenantUsecase.Create(r.Context(), *claim, request)
+	if err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, http.StatusCreated, tenant)
+}
+
+func (h *HTTP) list(w http.ResponseWriter, r *http.Request) {
+	claim, ok := httpx.ClaimFromContext(r.Context())
+	if !ok {
+		httpx.WriteError(w, errorx.ErrUnauthorized)
+		return
+	}
+
+	tenants, err := h.tenantUsecase.List(r.Context(), *claim)
+	if err != nil {
+		httpx.WriteError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, http.StatusOK, tenants)
+}
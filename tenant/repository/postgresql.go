@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/model"
+	"gorm.io/gorm"
+)
+
+// conflictCheck looks for a row of model already holding the value of field
+type conflictCheck struct {
+	field string
+	model interface{}
+	query string
+	value string
+}
+
+type PostgreSQLDatabase struct {
+	db *gorm.DB
+}
+
+func NewPostgreSQL(db *gorm.DB) *PostgreSQLDatabase {
+	return &PostgreSQLDatabase{
+		db: db,
+	}
+}
+
+func (p *PostgreSQLDatabase) GetTenantBySlug(ctx context.Context, slug string) (*model.Tenant, error) {
+	return p.getTenant(ctx, "slug = ?", slug)
+}
+
+func (p *PostgreSQLDatabase) GetTenantByHost(ctx context.Context, host string) (*model.Tenant, error) {
+	return p.getTenant(ctx, "host = ?", host)
+}
+
+func (p *PostgreSQLDatabase) FindConflicts(ctx context.Context, tenant *model.Tenant, owner *model.Owner) ([]string, error) {
+	checks := []conflictCheck{
+		{field: "slug", model: &model.Tenant{}, query: "slug = ?", value: tenant.Slug},
+		{field: "owner.username", model: &model.OwnerAccount{}, query: "lower(username) = ?", value: owner.Username},
+		{field: "owner.email", model: &model.OwnerAccount{}, query: "lower(email) = ?", value: owner.Email},
+	}
+	if tenant.Host != nil {
+		checks = append(checks, conflictCheck{field: "host", model: &model.Tenant{}, query: "host = ?", value: *tenant.Host})
+	}
+
+	var conflicts []string
+	for _, check := range checks {
+		var count int64
+		result := p.db.WithContext(ctx).Model(check.model).Where(check.query, check.value).Count(&count)
+		if result.Error != nil {
+			return nil, errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+		}
+		if count > 0 {
+			conflicts = append(conflicts, check.field)
+		}
+	}
+
+	return conflicts, nil
+}
+
+func (p *PostgreSQLDatabase) CreateTenant(ctx context.Context, ownerAccount *model.OwnerAccount, tenant *model.Tenant) error {
+	err := p.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(ownerAccount).Error; err != nil {
+			return err
+		}
+
+		tenant.OwnerID = ownerAccount.ID
+		return tx.Create(tenant).Error
+	})
+	if err != nil {
+		return errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), err)
+	}
+
+	return nil
+}
+
+func (p *PostgreSQLDatabase) ListTenants(ctx context.Context) ([]model.Tenant, error) {
+	var tenants []model.Tenant
+	result := p.db.WithContext(ctx).Order("slug").Find(&tenants)
+	if result.Error != nil {
+		return nil, errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+
+	return tenants, nil
+}
+
+func (p *PostgreSQLDatabase) getTenant(ctx context.Context, query string, value string) (*model.Tenant, error) {
+	tenant := &model.Tenant{}
+	result := p.db.WithContext(ctx).Where(query, value).First(tenant)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, errorx.ErrNotFound
+		}
+		return nil, errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+
+	return tenant, nil
+}
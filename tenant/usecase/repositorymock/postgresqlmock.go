@@ -0,0 +1,110 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: devoratio.dev/web-resume/tenant/usecase (interfaces: TenantRepository)
+
+// Package repositorymock is a generated GoMock package.
+package repositorymock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "devoratio.dev/web-resume/model"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockTenantRepository is a mock of TenantRepository interface.
+type MockTenantRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTenantRepositoryMockRecorder
+}
+
+// MockTenantRepositoryMockRecorder is the mock recorder for MockTenantRepository.
+type MockTenantRepositoryMockRecorder struct {
+	mock *MockTenantRepository
+}
+
+// NewMockTenantRepository creates a new mock instance.
+func NewMockTenantRepository(ctrl *gomock.Controller) *MockTenantRepository {
+	mock := &MockTenantRepository{ctrl: ctrl}
+	mock.recorder = &MockTenantRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTenantRepository) EXPECT() *MockTenantRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateTenant mocks base method.
+func (m *MockTenantRepository) CreateTenant(arg0 context.Context, arg1 *model.OwnerAccount, arg2 *model.Tenant) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTenant", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateTenant indicates an expected call of CreateTenant.
+func (mr *MockTenantRepositoryMockRecorder) CreateTenant(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTenant", reflect.TypeOf((*MockTenantRepository)(nil).CreateTenant), arg0, arg1, arg2)
+}
+
+// FindConflicts mocks base method.
+func (m *MockTenantRepository) FindConflicts(arg0 context.Context, arg1 *model.Tenant, arg2 *model.Owner) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindConflicts", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindConflicts indicates an expected call of FindConflicts.
+func (mr *MockTenantRepositoryMockRecorder) FindConflicts(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindConflicts", reflect.TypeOf((*MockTenantRepository)(nil).FindConflicts), arg0, arg1, arg2)
+}
+
+// GetTenantByHost mocks base method.
+func (m *MockTenantRepository) GetTenantByHost(arg0 context.Context, arg1 string) (*model.Tenant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTenantByHost", arg0, arg1)
+	ret0, _ := ret[0].(*model.Tenant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTenantByHost indicates an expected call of GetTenantByHost.
+func (mr *MockTenantRepositoryMockRecorder) GetTenantByHost(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTenantByHost", reflect.TypeOf((*MockTenantRepository)(nil).GetTenantByHost), arg0, arg1)
+}
+
+// GetTenantBySlug mocks base method.
+func (m *MockTenantRepository) GetTenantBySlug(arg0 context.Context, arg1 string) (*model.Tenant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTenantBySlug", arg0, arg1)
+	ret0, _ := ret[0].(*model.Tenant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTenantBySlug indicates an expected call of GetTenantBySlug.
+func (mr *MockTenantRepositoryMockRecorder) GetTenantBySlug(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTenantBySlug", reflect.TypeOf((*MockTenantRepository)(nil).GetTenantBySlug), arg0, arg1)
+}
+
+// ListTenants mocks base method.
+func (m *MockTenantRepository) ListTenants(arg0 context.Context) ([]model.Tenant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTenants", arg0)
+	ret0, _ := ret[0].([]model.Tenant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTenants indicates an expected call of ListTenants.
+func (mr *MockTenantRepositoryMockRecorder) ListTenants(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTenants", reflect.TypeOf((*MockTenantRepository)(nil).ListTenants), arg0)
+}
@@ -0,0 +1,201 @@
+package usecase
+
+import (
+	"context"
+	"log"
+	"net"
+	"regexp"
+	"slices"
+	"strings"
+
+	"devoratio.dev/web-resume/config"
+	"devoratio.dev/web-resume/internal/authz"
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/internal/hasher"
+	"devoratio.dev/web-resume/internal/identifier"
+	"devoratio.dev/web-resume/internal/policy"
+	"devoratio.dev/web-resume/internal/tenancy"
+	"devoratio.dev/web-resume/model"
+)
+
+const (
+	invalidTenantMessage    = "tenant data is invalid"
+	tenantHostedHereMessage = "tenants can only be managed on the base domain"
+)
+
+var (
+	// validSlug is a single DNS label, so that it can be used as a subdomain
+	validSlug = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?$`)
+	validHost = regexp.MustCompile(`^([a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?\.)+[a-z]{2,63}$`)
+	// reservedSlugs are subdomains commonly used by the deployment itself
+	reservedSlugs = []string{"admin", "api", "app", "mail", "static", "www"}
+)
+
+//go:generate mockgen -destination=repositorymock/postgresqlmock.go -package=repositorymock . TenantRepository
+type TenantRepository interface {
+	GetTenantBySlug(ctx context.Context, slug string) (*model.Tenant, error)
+	GetTenantByHost(ctx context.Context, host string) (*model.Tenant, error)
+	// FindConflicts returns the fields of the tenant and its owner whose value
+	// is already taken, keyed like the fields of model.TenantRequest
+	FindConflicts(ctx context.Context, tenant *model.Tenant, owner *model.Owner) ([]string, error)
+	// CreateTenant stores the owner and their tenant atomically
+	CreateTenant(ctx context.Context, ownerAccount *model.OwnerAccount, tenant *model.Tenant) error
+	ListTenants(ctx context.Context) ([]model.Tenant, error)
+}
+
+//go:generate mockgen -destination=usecasemock/emailverificationmock.go -package=usecasemock . EmailVerificationUsecase
+type EmailVerificationUsecase interface {
+	SendVerification(ctx context.Context, ownerID uint) error
+}
+
+type Tenant struct {
+	tenantRepo          TenantRepository
+	verificationUsecase EmailVerificationUsecase
+	appConfig           *config.Application
+}
+
+func NewUsecase(tenantRepo TenantRepository, verificationUsecase EmailVerificationUsecase, appConfig *config.Application) *Tenant {
+	return &Tenant{
+		tenantRepo:          tenantRepo,
+		verificationUsecase: verificationUsecase,
+		appConfig:           appConfig,
+	}
+}
+
+// Resolve returns the tenant hosted on host, either a subdomain of the base
+// domain named after the tenant's slug or the tenant's custom host. The base
+// domain itself has no tenant.
+func (t *Tenant) Resolve(ctx context.Context, host string) (*model.Tenant, error) {
+	if hostname, _, err := net.SplitHostPort(host); err == nil {
+		host = hostname
+	}
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+
+	baseDomain := strings.ToLower(t.appConfig.Tenancy.BaseDomain)
+	if host == baseDomain {
+		return nil, nil
+	}
+
+	if slug, found := strings.CutSuffix(host, "."+baseDomain); found && !strings.Contains(slug, ".") {
+		return t.tenantRepo.GetTenantBySlug(ctx, slug)
+	}
+
+	return t.tenantRepo.GetTenantByHost(ctx, host)
+}
+
+// Create registers a new owner along with the tenant hosting their resume. It
+// is reserved to the site administrator.
+func (t *Tenant) Create(ctx context.Context, claim model.Claim, request model.TenantRequest) (*model.Tenant, error) {
+	if err := t.authorize(ctx, claim); err != nil {
+		return nil, err
+	}
+
+	tenant := &model.Tenant{
+		Slug: strings.TrimSpace(request.Slug),
+	}
+	if request.Host != nil {
+		host := strings.ToLower(strings.TrimSpace(*request.Host))
+		tenant.Host = &host
+	}
+
+	if err := t.validate(tenant, request.Owner); err != nil {
+		return nil, err
+	}
+
+	hashedPassword, err := hasher.GenerateFromPassword(request.Owner.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	ownerAccount := &model.OwnerAccount{
+		Owner: model.Owner{
+			Username: identifier.Normalize(request.Owner.Username),
+			FistName: strings.TrimSpace(request.Owner.FirstName),
+			LastName: strings.TrimSpace(request.Owner.LastName),
+			Email:    identifier.Normalize(request.Owner.Email),
+		},
+		Password: hashedPassword,
+	}
+
+	conflicts, err := t.tenantRepo.FindConflicts(ctx, tenant, &ownerAccount.Owner)
+	if err != nil {
+		return nil, err
+	}
+	if len(conflicts) > 0 {
+		details := map[string]interface{}{}
+		for _, field := range conflicts {
+			details[field] = "is already taken"
+		}
+		err := errorx.New(errorx.TypeInvalidParameter, invalidTenantMessage, nil)
+		err.Details = details
+		return nil, err
+	}
+
+	if err := t.tenantRepo.CreateTenant(ctx, ownerAccount, tenant); err != nil {
+		return nil, err
+	}
+
+	// The tenant exists at this point, the owner can have the link sent again
+	// once signed in
+	if err := t.verificationUsecase.SendVerification(ctx, ownerAccount.ID); err != nil {
+		log.Printf("failed to send email verification to the owner of tenant %d: %s", tenant.ID, err)
+	}
+
+	return tenant, nil
+}
+
+func (t *Tenant) List(ctx context.Context, claim model.Claim) ([]model.Tenant, error) {
+	if err := t.authorize(ctx, claim); err != nil {
+		return nil, err
+	}
+
+	return t.tenantRepo.ListTenants(ctx)
+}
+
+// authorize lets the site administrator through, on the base domain only since
+// every owner lookup made on a tenant host is limited to the tenant's owner
+func (t *Tenant) authorize(ctx context.Context, claim model.Claim) error {
+	if err := authz.RequireRole(claim, model.RoleAdmin); err != nil {
+		return err
+	}
+
+	if _, ok := tenancy.FromContext(ctx); ok {
+		return errorx.New(errorx.TypeForbidden, tenantHostedHereMessage, nil)
+	}
+
+	return nil
+}
+
+func (t *Tenant) validate(tenant *model.Tenant, registration model.OwnerRegistration) error {
+	details := map[string]interface{}{}
+
+	if err := policy.ValidateRegistration(registration); err != nil {
+		for field, violation := range err.(*errorx.Error).Details {
+			details["owner."+field] = violation
+		}
+	}
+
+	if !validSlug.MatchString(tenant.Slug) {
+		details["slug"] = "must be lowercase letters, digits and hyphens, at most 63 characters"
+	} else if slices.Contains(reservedSlugs, tenant.Slug) {
+		details["slug"] = "is reserved"
+	}
+
+	if tenant.Host != nil {
+		baseDomain := strings.ToLower(t.appConfig.Tenancy.BaseDomain)
+		host := *tenant.Host
+		if !validHost.MatchString(host) || len(host) > 253 {
+			details["host"] = "must be a valid host name"
+		} else if host == baseDomain || strings.HasSuffix(host, "."+baseDomain) {
+			details["host"] = "must not be the base domain or one of its subdomains"
+		}
+	}
+
+	if len(details) > 0 {
+		err := errorx.New(errorx.TypeInvalidParameter, invalidTenantMessage, nil)
+		err.Details = details
+		return err
+	}
+
+	return nil
+}
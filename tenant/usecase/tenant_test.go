@@ -0,0 +1,183 @@
+package usecase_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"devoratio.dev/web-resume/config"
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/internal/tenancy"
+	"devoratio.dev/web-resume/model"
+	"devoratio.dev/web-resume/tenant/usecase"
+	"devoratio.dev/web-resume/tenant/usecase/repositorymock"
+	"devoratio.dev/web-resume/tenant/usecase/usecasemock"
+)
+
+var _ = Describe("Tenants", Label("tenant"), func() {
+	var (
+		mockController *gomock.Controller
+
+		tenantRepoMock               *repositorymock.MockTenantRepository
+		emailVerificationUsecaseMock *usecasemock.MockEmailVerificationUsecase
+
+		tenantUsecase *usecase.Tenant
+		commonCtx     context.Context
+		adminClaim    model.Claim
+		request       model.TenantRequest
+		tenantStub    model.Tenant
+	)
+
+	BeforeEach(func() {
+		mockController = gomock.NewController(GinkgoT())
+
+		tenantRepoMock = repositorymock.NewMockTenantRepository(mockController)
+		emailVerificationUsecaseMock = usecasemock.NewMockEmailVerificationUsecase(mockController)
+
+		appConfig := &config.Application{
+			Tenancy: config.Tenancy{Enabled: true, BaseDomain: "resume.example.com"},
+		}
+		tenantUsecase = usecase.NewUsecase(tenantRepoMock, emailVerificationUsecaseMock, appConfig)
+
+		adminClaim = model.Claim{
+			UserID: 1,
+			Roles:  []string{model.RoleOwner, model.RoleAdmin},
+			Scopes: []string{model.ScopeAccountManage},
+		}
+		request = model.TenantRequest{
+			Slug: "jane",
+			Owner: model.OwnerRegistration{
+				Username:  "jane",
+				FirstName: "Jane",
+				LastName:  "Doe",
+				Email:     "jane@example.org",
+				Password:  "veryverysecurepassword",
+			},
+		}
+		tenantStub = model.Tenant{ID: 7, OwnerID: 3, Slug: "jane"}
+
+		commonCtx = context.Background()
+	})
+
+	AfterEach(func() {
+		mockController.Finish()
+	})
+
+	Describe("Resolve the tenant of a host", func() {
+		When("the request is on the base domain", func() {
+			It("resolves no tenant", func(ctx SpecContext) {
+				result, err := tenantUsecase.Resolve(commonCtx, "Resume.Example.com:9090")
+				Expect(err).Should(BeNil())
+				Expect(result).Should(BeNil())
+			}, SpecTimeout(time.Second*2))
+		})
+
+		When("the request is on a subdomain of the base domain", func() {
+			It("resolves the tenant by its slug", func(ctx SpecContext) {
+				tenantRepoMock.EXPECT().GetTenantBySlug(commonCtx, "jane").Return(&tenantStub, nil).Times(1)
+
+				result, err := tenantUsecase.Resolve(commonCtx, "jane.resume.example.com")
+				Expect(err).Should(BeNil())
+				Expect(result).Should(Equal(&tenantStub))
+			}, SpecTimeout(time.Second*2))
+		})
+
+		When("the request is on another host", func() {
+			It("resolves the tenant by its custom host", func(ctx SpecContext) {
+				tenantRepoMock.EXPECT().GetTenantByHost(commonCtx, "cv.jane.dev").Return(nil, errorx.ErrNotFound).Times(1)
+
+				result, err := tenantUsecase.Resolve(commonCtx, "cv.jane.dev.")
+				Expect(err).Should(Equal(errorx.ErrNotFound))
+				Expect(result).Should(BeNil())
+			}, SpecTimeout(time.Second*2))
+		})
+
+		When("the request is on a nested subdomain of the base domain", func() {
+			It("does not take it for a slug", func(ctx SpecContext) {
+				tenantRepoMock.EXPECT().GetTenantByHost(commonCtx, "a.jane.resume.example.com").Return(nil, errorx.ErrNotFound).Times(1)
+
+				_, err := tenantUsecase.Resolve(commonCtx, "a.jane.resume.example.com")
+				Expect(err).Should(Equal(errorx.ErrNotFound))
+			}, SpecTimeout(time.Second*2))
+		})
+	})
+
+	Describe("Create a tenant", func() {
+		When("the owner is not the site administrator", func() {
+			It("refuses to create the tenant", func(ctx SpecContext) {
+				adminClaim.Roles = []string{model.RoleOwner}
+
+				result, err := tenantUsecase.Create(commonCtx, adminClaim, request)
+				Expect(err).Should(Equal(errorx.ErrForbidden))
+				Expect(result).Should(BeNil())
+			}, SpecTimeout(time.Second*2))
+		})
+
+		When("the request is served on a tenant host", func() {
+			It("refuses to create the tenant", func(ctx SpecContext) {
+				tenantCtx := tenancy.WithTenant(commonCtx, &tenantStub)
+
+				result, err := tenantUsecase.Create(tenantCtx, adminClaim, request)
+				Expect(err.(*errorx.Error).Type).Should(Equal(errorx.TypeForbidden))
+				Expect(result).Should(BeNil())
+			}, SpecTimeout(time.Second*2))
+		})
+
+		When("the request is invalid", func() {
+			It("tells the administrator which fields are invalid", func(ctx SpecContext) {
+				host := "cv.resume.example.com"
+				request.Slug = "www"
+				request.Host = &host
+				request.Owner.Email = "not an email"
+
+				result, err := tenantUsecase.Create(commonCtx, adminClaim, request)
+				Expect(err.(*errorx.Error).Type).Should(Equal(errorx.TypeInvalidParameter))
+				Expect(err.(*errorx.Error).Details).Should(HaveKey("slug"))
+				Expect(err.(*errorx.Error).Details).Should(HaveKey("host"))
+				Expect(err.(*errorx.Error).Details).Should(HaveKey("owner.email"))
+				Expect(result).Should(BeNil())
+			}, SpecTimeout(time.Second*2))
+		})
+
+		When("the slug or the owner is already taken", func() {
+			It("tells the administrator which fields are taken", func(ctx SpecContext) {
+				tenantRepoMock.EXPECT().FindConflicts(commonCtx, gomock.Any(), gomock.Any()).
+					Return([]string{"slug", "owner.username"}, nil).Times(1)
+
+				result, err := tenantUsecase.Create(commonCtx, adminClaim, request)
+				Expect(err.(*errorx.Error).Type).Should(Equal(errorx.TypeInvalidParameter))
+				Expect(err.(*errorx.Error).Details).Should(HaveKeyWithValue("slug", "is already taken"))
+				Expect(err.(*errorx.Error).Details).Should(HaveKeyWithValue("owner.username", "is already taken"))
+				Expect(result).Should(BeNil())
+			}, SpecTimeout(time.Second*2))
+		})
+
+		When("the request is valid", func() {
+			It("creates the owner and their tenant", func(ctx SpecContext) {
+				host := " CV.Jane.dev "
+				request.Host = &host
+
+				tenantRepoMock.EXPECT().FindConflicts(commonCtx, gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+				tenantRepoMock.EXPECT().CreateTenant(commonCtx, gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, ownerAccount *model.OwnerAccount, tenant *model.Tenant) error {
+						Expect(ownerAccount.SiteAdmin).Should(BeFalse())
+						Expect(ownerAccount.Password).ShouldNot(Equal(request.Owner.Password))
+						ownerAccount.ID = 3
+						tenant.ID = 7
+						tenant.OwnerID = ownerAccount.ID
+						return nil
+					}).Times(1)
+				emailVerificationUsecaseMock.EXPECT().SendVerification(commonCtx, uint(3)).Return(nil).Times(1)
+
+				result, err := tenantUsecase.Create(commonCtx, adminClaim, request)
+				Expect(err).Should(BeNil())
+				Expect(result.Slug).Should(Equal("jane"))
+				Expect(*result.Host).Should(Equal("cv.jane.dev"))
+				Expect(result.OwnerID).Should(Equal(uint(3)))
+			}, SpecTimeout(time.Second*2))
+		})
+	})
+})
@@ -0,0 +1,145 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/model"
+	"gorm.io/gorm"
+)
+
+type PostgreSQLDatabase struct {
+	db *gorm.DB
+}
+
+func NewPostgreSQL(db *gorm.DB) *PostgreSQLDatabase {
+	return &PostgreSQLDatabase{
+		db: db,
+	}
+}
+
+func (p *PostgreSQLDatabase) CreateVariant(ctx context.Context, variant *model.Variant) error {
+	err := p.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := clearDefault(tx, variant); err != nil {
+			return err
+		}
+
+		return tx.Create(variant).Error
+	})
+	if err != nil {
+		return errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), err)
+	}
+
+	return nil
+}
+
+func (p *PostgreSQLDatabase) GetVariant(ctx context.Context, ownerID, variantID uint) (*model.Variant, error) {
+	variant := &model.Variant{}
+	result := p.db.WithContext(ctx).Where("id = ? AND owner_id = ?", variantID, ownerID).First(variant)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, errorx.ErrNotFound
+		}
+		return nil, errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+
+	return variant, nil
+}
+
+func (p *PostgreSQLDatabase) ListVariants(ctx context.Context, ownerID uint) ([]model.Variant, error) {
+	var variants []model.Variant
+	result := p.db.WithContext(ctx).Where("owner_id = ?", ownerID).Order("slug").Find(&variants)
+	if result.Error != nil {
+		return nil, errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+
+	return variants, nil
+}
+
+func (p *PostgreSQLDatabase) UpdateVariant(ctx context.Context, variant *model.Variant) error {
+	err := p.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := clearDefault(tx, variant); err != nil {
+			return err
+		}
+
+		result := tx.Model(variant).
+			Where("owner_id = ?", variant.OwnerID).
+			Select("*").Omit("id", "owner_id", "created_at").
+			Updates(variant)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errorx.ErrNotFound
+		}
+		return errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), err)
+	}
+
+	return nil
+}
+
+func (p *PostgreSQLDatabase) DeleteVariant(ctx context.Context, ownerID, variantID uint) error {
+	result := p.db.WithContext(ctx).Where("id = ? AND owner_id = ?", variantID, ownerID).Delete(&model.Variant{})
+	if result.Error != nil {
+		return errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return errorx.ErrNotFound
+	}
+
+	return nil
+}
+
+func (p *PostgreSQLDatabase) VariantSlugTaken(ctx context.Context, ownerID uint, slug string, exceptVariantID uint) (bool, error) {
+	var count int64
+	result := p.db.WithContext(ctx).Model(&model.Variant{}).
+		Where("owner_id = ? AND slug = ? AND id <> ?", ownerID, slug, exceptVariantID).
+		Count(&count)
+	if result.Error != nil {
+		return false, errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+
+	return count > 0, nil
+}
+
+func (p *PostgreSQLDatabase) ListExperienceIDs(ctx context.Context, ownerID uint) ([]uint, error) {
+	return p.listIDs(ctx, &model.Experience{}, ownerID)
+}
+
+func (p *PostgreSQLDatabase) ListProjectIDs(ctx context.Context, ownerID uint) ([]uint, error) {
+	return p.listIDs(ctx, &model.Project{}, ownerID)
+}
+
+func (p *PostgreSQLDatabase) ListSkillIDs(ctx context.Context, ownerID uint) ([]uint, error) {
+	return p.listIDs(ctx, &model.Skill{}, ownerID)
+}
+
+func (p *PostgreSQLDatabase) listIDs(ctx context.Context, entries interface{}, ownerID uint) ([]uint, error) {
+	var ids []uint
+	result := p.db.WithContext(ctx).Model(entries).Where("owner_id = ?", ownerID).Pluck("id", &ids)
+	if result.Error != nil {
+		return nil, errorx.New(errorx.TypeInternal, errorx.TypeInternal.String(), result.Error)
+	}
+
+	return ids, nil
+}
+
+// clearDefault makes the other variants of the owner stop being the default
+// when variant becomes it
+func clearDefault(tx *gorm.DB, variant *model.Variant) error {
+	if !variant.Default {
+		return nil
+	}
+
+	return tx.Model(&model.Variant{}).
+		Where("owner_id = ? AND id <> ? AND is_default", variant.OwnerID, variant.ID).
+		Update("is_default", false).Error
+}
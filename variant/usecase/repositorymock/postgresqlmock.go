@@ -0,0 +1,168 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: devoratio.dev/web-resume/variant/usecase (interfaces: VariantRepository)
+
+// Package repositorymock is a generated GoMock package.
+package repositorymock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "devoratio.dev/web-resume/model"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockVariantRepository is a mock of VariantRepository interface.
+type MockVariantRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockVariantRepositoryMockRecorder
+}
+
+// MockVariantRepositoryMockRecorder is the mock recorder for MockVariantRepository.
+type MockVariantRepositoryMockRecorder struct {
+	mock *MockVariantRepository
+}
+
+// NewMockVariantRepository creates a new mock instance.
+func NewMockVariantRepository(ctrl *gomock.Controller) *MockVariantRepository {
+	mock := &MockVariantRepository{ctrl: ctrl}
+	mock.recorder = &MockVariantRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVariantRepository) EXPECT() *MockVariantRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateVariant mocks base method.
+func (m *MockVariantRepository) CreateVariant(arg0 context.Context, arg1 *model.Variant) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVariant", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateVariant indicates an expected call of CreateVariant.
+func (mr *MockVariantRepositoryMockRecorder) CreateVariant(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVariant", reflect.TypeOf((*MockVariantRepository)(nil).CreateVariant), arg0, arg1)
+}
+
+// DeleteVariant mocks base method.
+func (m *MockVariantRepository) DeleteVariant(arg0 context.Context, arg1, arg2 uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVariant", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteVariant indicates an expected call of DeleteVariant.
+func (mr *MockVariantRepositoryMockRecorder) DeleteVariant(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVariant", reflect.TypeOf((*MockVariantRepository)(nil).DeleteVariant), arg0, arg1, arg2)
+}
+
+// GetVariant mocks base method.
+func (m *MockVariantRepository) GetVariant(arg0 context.Context, arg1, arg2 uint) (*model.Variant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVariant", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*model.Variant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVariant indicates an expected call of GetVariant.
+func (mr *MockVariantRepositoryMockRecorder) GetVariant(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVariant", reflect.TypeOf((*MockVariantRepository)(nil).GetVariant), arg0, arg1, arg2)
+}
+
+// ListExperienceIDs mocks base method.
+func (m *MockVariantRepository) ListExperienceIDs(arg0 context.Context, arg1 uint) ([]uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListExperienceIDs", arg0, arg1)
+	ret0, _ := ret[0].([]uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListExperienceIDs indicates an expected call of ListExperienceIDs.
+func (mr *MockVariantRepositoryMockRecorder) ListExperienceIDs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExperienceIDs", reflect.TypeOf((*MockVariantRepository)(nil).ListExperienceIDs), arg0, arg1)
+}
+
+// ListProjectIDs mocks base method.
+func (m *MockVariantRepository) ListProjectIDs(arg0 context.Context, arg1 uint) ([]uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProjectIDs", arg0, arg1)
+	ret0, _ := ret[0].([]uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProjectIDs indicates an expected call of ListProjectIDs.
+func (mr *MockVariantRepositoryMockRecorder) ListProjectIDs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectIDs", reflect.TypeOf((*MockVariantRepository)(nil).ListProjectIDs), arg0, arg1)
+}
+
+// ListSkillIDs mocks base method.
+func (m *MockVariantRepository) ListSkillIDs(arg0 context.Context, arg1 uint) ([]uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSkillIDs", arg0, arg1)
+	ret0, _ := ret[0].([]uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSkillIDs indicates an expected call of ListSkillIDs.
+func (mr *MockVariantRepositoryMockRecorder) ListSkillIDs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSkillIDs", reflect.TypeOf((*MockVariantRepository)(nil).ListSkillIDs), arg0, arg1)
+}
+
+// ListVariants mocks base method.
+func (m *MockVariantRepository) ListVariants(arg0 context.Context, arg1 uint) ([]model.Variant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVariants", arg0, arg1)
+	ret0, _ := ret[0].([]model.Variant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVariants indicates an expected call of ListVariants.
+func (mr *MockVariantRepositoryMockRecorder) ListVariants(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVariants", reflect.TypeOf((*MockVariantRepository)(nil).ListVariants), arg0, arg1)
+}
+
+// UpdateVariant mocks base method.
+func (m *MockVariantRepository) UpdateVariant(arg0 context.Context, arg1 *model.Variant) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVariant", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateVariant indicates an expected call of UpdateVariant.
+func (mr *MockVariantRepositoryMockRecorder) UpdateVariant(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVariant", reflect.TypeOf((*MockVariantRepository)(nil).UpdateVariant), arg0, arg1)
+}
+
+// VariantSlugTaken mocks base method.
+func (m *MockVariantRepository) VariantSlugTaken(arg0 context.Context, arg1 uint, arg2 string, arg3 uint) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VariantSlugTaken", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VariantSlugTaken indicates an expected call of VariantSlugTaken.
+func (mr *MockVariantRepositoryMockRecorder) VariantSlugTaken(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VariantSlugTaken", reflect.TypeOf((*MockVariantRepository)(nil).VariantSlugTaken), arg0, arg1, arg2, arg3)
+}
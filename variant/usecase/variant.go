@@ -0,0 +1,228 @@
+package usecase
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"devoratio.dev/web-resume/internal/authz"
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/model"
+)
+
+const (
+	invalidVariantMessage = "resume variant is invalid"
+
+	maxNameLength     = 100
+	maxHeadlineLength = 120
+	maxSummaryLength  = 2000
+)
+
+var (
+	validSlug = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?$`)
+	// reservedSlugs are taken by the other public resume routes
+	reservedSlugs = []string{"projects"}
+)
+
+//go:generate mockgen -destination=repositorymock/postgresqlmock.go -package=repositorymock . VariantRepository
+type VariantRepository interface {
+	// CreateVariant stores variant, the other variants of the owner stop being
+	// the default when it is
+	CreateVariant(ctx context.Context, variant *model.Variant) error
+	GetVariant(ctx context.Context, ownerID, variantID uint) (*model.Variant, error)
+	ListVariants(ctx context.Context, ownerID uint) ([]model.Variant, error)
+	// UpdateVariant stores variant, the other variants of the owner stop being
+	// the default when it is
+	UpdateVariant(ctx context.Context, variant *model.Variant) error
+	DeleteVariant(ctx context.Context, ownerID, variantID uint) error
+	// VariantSlugTaken reports whether the owner has a variant other than
+	// exceptVariantID with slug
+	VariantSlugTaken(ctx context.Context, ownerID uint, slug string, exceptVariantID uint) (bool, error)
+	ListExperienceIDs(ctx context.Context, ownerID uint) ([]uint, error)
+	ListProjectIDs(ctx context.Context, ownerID uint) ([]uint, error)
+	ListSkillIDs(ctx context.Context, ownerID uint) ([]uint, error)
+}
+
+type Variant struct {
+	variantRepo VariantRepository
+}
+
+func NewUsecase(variantRepo VariantRepository) *Variant {
+	return &Variant{
+		variantRepo: variantRepo,
+	}
+}
+
+func (v *Variant) Create(ctx context.Context, claim model.Claim, request model.VariantRequest) (*model.Variant, error) {
+	if err := authz.RequireScope(claim, model.ScopeResumeWrite); err != nil {
+		return nil, err
+	}
+
+	variant := &model.Variant{OwnerID: claim.UserID}
+	if err := v.apply(ctx, variant, request); err != nil {
+		return nil, err
+	}
+
+	if err := v.variantRepo.CreateVariant(ctx, variant); err != nil {
+		return nil, err
+	}
+
+	return variant, nil
+}
+
+func (v *Variant) Get(ctx context.Context, claim model.Claim, variantID uint) (*model.Variant, error) {
+	return v.variantRepo.GetVariant(ctx, claim.UserID, variantID)
+}
+
+// List returns the variants of the owner, the default first
+func (v *Variant) List(ctx context.Context, claim model.Claim) ([]model.Variant, error) {
+	variants, err := v.variantRepo.ListVariants(ctx, claim.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortStableFunc(variants, func(a, b model.Variant) int {
+		if a.Default != b.Default {
+			if a.Default {
+				return -1
+			}
+			return 1
+		}
+		return cmp.Compare(a.Slug, b.Slug)
+	})
+	return variants, nil
+}
+
+func (v *Variant) Update(ctx context.Context, claim model.Claim, variantID uint, request model.VariantRequest) (*model.Variant, error) {
+	if err := authz.RequireScope(claim, model.ScopeResumeWrite); err != nil {
+		return nil, err
+	}
+
+	variant, err := v.variantRepo.GetVariant(ctx, claim.UserID, variantID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := v.apply(ctx, variant, request); err != nil {
+		return nil, err
+	}
+
+	if err := v.variantRepo.UpdateVariant(ctx, variant); err != nil {
+		return nil, err
+	}
+
+	return variant, nil
+}
+
+// Delete removes the variant, when it was the default the public resume shows
+// every entry again
+func (v *Variant) Delete(ctx context.Context, claim model.Claim, variantID uint) error {
+	if err := authz.RequireScope(claim, model.ScopeResumeWrite); err != nil {
+		return err
+	}
+
+	return v.variantRepo.DeleteVariant(ctx, claim.UserID, variantID)
+}
+
+// apply validates request and copies it onto variant
+func (v *Variant) apply(ctx context.Context, variant *model.Variant, request model.VariantRequest) error {
+	variant.Name = strings.TrimSpace(request.Name)
+	variant.Slug = strings.ToLower(strings.TrimSpace(request.Slug))
+	variant.Default = request.Default
+	variant.Headline = strings.TrimSpace(request.Headline)
+	variant.Summary = strings.TrimSpace(request.Summary)
+	variant.ExperienceIDs = nonNil(request.ExperienceIDs)
+	variant.ProjectIDs = nonNil(request.ProjectIDs)
+	variant.SkillIDs = nonNil(request.SkillIDs)
+	variant.UpdatedAt = time.Now()
+
+	details := map[string]interface{}{}
+	if variant.Name == "" {
+		details["name"] = "must not be empty"
+	} else if utf8.RuneCountInString(variant.Name) > maxNameLength {
+		details["name"] = fmt.Sprintf("must not be longer than %d characters", maxNameLength)
+	}
+	if !validSlug.MatchString(variant.Slug) {
+		details["slug"] = "must only have lowercase letters, digits and hyphens, and neither start nor end with a hyphen"
+	} else if slices.Contains(reservedSlugs, variant.Slug) {
+		details["slug"] = "is reserved"
+	}
+	if utf8.RuneCountInString(variant.Headline) > maxHeadlineLength {
+		details["headline"] = fmt.Sprintf("must not be longer than %d characters", maxHeadlineLength)
+	}
+	if utf8.RuneCountInString(variant.Summary) > maxSummaryLength {
+		details["summary"] = fmt.Sprintf("must not be longer than %d characters", maxSummaryLength)
+	}
+
+	if _, invalid := details["slug"]; !invalid {
+		taken, err := v.variantRepo.VariantSlugTaken(ctx, variant.OwnerID, variant.Slug, variant.ID)
+		if err != nil {
+			return err
+		}
+		if taken {
+			details["slug"] = "is already taken"
+		}
+	}
+
+	picks := []struct {
+		field string
+		kind  string
+		ids   []uint
+		list  func(ctx context.Context, ownerID uint) ([]uint, error)
+	}{
+		{field: "experience_ids", kind: "experiences", ids: variant.ExperienceIDs, list: v.variantRepo.ListExperienceIDs},
+		{field: "project_ids", kind: "projects", ids: variant.ProjectIDs, list: v.variantRepo.ListProjectIDs},
+		{field: "skill_ids", kind: "skills", ids: variant.SkillIDs, list: v.variantRepo.ListSkillIDs},
+	}
+	for _, pick := range picks {
+		if len(pick.ids) == 0 {
+			continue
+		}
+
+		owned, err := pick.list(ctx, variant.OwnerID)
+		if err != nil {
+			return err
+		}
+		if message := checkPick(pick.ids, owned, pick.kind); message != "" {
+			details[pick.field] = message
+		}
+	}
+
+	if len(details) > 0 {
+		err := errorx.New(errorx.TypeInvalidParameter, invalidVariantMessage, nil)
+		err.Details = details
+		return err
+	}
+
+	return nil
+}
+
+// checkPick tells what is wrong with ids picked among the owned entries of a
+// kind, or returns an empty string
+func checkPick(ids, owned []uint, kind string) string {
+	seen := make(map[uint]bool, len(ids))
+	for _, id := range ids {
+		if seen[id] {
+			return fmt.Sprintf("must not have %d more than once", id)
+		}
+		seen[id] = true
+
+		if !slices.Contains(owned, id) {
+			return fmt.Sprintf("%d is not one of your %s", id, kind)
+		}
+	}
+
+	return ""
+}
+
+func nonNil(ids []uint) []uint {
+	if ids == nil {
+		return []uint{}
+	}
+	return ids
+}
@@ -0,0 +1,133 @@
+package usecase_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"devoratio.dev/web-resume/internal/errorx"
+	"devoratio.dev/web-resume/model"
+	"devoratio.dev/web-resume/variant/usecase"
+	"devoratio.dev/web-resume/variant/usecase/repositorymock"
+)
+
+var _ = Describe("Variant", Label("variant"), func() {
+	var (
+		mockController *gomock.Controller
+
+		variantRepoMock *repositorymock.MockVariantRepository
+
+		variantUsecase *usecase.Variant
+		commonCtx      context.Context
+		claim          model.Claim
+		request        model.VariantRequest
+	)
+
+	BeforeEach(func() {
+		mockController = gomock.NewController(GinkgoT())
+
+		variantRepoMock = repositorymock.NewMockVariantRepository(mockController)
+
+		variantUsecase = usecase.NewUsecase(variantRepoMock)
+
+		claim = model.Claim{UserID: 1, Username: "devoratio", Scopes: []string{model.ScopeResumeWrite}}
+		request = model.VariantRequest{
+			Name:          "Backend",
+			Slug:          " Backend ",
+			Default:       true,
+			Headline:      "Backend engineer",
+			ExperienceIDs: []uint{3, 1},
+			SkillIDs:      []uint{2},
+		}
+
+		commonCtx = context.Background()
+	})
+
+	AfterEach(func() {
+		mockController.Finish()
+	})
+
+	Describe("Create a variant", func() {
+		When("the slug is taken by another public route", func() {
+			It("tells the owner the slug is reserved", func(ctx SpecContext) {
+				request.Slug = "projects"
+				request.ExperienceIDs = nil
+				request.SkillIDs = nil
+
+				result, err := variantUsecase.Create(commonCtx, claim, request)
+				Expect(err.(*errorx.Error).Type).Should(Equal(errorx.TypeInvalidParameter))
+				Expect(err.(*errorx.Error).Details).Should(HaveKeyWithValue("slug", "is reserved"))
+				Expect(result).Should(BeNil())
+			}, SpecTimeout(time.Second*2))
+		})
+
+		When("the variant picks entries of someone else", func() {
+			It("tells the owner which entry isn't theirs", func(ctx SpecContext) {
+				variantRepoMock.EXPECT().VariantSlugTaken(commonCtx, claim.UserID, "backend", uint(0)).Return(false, nil).Times(1)
+				variantRepoMock.EXPECT().ListExperienceIDs(commonCtx, claim.UserID).Return([]uint{1, 2}, nil).Times(1)
+				variantRepoMock.EXPECT().ListSkillIDs(commonCtx, claim.UserID).Return([]uint{2}, nil).Times(1)
+
+				_, err := variantUsecase.Create(commonCtx, claim, request)
+				Expect(err.(*errorx.Error).Details).Should(HaveKeyWithValue("experience_ids", "3 is not one of your experiences"))
+				Expect(err.(*errorx.Error).Details).ShouldNot(HaveKey("skill_ids"))
+			}, SpecTimeout(time.Second*2))
+		})
+
+		When("the variant picks an entry twice", func() {
+			It("refuses the duplicate", func(ctx SpecContext) {
+				request.ExperienceIDs = nil
+				request.SkillIDs = []uint{2, 2}
+				variantRepoMock.EXPECT().VariantSlugTaken(commonCtx, claim.UserID, "backend", uint(0)).Return(false, nil).Times(1)
+				variantRepoMock.EXPECT().ListSkillIDs(commonCtx, claim.UserID).Return([]uint{2}, nil).Times(1)
+
+				_, err := variantUsecase.Create(commonCtx, claim, request)
+				Expect(err.(*errorx.Error).Details).Should(HaveKeyWithValue("skill_ids", "must not have 2 more than once"))
+			}, SpecTimeout(time.Second*2))
+		})
+
+		When("the slug is already taken", func() {
+			It("asks for another slug", func(ctx SpecContext) {
+				variantRepoMock.EXPECT().VariantSlugTaken(commonCtx, claim.UserID, "backend", uint(0)).Return(true, nil).Times(1)
+				variantRepoMock.EXPECT().ListExperienceIDs(commonCtx, claim.UserID).Return([]uint{1, 3}, nil).Times(1)
+				variantRepoMock.EXPECT().ListSkillIDs(commonCtx, claim.UserID).Return([]uint{2}, nil).Times(1)
+
+				_, err := variantUsecase.Create(commonCtx, claim, request)
+				Expect(err.(*errorx.Error).Details).Should(HaveKeyWithValue("slug", "is already taken"))
+			}, SpecTimeout(time.Second*2))
+		})
+
+		When("the variant is valid", func() {
+			It("keeps the order the entries were picked in", func(ctx SpecContext) {
+				variantRepoMock.EXPECT().VariantSlugTaken(commonCtx, claim.UserID, "backend", uint(0)).Return(false, nil).Times(1)
+				variantRepoMock.EXPECT().ListExperienceIDs(commonCtx, claim.UserID).Return([]uint{1, 2, 3}, nil).Times(1)
+				variantRepoMock.EXPECT().ListSkillIDs(commonCtx, claim.UserID).Return([]uint{1, 2}, nil).Times(1)
+				variantRepoMock.EXPECT().CreateVariant(commonCtx, gomock.Any()).Return(nil).Times(1)
+
+				result, err := variantUsecase.Create(commonCtx, claim, request)
+				Expect(err).Should(BeNil())
+				Expect(result.OwnerID).Should(Equal(claim.UserID))
+				Expect(result.Slug).Should(Equal("backend"))
+				Expect(result.ExperienceIDs).Should(Equal([]uint{3, 1}))
+				Expect(result.ProjectIDs).Should(BeEmpty())
+				Expect(result.Default).Should(BeTrue())
+			}, SpecTimeout(time.Second*2))
+		})
+	})
+
+	Describe("List the variants", func() {
+		It("lists the default first", func(ctx SpecContext) {
+			variantRepoMock.EXPECT().ListVariants(commonCtx, claim.UserID).Return([]model.Variant{
+				{ID: 1, Slug: "backend"},
+				{ID: 2, Slug: "management", Default: true},
+				{ID: 3, Slug: "sre"},
+			}, nil).Times(1)
+
+			result, err := variantUsecase.List(commonCtx, claim)
+			Expect(err).Should(BeNil())
+			Expect([]uint{result[0].ID, result[1].ID, result[2].ID}).Should(Equal([]uint{2, 1, 3}))
+		}, SpecTimeout(time.Second*2))
+	})
+})